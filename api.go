@@ -0,0 +1,387 @@
+package backlog
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/url"
+)
+
+// The interfaces in this file describe the operations of each service so
+// that code can depend on a single service instead of the whole [Client]
+// and be tested with a fake, such as those in the backlogtest package.
+// Methods are added to an interface when they are added to its service; embed
+// the interface in a fake to keep it compiling across releases.
+
+// IssueAPI is the interface implemented by [IssueService].
+type IssueAPI interface {
+	List(ctx context.Context, opts ...RequestOption) ([]*Issue, error)
+	All(ctx context.Context, perPage int, opts ...RequestOption) (iter.Seq2[*Issue, error], error)
+	AllPrefetch(ctx context.Context, perPage, prefetch int, opts ...RequestOption) (iter.Seq2[*Issue, error], error)
+	Count(ctx context.Context, opts ...RequestOption) (int, error)
+	One(ctx context.Context, issueIDOrKey string) (*Issue, error)
+	Create(ctx context.Context, projectID int, summary string, issueTypeID int, priorityID int, opts ...RequestOption) (*Issue, error)
+	Update(ctx context.Context, issueIDOrKey string, option RequestOption, opts ...RequestOption) (*Issue, error)
+	Delete(ctx context.Context, issueIDOrKey string) (*Issue, error)
+	Participants(ctx context.Context, issueIDOrKey string) ([]*User, error)
+	BulkUpdate(ctx context.Context, concurrency int, issueIDOrKeys []string, option RequestOption, opts ...RequestOption) (*BulkUpdateReport, error)
+	BulkUpdateEach(ctx context.Context, concurrency int, updates []*IssueUpdate) (*BulkUpdateReport, error)
+}
+
+// IssueAttachmentAPI is the interface implemented by [IssueAttachmentService].
+type IssueAttachmentAPI interface {
+	List(ctx context.Context, issueIDOrKey string) ([]*Attachment, error)
+	Remove(ctx context.Context, issueIDOrKey string, attachmentID int) (*Attachment, error)
+	Download(ctx context.Context, issueIDOrKey string, attachmentID int) (*FileData, error)
+}
+
+// IssueCommentAPI is the interface implemented by [IssueCommentService].
+type IssueCommentAPI interface {
+	List(ctx context.Context, issueIDOrKey string, opts ...RequestOption) ([]*Comment, error)
+	All(ctx context.Context, perPage int, issueIDOrKey string, opts ...RequestOption) (iter.Seq2[*Comment, error], error)
+	Add(ctx context.Context, issueIDOrKey string, content string, opts ...RequestOption) (*Comment, error)
+	Count(ctx context.Context, issueIDOrKey string) (int, error)
+	One(ctx context.Context, issueIDOrKey string, commentID int) (*Comment, error)
+	Delete(ctx context.Context, issueIDOrKey string, commentID int) (*Comment, error)
+	Update(ctx context.Context, issueIDOrKey string, commentID int, content string) (*Comment, error)
+	Notifications(ctx context.Context, issueIDOrKey string, commentID int) ([]*Notification, error)
+	Notify(ctx context.Context, issueIDOrKey string, commentID int, userIDs []int) (*Comment, error)
+}
+
+// IssueSharedFileAPI is the interface implemented by [IssueSharedFileService].
+type IssueSharedFileAPI interface {
+	List(ctx context.Context, issueIDOrKey string) ([]*SharedFile, error)
+	Link(ctx context.Context, issueIDOrKey string, fileIDs []int) ([]*SharedFile, error)
+	Unlink(ctx context.Context, issueIDOrKey string, fileID int) (*SharedFile, error)
+}
+
+// IssueStarAPI is the interface implemented by [IssueStarService].
+type IssueStarAPI interface {
+	Add(ctx context.Context, issueID int) error
+	Remove(ctx context.Context, starID int) error
+}
+
+// NotificationAPI is the interface implemented by [NotificationService].
+type NotificationAPI interface {
+	List(ctx context.Context, opts ...RequestOption) ([]*Notification, error)
+	All(ctx context.Context, perPage int, opts ...RequestOption) (iter.Seq2[*Notification, error], error)
+	Count(ctx context.Context, opts ...RequestOption) (int, error)
+	ResetUnreadCount(ctx context.Context) (int, error)
+	MarkAsRead(ctx context.Context, notificationID int) error
+}
+
+// ProjectAPI is the interface implemented by [ProjectService].
+type ProjectAPI interface {
+	List(ctx context.Context, opts ...RequestOption) ([]*Project, error)
+	One(ctx context.Context, projectIDOrKey string) (*Project, error)
+	Create(ctx context.Context, key, name string, opts ...RequestOption) (*Project, error)
+	Update(ctx context.Context, projectIDOrKey string, option RequestOption, opts ...RequestOption) (*Project, error)
+	Delete(ctx context.Context, projectIDOrKey string) (*Project, error)
+	DiskUsage(ctx context.Context, projectIDOrKey string) (*DiskUsageProject, error)
+	Icon(ctx context.Context, projectIDOrKey string) (*FileData, error)
+}
+
+// ProjectActivityAPI is the interface implemented by [ProjectActivityService].
+type ProjectActivityAPI interface {
+	List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*Activity, error)
+	All(ctx context.Context, perPage int, projectIDOrKey string, opts ...RequestOption) (iter.Seq2[*Activity, error], error)
+}
+
+// ProjectCategoryAPI is the interface implemented by [ProjectCategoryService].
+type ProjectCategoryAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*Category, error)
+	Create(ctx context.Context, projectIDOrKey string, name string) (*Category, error)
+	Update(ctx context.Context, projectIDOrKey string, categoryID int, name string) (*Category, error)
+	Delete(ctx context.Context, projectIDOrKey string, categoryID int) (*Category, error)
+}
+
+// ProjectCustomFieldAPI is the interface implemented by [ProjectCustomFieldService].
+type ProjectCustomFieldAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*CustomField, error)
+	Create(ctx context.Context, projectIDOrKey string, fieldType CustomFieldType, name string, opts ...RequestOption) (*CustomField, error)
+	Update(ctx context.Context, projectIDOrKey string, customFieldID int, option RequestOption, opts ...RequestOption) (*CustomField, error)
+	Delete(ctx context.Context, projectIDOrKey string, customFieldID int) (*CustomField, error)
+	AddListItem(ctx context.Context, projectIDOrKey string, customFieldID int, name string) (*CustomField, error)
+	UpdateListItem(ctx context.Context, projectIDOrKey string, customFieldID, itemID int, name string) (*CustomField, error)
+	DeleteListItem(ctx context.Context, projectIDOrKey string, customFieldID, itemID int) (*CustomField, error)
+}
+
+// ProjectIssueTypeAPI is the interface implemented by [ProjectIssueTypeService].
+type ProjectIssueTypeAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*IssueType, error)
+	Create(ctx context.Context, projectIDOrKey, name, color string, opts ...RequestOption) (*IssueType, error)
+	Update(ctx context.Context, projectIDOrKey string, issueTypeID int, option RequestOption, opts ...RequestOption) (*IssueType, error)
+	Delete(ctx context.Context, projectIDOrKey string, issueTypeID, substituteIssueTypeID int) (*IssueType, error)
+}
+
+// ProjectSharedFileAPI is the interface implemented by [ProjectSharedFileService].
+type ProjectSharedFileAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*SharedFile, error)
+	Download(ctx context.Context, projectIDOrKey string, sharedFileID int) (*FileData, error)
+}
+
+// ProjectStatusAPI is the interface implemented by [ProjectStatusService].
+type ProjectStatusAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*Status, error)
+	Create(ctx context.Context, projectIDOrKey, name, color string) (*Status, error)
+	Update(ctx context.Context, projectIDOrKey string, statusID int, option RequestOption, opts ...RequestOption) (*Status, error)
+	Delete(ctx context.Context, projectIDOrKey string, statusID, substituteStatusID int) (*Status, error)
+	UpdateOrder(ctx context.Context, projectIDOrKey string, statusIDs []int) ([]*Status, error)
+}
+
+// ProjectTeamAPI is the interface implemented by [ProjectTeamService].
+type ProjectTeamAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*Team, error)
+	Add(ctx context.Context, projectIDOrKey string, teamID int) (*Team, error)
+	Delete(ctx context.Context, projectIDOrKey string, teamID int) (*Team, error)
+}
+
+// ProjectUserAPI is the interface implemented by [ProjectUserService].
+type ProjectUserAPI interface {
+	List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*User, error)
+	Add(ctx context.Context, projectIDOrKey string, userID int) (*User, error)
+	Delete(ctx context.Context, projectIDOrKey string, userID int) (*User, error)
+	AddAdmin(ctx context.Context, projectIDOrKey string, userID int) (*User, error)
+	AdminList(ctx context.Context, projectIDOrKey string) ([]*User, error)
+	DeleteAdmin(ctx context.Context, projectIDOrKey string, userID int) (*User, error)
+}
+
+// ProjectVersionAPI is the interface implemented by [ProjectVersionService].
+type ProjectVersionAPI interface {
+	List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*Version, error)
+	Create(ctx context.Context, projectIDOrKey, name string, opts ...RequestOption) (*Version, error)
+	Update(ctx context.Context, projectIDOrKey string, versionID int, option RequestOption, opts ...RequestOption) (*Version, error)
+	Delete(ctx context.Context, projectIDOrKey string, versionID int) (*Version, error)
+}
+
+// ProjectWebhookAPI is the interface implemented by [ProjectWebhookService].
+type ProjectWebhookAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*Webhook, error)
+	Create(ctx context.Context, projectIDOrKey, name, hookURL string, opts ...RequestOption) (*Webhook, error)
+	One(ctx context.Context, projectIDOrKey string, webhookID int) (*Webhook, error)
+	Update(ctx context.Context, projectIDOrKey string, webhookID int, option RequestOption, opts ...RequestOption) (*Webhook, error)
+	Delete(ctx context.Context, projectIDOrKey string, webhookID int) (*Webhook, error)
+}
+
+// PullRequestAPI is the interface implemented by [PullRequestService].
+type PullRequestAPI interface {
+	List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...RequestOption) ([]*PullRequest, error)
+	All(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, opts ...RequestOption) (iter.Seq2[*PullRequest, error], error)
+	Count(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...RequestOption) (int, error)
+	One(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (*PullRequest, error)
+	Create(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, summary string, description string, base string, branch string, opts ...RequestOption) (*PullRequest, error)
+	Update(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, option RequestOption, opts ...RequestOption) (*PullRequest, error)
+}
+
+// PullRequestAttachmentAPI is the interface implemented by [PullRequestAttachmentService].
+type PullRequestAttachmentAPI interface {
+	List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) ([]*Attachment, error)
+	Remove(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*Attachment, error)
+	Download(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*FileData, error)
+}
+
+// PullRequestCommentAPI is the interface implemented by [PullRequestCommentService].
+type PullRequestCommentAPI interface {
+	List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...RequestOption) ([]*Comment, error)
+	All(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...RequestOption) (iter.Seq2[*Comment, error], error)
+	Add(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, content string, opts ...RequestOption) (*Comment, error)
+	Count(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (int, error)
+	Update(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, commentID int, content string) (*Comment, error)
+}
+
+// PullRequestStarAPI is the interface implemented by [PullRequestStarService].
+type PullRequestStarAPI interface {
+	Add(ctx context.Context, pullRequestID int) error
+	Remove(ctx context.Context, starID int) error
+}
+
+// RawAPI is the interface implemented by [RawService].
+type RawAPI interface {
+	Get(ctx context.Context, spath string, query url.Values, v any) error
+	Post(ctx context.Context, spath string, form url.Values, v any) error
+	Patch(ctx context.Context, spath string, form url.Values, v any) error
+	Put(ctx context.Context, spath string, form url.Values, v any) error
+	Delete(ctx context.Context, spath string, form url.Values, v any) error
+	Download(ctx context.Context, spath string, query url.Values) (*FileData, error)
+}
+
+// RecentlyViewedAPI is the interface implemented by [RecentlyViewedService].
+type RecentlyViewedAPI interface {
+	ListIssues(ctx context.Context, opts ...RequestOption) ([]*Issue, error)
+	AddIssue(ctx context.Context, issueID int) (*Issue, error)
+	ListProjects(ctx context.Context, opts ...RequestOption) ([]*Project, error)
+	ListWikis(ctx context.Context, opts ...RequestOption) ([]*Wiki, error)
+	AddWiki(ctx context.Context, wikiID int) (*Wiki, error)
+}
+
+// RepositoryAPI is the interface implemented by [RepositoryService].
+type RepositoryAPI interface {
+	List(ctx context.Context, projectIDOrKey string) ([]*Repository, error)
+	One(ctx context.Context, projectIDOrKey string, repoIDOrName string) (*Repository, error)
+}
+
+// SpaceAPI is the interface implemented by [SpaceService].
+type SpaceAPI interface {
+	Info(ctx context.Context) (*Space, error)
+	DiskUsage(ctx context.Context) (*DiskUsageSpace, error)
+	Notification(ctx context.Context) (*SpaceNotification, error)
+	UpdateNotification(ctx context.Context, content string) (*SpaceNotification, error)
+	Licence(ctx context.Context) (*Licence, error)
+	Logo(ctx context.Context) (*FileData, error)
+	RateLimit(ctx context.Context) (*RateLimitStatus, error)
+}
+
+// SpaceActivityAPI is the interface implemented by [SpaceActivityService].
+type SpaceActivityAPI interface {
+	List(ctx context.Context, opts ...RequestOption) ([]*Activity, error)
+	All(ctx context.Context, perPage int, opts ...RequestOption) (iter.Seq2[*Activity, error], error)
+	One(ctx context.Context, activityID int) (*Activity, error)
+}
+
+// SpaceAttachmentAPI is the interface implemented by [SpaceAttachmentService].
+type SpaceAttachmentAPI interface {
+	Upload(ctx context.Context, fileName string, r io.Reader) (*Attachment, error)
+}
+
+// SpacePriorityAPI is the interface implemented by [SpacePriorityService].
+type SpacePriorityAPI interface {
+	List(ctx context.Context) ([]*Priority, error)
+}
+
+// SpaceResolutionAPI is the interface implemented by [SpaceResolutionService].
+type SpaceResolutionAPI interface {
+	List(ctx context.Context) ([]*Resolution, error)
+}
+
+// StarAPI is the interface implemented by [StarService].
+type StarAPI interface {
+	Add(ctx context.Context, option RequestOption) error
+	Remove(ctx context.Context, id int) error
+}
+
+// TeamAPI is the interface implemented by [TeamService].
+type TeamAPI interface {
+	List(ctx context.Context, opts ...RequestOption) ([]*Team, error)
+	One(ctx context.Context, teamID int) (*Team, error)
+	Create(ctx context.Context, name string, opts ...RequestOption) (*Team, error)
+	Update(ctx context.Context, teamID int, option RequestOption, opts ...RequestOption) (*Team, error)
+	Delete(ctx context.Context, teamID int) (*Team, error)
+	Icon(ctx context.Context, teamID int) (*FileData, error)
+}
+
+// UserAPI is the interface implemented by [UserService].
+type UserAPI interface {
+	List(ctx context.Context) ([]*User, error)
+	One(ctx context.Context, id int) (*User, error)
+	Me(ctx context.Context) (*User, error)
+	Add(ctx context.Context, userID, password, name, mailAddress string, roleType Role) (*User, error)
+	Update(ctx context.Context, id int, option RequestOption, opts ...RequestOption) (*User, error)
+	Delete(ctx context.Context, id int) (*User, error)
+	Icon(ctx context.Context, id int) (*FileData, error)
+}
+
+// UserActivityAPI is the interface implemented by [UserActivityService].
+type UserActivityAPI interface {
+	List(ctx context.Context, userID int, opts ...RequestOption) ([]*Activity, error)
+	All(ctx context.Context, perPage int, userID int, opts ...RequestOption) (iter.Seq2[*Activity, error], error)
+}
+
+// UserStarAPI is the interface implemented by [UserStarService].
+type UserStarAPI interface {
+	List(ctx context.Context, userID int, opts ...RequestOption) ([]*Star, error)
+	All(ctx context.Context, perPage int, userID int, opts ...RequestOption) (iter.Seq2[*Star, error], error)
+	Count(ctx context.Context, userID int) (int, error)
+}
+
+// WatchingAPI is the interface implemented by [WatchingService].
+type WatchingAPI interface {
+	List(ctx context.Context, userID int, opts ...RequestOption) ([]*Watching, error)
+	All(ctx context.Context, perPage int, userID int, opts ...RequestOption) (iter.Seq2[*Watching, error], error)
+	Count(ctx context.Context, userID int, opts ...RequestOption) (int, error)
+	One(ctx context.Context, watchingID int) (*Watching, error)
+	Add(ctx context.Context, issueIDOrKey string, opts ...RequestOption) (*Watching, error)
+	Update(ctx context.Context, watchingID int, note string) (*Watching, error)
+	Delete(ctx context.Context, watchingID int) (*Watching, error)
+	MarkAsRead(ctx context.Context, watchingID int) error
+}
+
+// WikiAPI is the interface implemented by [WikiService].
+type WikiAPI interface {
+	List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*Wiki, error)
+	Count(ctx context.Context, projectIDOrKey string) (int, error)
+	One(ctx context.Context, wikiID int) (*Wiki, error)
+	Create(ctx context.Context, projectID int, name, content string, opts ...RequestOption) (*Wiki, error)
+	Update(ctx context.Context, wikiID int, option RequestOption, opts ...RequestOption) (*Wiki, error)
+	Delete(ctx context.Context, wikiID int, opts ...RequestOption) (*Wiki, error)
+}
+
+// WikiAttachmentAPI is the interface implemented by [WikiAttachmentService].
+type WikiAttachmentAPI interface {
+	Attach(ctx context.Context, wikiID int, attachmentIDs []int) ([]*Attachment, error)
+	List(ctx context.Context, wikiID int) ([]*Attachment, error)
+	Remove(ctx context.Context, wikiID, attachmentID int) (*Attachment, error)
+	Download(ctx context.Context, wikiID, attachmentID int) (*FileData, error)
+}
+
+// WikiHistoryAPI is the interface implemented by [WikiHistoryService].
+type WikiHistoryAPI interface {
+	List(ctx context.Context, wikiID int) ([]*WikiHistory, error)
+}
+
+// WikiSharedFileAPI is the interface implemented by [WikiSharedFileService].
+type WikiSharedFileAPI interface {
+	List(ctx context.Context, wikiID int) ([]*SharedFile, error)
+	Link(ctx context.Context, wikiID int, fileIDs []int) ([]*SharedFile, error)
+	Unlink(ctx context.Context, wikiID, fileID int) (*SharedFile, error)
+}
+
+// WikiStarAPI is the interface implemented by [WikiStarService].
+type WikiStarAPI interface {
+	List(ctx context.Context, wikiID int) ([]*Star, error)
+	Add(ctx context.Context, wikiID int) error
+	Remove(ctx context.Context, starID int) error
+}
+
+// Compile-time checks that every service implements its interface.
+var (
+	_ IssueAPI                 = (*IssueService)(nil)
+	_ IssueAttachmentAPI       = (*IssueAttachmentService)(nil)
+	_ IssueCommentAPI          = (*IssueCommentService)(nil)
+	_ IssueSharedFileAPI       = (*IssueSharedFileService)(nil)
+	_ IssueStarAPI             = (*IssueStarService)(nil)
+	_ NotificationAPI          = (*NotificationService)(nil)
+	_ ProjectAPI               = (*ProjectService)(nil)
+	_ ProjectActivityAPI       = (*ProjectActivityService)(nil)
+	_ ProjectCategoryAPI       = (*ProjectCategoryService)(nil)
+	_ ProjectCustomFieldAPI    = (*ProjectCustomFieldService)(nil)
+	_ ProjectIssueTypeAPI      = (*ProjectIssueTypeService)(nil)
+	_ ProjectSharedFileAPI     = (*ProjectSharedFileService)(nil)
+	_ ProjectStatusAPI         = (*ProjectStatusService)(nil)
+	_ ProjectTeamAPI           = (*ProjectTeamService)(nil)
+	_ ProjectUserAPI           = (*ProjectUserService)(nil)
+	_ ProjectVersionAPI        = (*ProjectVersionService)(nil)
+	_ ProjectWebhookAPI        = (*ProjectWebhookService)(nil)
+	_ PullRequestAPI           = (*PullRequestService)(nil)
+	_ PullRequestAttachmentAPI = (*PullRequestAttachmentService)(nil)
+	_ PullRequestCommentAPI    = (*PullRequestCommentService)(nil)
+	_ PullRequestStarAPI       = (*PullRequestStarService)(nil)
+	_ RawAPI                   = (*RawService)(nil)
+	_ RecentlyViewedAPI        = (*RecentlyViewedService)(nil)
+	_ RepositoryAPI            = (*RepositoryService)(nil)
+	_ SpaceAPI                 = (*SpaceService)(nil)
+	_ SpaceActivityAPI         = (*SpaceActivityService)(nil)
+	_ SpaceAttachmentAPI       = (*SpaceAttachmentService)(nil)
+	_ SpacePriorityAPI         = (*SpacePriorityService)(nil)
+	_ SpaceResolutionAPI       = (*SpaceResolutionService)(nil)
+	_ StarAPI                  = (*StarService)(nil)
+	_ TeamAPI                  = (*TeamService)(nil)
+	_ UserAPI                  = (*UserService)(nil)
+	_ UserActivityAPI          = (*UserActivityService)(nil)
+	_ UserStarAPI              = (*UserStarService)(nil)
+	_ WatchingAPI              = (*WatchingService)(nil)
+	_ WikiAPI                  = (*WikiService)(nil)
+	_ WikiAttachmentAPI        = (*WikiAttachmentService)(nil)
+	_ WikiHistoryAPI           = (*WikiHistoryService)(nil)
+	_ WikiSharedFileAPI        = (*WikiSharedFileService)(nil)
+	_ WikiStarAPI              = (*WikiStarService)(nil)
+)
@@ -0,0 +1,190 @@
+package backlogtest
+
+import (
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// maxUploadSize is the largest file accepted by the upload endpoint.
+const maxUploadSize = 100 << 20
+
+// attachment holds an uploaded file. It is attached once it has been
+// added to an issue, comment or wiki, after which it cannot be reused.
+type attachment struct {
+	*model.Attachment
+	data     []byte
+	attached bool
+}
+
+// download is returned by handlers that respond with a file.
+type download struct {
+	name string
+	data []byte
+}
+
+// attachmentsParam resolves the attachmentId[] param to uploaded files that
+// are not attached yet.
+func (s *Server) attachmentsParam(r *http.Request) ([]*attachment, error) {
+	ids, err := ints(r, "attachmentId[]")
+	if err != nil {
+		return nil, err
+	}
+	var out []*attachment
+	for _, id := range ids {
+		a := s.attachments[id]
+		if a == nil || a.attached {
+			return nil, errInvalidParam("attachmentId[]")
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+// findAttachment returns the attachment with the given ID among list.
+func findAttachment(list []*model.Attachment, id int) *model.Attachment {
+	for _, a := range list {
+		if a.ID == id {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) uploadAttachment(r *http.Request) (any, error) {
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		return nil, errInvalidRequest("The request must be multipart/form-data.")
+	}
+	f, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, errRequired("file")
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &attachment{
+		Attachment: &model.Attachment{
+			ID:          s.nextID("attachment"),
+			Name:        header.Filename,
+			Size:        len(data),
+			CreatedUser: s.myself,
+			Created:     s.timestamp(),
+		},
+		data: data,
+	}
+	s.attachments[a.ID] = a
+	return a.Attachment, nil
+}
+
+// issueAttachmentParam returns the issue and attachment named by the path.
+func (s *Server) issueAttachmentParam(r *http.Request) (*issue, *model.Attachment, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := pathInt(r, "attachmentId", "attachment")
+	if err != nil {
+		return nil, nil, err
+	}
+	a := findAttachment(i.Attachments, id)
+	if a == nil {
+		return nil, nil, errNoResource("attachment")
+	}
+	return i, a, nil
+}
+
+func (s *Server) listIssueAttachments(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return orEmpty(i.Attachments), nil
+}
+
+func (s *Server) downloadIssueAttachment(r *http.Request) (any, error) {
+	_, a, err := s.issueAttachmentParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return &download{name: a.Name, data: s.attachments[a.ID].data}, nil
+}
+
+func (s *Server) deleteIssueAttachment(r *http.Request) (any, error) {
+	i, a, err := s.issueAttachmentParam(r)
+	if err != nil {
+		return nil, err
+	}
+	i.Attachments = slices.DeleteFunc(i.Attachments, func(v *model.Attachment) bool { return v == a })
+	delete(s.attachments, a.ID)
+	return a, nil
+}
+
+// wikiAttachmentParam returns the wiki and attachment named by the path.
+func (s *Server) wikiAttachmentParam(r *http.Request) (*wiki, *model.Attachment, error) {
+	w, err := s.wikiParam(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := pathInt(r, "attachmentId", "attachment")
+	if err != nil {
+		return nil, nil, err
+	}
+	a := findAttachment(w.Attachments, id)
+	if a == nil {
+		return nil, nil, errNoResource("attachment")
+	}
+	return w, a, nil
+}
+
+func (s *Server) listWikiAttachments(r *http.Request) (any, error) {
+	w, err := s.wikiParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return orEmpty(w.Attachments), nil
+}
+
+func (s *Server) attachWikiAttachments(r *http.Request) (any, error) {
+	w, err := s.wikiParam(r)
+	if err != nil {
+		return nil, err
+	}
+	if !r.Form.Has("attachmentId[]") {
+		return nil, errRequired("attachmentId[]")
+	}
+	attachments, err := s.attachmentsParam(r)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.Attachment, len(attachments))
+	for n, a := range attachments {
+		a.attached = true
+		w.Attachments = append(w.Attachments, a.Attachment)
+		out[n] = a.Attachment
+	}
+	return out, nil
+}
+
+func (s *Server) downloadWikiAttachment(r *http.Request) (any, error) {
+	_, a, err := s.wikiAttachmentParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return &download{name: a.Name, data: s.attachments[a.ID].data}, nil
+}
+
+func (s *Server) deleteWikiAttachment(r *http.Request) (any, error) {
+	w, a, err := s.wikiAttachmentParam(r)
+	if err != nil {
+		return nil, err
+	}
+	w.Attachments = slices.DeleteFunc(w.Attachments, func(v *model.Attachment) bool { return v == a })
+	delete(s.attachments, a.ID)
+	return a, nil
+}
@@ -0,0 +1,346 @@
+package backlogtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/nattokin/go-backlog"
+)
+
+// redacted replaces credentials in recorded requests.
+const redacted = "REDACTED"
+
+// Cassette is a [backlog.Doer] that records API interactions to a file and
+// replays them later, so tests can run against captured responses of a real
+// Backlog space without network access or credentials.
+//
+// Create a recording Cassette with [NewRecorder] and call [Cassette.Save]
+// when finished, or load a recorded one with [LoadCassette] to replay it:
+//
+//	cas, err := backlogtest.LoadCassette("testdata/issues.json")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	c, _ := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(cas))
+//
+// Requests are matched on method, path, query and form parameters; the host
+// and credentials are ignored. The Authorization header and the apiKey query
+// parameter are never written to the file. Response bodies that are not
+// UTF-8 text, such as downloaded files, are stored base64 encoded.
+//
+// A Cassette is safe for concurrent use.
+type Cassette struct {
+	path   string
+	doer   backlog.Doer
+	strict bool
+
+	mu           sync.Mutex
+	interactions []*interaction
+	played       []bool
+}
+
+// CassetteOption configures a [Cassette].
+type CassetteOption func(*Cassette)
+
+// WithStrictMatching makes a replaying Cassette serve each recorded
+// interaction at most once and fail unmatched requests with an
+// *UnmatchedRequestError.
+//
+// By default interactions can be replayed any number of times, and an
+// unmatched request receives a 404 response with a Backlog error payload.
+func WithStrictMatching() CassetteOption {
+	return func(c *Cassette) { c.strict = true }
+}
+
+// UnmatchedRequestError is returned by a strict [Cassette] when a request
+// matches no recorded interaction that is left to play.
+type UnmatchedRequestError struct {
+	Method string
+	Path   string
+}
+
+// Error implements the error interface.
+func (e *UnmatchedRequestError) Error() string {
+	return fmt.Sprintf("backlogtest: no recorded interaction for %s %s", e.Method, e.Path)
+}
+
+// interaction is a recorded request and its response.
+type interaction struct {
+	Request  *recordedRequest  `json:"request"`
+	Response *recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  url.Values  `json:"query,omitempty"`
+	Form   url.Values  `json:"form,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	// Body holds UTF-8 text bodies and BodyBase64 all other bodies.
+	Body       string `json:"body,omitempty"`
+	BodyBase64 []byte `json:"bodyBase64,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []*interaction `json:"interactions"`
+}
+
+// NewRecorder returns a Cassette that sends requests with doer and records
+// them. Call [Cassette.Save] to write the recording to path.
+func NewRecorder(path string, doer backlog.Doer, opts ...CassetteOption) *Cassette {
+	c := &Cassette{path: path, doer: doer}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// LoadCassette returns a Cassette that replays the interactions recorded in
+// the file at path.
+func LoadCassette(path string, opts ...CassetteOption) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f cassetteFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("backlogtest: decode cassette %s: %w", path, err)
+	}
+
+	c := &Cassette{
+		path:         path,
+		interactions: f.Interactions,
+		played:       make([]bool, len(f.Interactions)),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Do implements [backlog.Doer]. A recording Cassette sends req and records
+// the exchange; a replaying one returns the recorded response.
+func (c *Cassette) Do(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	rec, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.doer != nil {
+		return c.record(req, rec)
+	}
+	return c.replay(req, rec)
+}
+
+// Save writes the recorded interactions to the cassette file, creating its
+// directory if needed.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	b, err := json.MarshalIndent(&cassetteFile{Interactions: orEmpty(c.interactions)}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(b, '\n'), 0o644)
+}
+
+func (c *Cassette) record(req *http.Request, rec *recordedRequest) (*http.Response, error) {
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	out := &recordedResponse{StatusCode: resp.StatusCode, Header: resp.Header.Clone()}
+	if isText(resp.Header, body) {
+		out.Body = string(body)
+	} else {
+		out.BodyBase64 = body
+	}
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, &interaction{Request: rec, Response: out})
+	c.mu.Unlock()
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, rec *recordedRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Unplayed interactions are served in recorded order; once all matches
+	// have been played, a lenient Cassette replays the last of them again.
+	match := -1
+	for i, in := range c.interactions {
+		if !in.Request.matches(rec) {
+			continue
+		}
+		if !c.played[i] {
+			match = i
+			break
+		}
+		if !c.strict {
+			match = i
+		}
+	}
+	if match < 0 {
+		if c.strict {
+			return nil, &UnmatchedRequestError{Method: rec.Method, Path: rec.Path}
+		}
+		return unmatchedResponse(req, rec), nil
+	}
+	c.played[match] = true
+
+	r := c.interactions[match].Response
+	body := r.BodyBase64
+	if body == nil {
+		body = []byte(r.Body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// unmatchedResponse returns a 404 response in the Backlog error format.
+func unmatchedResponse(req *http.Request, rec *recordedRequest) *http.Response {
+	return errorResponse(req, &apiError{
+		status:  http.StatusNotFound,
+		code:    backlog.ErrorCodeNoResource,
+		message: fmt.Sprintf("No recorded interaction for %s %s.", rec.Method, rec.Path),
+	}, nil)
+}
+
+// recordRequest captures the parts of req that are matched and stored,
+// leaving req.Body readable.
+func recordRequest(req *http.Request) (*recordedRequest, error) {
+	rec := &recordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Header: req.Header.Clone(),
+	}
+	if q := req.URL.Query(); len(q) > 0 {
+		if q.Has("apiKey") {
+			q.Set("apiKey", redacted)
+		}
+		rec.Query = q
+	}
+	if rec.Header.Get("Authorization") != "" {
+		rec.Header.Set("Authorization", redacted)
+	}
+	if len(rec.Header) == 0 {
+		rec.Header = nil
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return rec, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	form, err := parseBody(req.Header.Get("Content-Type"), body)
+	if err != nil {
+		return nil, err
+	}
+	if len(form) > 0 {
+		rec.Form = form
+	}
+	return rec, nil
+}
+
+// parseBody returns the form parameters of a URL-encoded or multipart body.
+// Multipart file parts are recorded as their file name.
+func parseBody(contentType string, body []byte) (url.Values, error) {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		return url.ParseQuery(string(body))
+	case strings.HasPrefix(mediaType, "multipart/"):
+		form := url.Values{}
+		mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				return form, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			if p.FileName() != "" {
+				form.Add(p.FormName(), p.FileName())
+				continue
+			}
+			v, err := io.ReadAll(p)
+			if err != nil {
+				return nil, err
+			}
+			form.Add(p.FormName(), string(v))
+		}
+	default:
+		return nil, nil
+	}
+}
+
+// matches reports whether r and other have the same method, path, query and
+// form. The apiKey parameter is not compared.
+func (r *recordedRequest) matches(other *recordedRequest) bool {
+	return r.Method == other.Method &&
+		r.Path == other.Path &&
+		equalValues(r.Query, other.Query, "apiKey") &&
+		equalValues(r.Form, other.Form, "")
+}
+
+func equalValues(a, b url.Values, ignore string) bool {
+	a, b = maps.Clone(a), maps.Clone(b)
+	delete(a, ignore)
+	delete(b, ignore)
+	return maps.EqualFunc(a, b, slices.Equal)
+}
+
+// isText reports whether a response body can be stored as a string.
+func isText(header http.Header, body []byte) bool {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if mediaType == "application/octet-stream" || strings.HasPrefix(header.Get("Content-Disposition"), "attachment") {
+		return false
+	}
+	return utf8.Valid(body)
+}
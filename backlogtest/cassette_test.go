@@ -0,0 +1,206 @@
+package backlogtest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/backlogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordCassette records a session against a fake server and returns the
+// cassette path. The fake server is closed before it returns.
+func recordCassette(t *testing.T, session func(c *backlog.Client), opts ...*backlog.ClientOption) string {
+	t.Helper()
+
+	srv := backlogtest.NewServer(backlogtest.WithToken("secret-token"))
+	defer srv.Close()
+	srv.AddProject("PRJ", "Project")
+	srv.AddIssue("PRJ", "first")
+	srv.AddIssue("PRJ", "second")
+
+	path := filepath.Join(t.TempDir(), "cassettes", "session.json")
+	rec := backlogtest.NewRecorder(path, srv.Client())
+	c, err := backlog.NewClient(srv.URL, "secret-token", append(opts, backlog.WithDoer(rec))...)
+	require.NoError(t, err)
+
+	session(c)
+	require.NoError(t, rec.Save())
+	return path
+}
+
+// replayClient returns a client that replays the cassette at path.
+func replayClient(t *testing.T, path string, opts ...backlogtest.CassetteOption) *backlog.Client {
+	t.Helper()
+
+	cas, err := backlogtest.LoadCassette(path, opts...)
+	require.NoError(t, err)
+	c, err := backlog.NewClient("https://example.backlog.com", "other-token", backlog.WithDoer(cas))
+	require.NoError(t, err)
+	return c
+}
+
+func TestCassette_Replay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := recordCassette(t, func(c *backlog.Client) {
+		_, err := c.Issue.List(ctx, c.Issue.Option.WithKeyword("first"))
+		require.NoError(t, err)
+		_, err = c.Issue.List(ctx, c.Issue.Option.WithKeyword("second"))
+		require.NoError(t, err)
+		_, err = c.Issue.Update(ctx, "PRJ-1", c.Issue.Option.WithSummary("updated"))
+		require.NoError(t, err)
+		_, err = c.Issue.One(ctx, "PRJ-99")
+		require.Error(t, err)
+	})
+
+	c := replayClient(t, path)
+
+	// Requests are matched on the query regardless of the recorded order.
+	issues, err := c.Issue.List(ctx, c.Issue.Option.WithKeyword("second"))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "PRJ-2", issues[0].IssueKey)
+
+	issues, err = c.Issue.List(ctx, c.Issue.Option.WithKeyword("first"))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "PRJ-1", issues[0].IssueKey)
+
+	// Requests are matched on the form.
+	issue, err := c.Issue.Update(ctx, "PRJ-1", c.Issue.Option.WithSummary("updated"))
+	require.NoError(t, err)
+	assert.Equal(t, "updated", issue.Summary)
+
+	_, err = c.Issue.Update(ctx, "PRJ-1", c.Issue.Option.WithSummary("other"))
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+
+	// Recorded errors are replayed.
+	_, err = c.Issue.One(ctx, "PRJ-99")
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+
+	// Interactions can be replayed more than once.
+	_, err = c.Issue.List(ctx, c.Issue.Option.WithKeyword("first"))
+	require.NoError(t, err)
+}
+
+func TestCassette_Strict(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := recordCassette(t, func(c *backlog.Client) {
+		_, err := c.Issue.Count(ctx)
+		require.NoError(t, err)
+	})
+
+	c := replayClient(t, path, backlogtest.WithStrictMatching())
+
+	count, err := c.Issue.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	var unmatched *backlogtest.UnmatchedRequestError
+	_, err = c.Issue.Count(ctx)
+	require.ErrorAs(t, err, &unmatched)
+	assert.Equal(t, http.MethodGet, unmatched.Method)
+	assert.Equal(t, "/api/v2/issues/count", unmatched.Path)
+
+	_, err = c.Issue.List(ctx)
+	require.ErrorAs(t, err, &unmatched)
+}
+
+func TestCassette_Scrub(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string][]*backlog.ClientOption{
+		"bearer":  nil,
+		"api-key": {backlog.WithAPIKey()},
+	}
+
+	for name, opts := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			path := recordCassette(t, func(c *backlog.Client) {
+				_, err := c.Issue.One(ctx, "PRJ-1")
+				require.NoError(t, err)
+			}, opts...)
+
+			b, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.NotContains(t, string(b), "secret-token")
+
+			// Replay matches whatever credential the client sends.
+			issue, err := replayClient(t, path).Issue.One(ctx, "PRJ-1")
+			require.NoError(t, err)
+			assert.Equal(t, "first", issue.Summary)
+		})
+	}
+}
+
+func TestCassette_BinaryBody(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := "\x89PNG\r\n\x1a\n\x00\xff"
+	var attachmentID int
+	path := recordCassette(t, func(c *backlog.Client) {
+		uploaded, err := c.Space.Attachment.Upload(ctx, "image.png", strings.NewReader(data))
+		require.NoError(t, err)
+		attachmentID = uploaded.ID
+		_, err = c.Issue.Update(ctx, "PRJ-1", c.Issue.Option.WithAttachmentIDs([]int{attachmentID}))
+		require.NoError(t, err)
+		_, err = c.Issue.Attachment.Download(ctx, "PRJ-1", attachmentID)
+		require.NoError(t, err)
+	})
+
+	c := replayClient(t, path, backlogtest.WithStrictMatching())
+
+	// Uploads are matched on the file name.
+	uploaded, err := c.Space.Attachment.Upload(ctx, "image.png", strings.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, attachmentID, uploaded.ID)
+
+	file, err := c.Issue.Attachment.Download(ctx, "PRJ-1", attachmentID)
+	require.NoError(t, err)
+	defer file.Body.Close()
+	body, err := io.ReadAll(file.Body)
+	require.NoError(t, err)
+	assert.Equal(t, data, string(body))
+	assert.Equal(t, "image.png", file.Filename)
+}
+
+func TestCassette_Canceled(t *testing.T) {
+	t.Parallel()
+
+	path := recordCassette(t, func(c *backlog.Client) {
+		_, err := c.Issue.Count(context.Background())
+		require.NoError(t, err)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := replayClient(t, path).Issue.Count(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLoadCassette_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := backlogtest.LoadCassette(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	path := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+	_, err = backlogtest.LoadCassette(path)
+	require.Error(t, err)
+}
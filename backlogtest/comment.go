@@ -0,0 +1,117 @@
+package backlogtest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+func (s *Server) commentParam(r *http.Request) (*issue, *model.Comment, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := pathInt(r, "commentId", "comment")
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range i.comments {
+		if c.ID == id {
+			return i, c, nil
+		}
+	}
+	return nil, nil, errNoResource("comment")
+}
+
+func (s *Server) listComments(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	minID, err := optionalInt(r, "minId", 0)
+	if err != nil {
+		return nil, err
+	}
+	maxID, err := optionalInt(r, "maxId", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []*model.Comment{}
+	for _, c := range i.comments {
+		if (minID == 0 || c.ID >= minID) && (maxID == 0 || c.ID <= maxID) {
+			matched = append(matched, c)
+		}
+	}
+	return page(r, matched, false)
+}
+
+func (s *Server) countComments(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return &countResult{Count: len(i.comments)}, nil
+}
+
+func (s *Server) getComment(r *http.Request) (any, error) {
+	_, c, err := s.commentParam(r)
+	return c, err
+}
+
+func (s *Server) addComment(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	content, err := requiredString(r, "content")
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := s.attachmentsParam(r)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.timestamp()
+	c := &model.Comment{
+		ID:          s.nextID("comment"),
+		Content:     content,
+		ChangeLogs:  s.applyIssueParams(i, &issueParams{attachments: attachments}),
+		CreatedUser: s.myself,
+		Created:     now,
+		Updated:     now,
+	}
+	i.comments = append(i.comments, c)
+	i.UpdatedUser = s.myself
+	i.Updated = now
+	return c, nil
+}
+
+func (s *Server) updateComment(r *http.Request) (any, error) {
+	_, c, err := s.commentParam(r)
+	if err != nil {
+		return nil, err
+	}
+	content, err := requiredString(r, "content")
+	if err != nil {
+		return nil, err
+	}
+	if c.CreatedUser != s.myself {
+		return nil, &apiError{status: http.StatusForbidden, code: backlog.ErrorCodeUnauthorizedOperation, message: "You do not have permission to edit this comment."}
+	}
+	c.Content = content
+	c.Updated = s.timestamp()
+	return c, nil
+}
+
+func (s *Server) deleteComment(r *http.Request) (any, error) {
+	i, c, err := s.commentParam(r)
+	if err != nil {
+		return nil, err
+	}
+	i.comments = slices.DeleteFunc(i.comments, func(v *model.Comment) bool { return v == c })
+	return c, nil
+}
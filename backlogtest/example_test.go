@@ -0,0 +1,41 @@
+package backlogtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/backlogtest"
+)
+
+func Example() {
+	srv := backlogtest.NewServer()
+	defer srv.Close()
+	projectID := srv.AddProject("PRJ", "Project")
+
+	c, _ := backlog.NewClient(srv.URL, "token")
+	ctx := context.Background()
+
+	issue, _ := c.Issue.Create(ctx, projectID, "Write docs", 1, backlog.PriorityNormal)
+	_, _ = c.Issue.Update(ctx, issue.IssueKey,
+		c.Issue.Option.WithStatusID(backlog.IssueStatusInProgress),
+		c.Issue.Option.WithComment("Started"),
+	)
+
+	issues, _ := c.Issue.List(ctx, c.Issue.Option.WithStatusIDs([]int{backlog.IssueStatusInProgress}))
+	fmt.Println(issues[0].IssueKey, issues[0].Status.Name)
+
+	comments, _ := c.Issue.Comment.List(ctx, issue.IssueKey)
+	fmt.Println(comments[0].Content, comments[0].ChangeLogs[0].Field)
+
+	_, err := c.Issue.One(ctx, "PRJ-99")
+	var apiErr *backlog.APIResponseError
+	if errors.As(err, &apiErr) {
+		fmt.Println(apiErr.StatusCode(), apiErr.Errors()[0].Message)
+	}
+	// Output:
+	// PRJ-1 In Progress
+	// Started status
+	// 404 No issue.
+}
@@ -0,0 +1,72 @@
+package backlogtest
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+//go:generate go run ./internal/fakegen -src ../api.go -out fake_gen.go
+
+// Every service interface of the backlog package, such as [backlog.IssueAPI],
+// has a fake named after it, such as [FakeIssueAPI]. Set the Func fields for
+// the methods a test expects to be called:
+//
+//	issues := &backlogtest.FakeIssueAPI{
+//		OneFunc: func(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error) {
+//			return &backlog.Issue{IssueKey: issueIDOrKey}, nil
+//		},
+//	}
+//
+// Calling a method whose Func field is nil panics. Fakes record their calls,
+// which can be inspected with Calls and CallCount.
+
+// Call is a call made to a fake.
+type Call struct {
+	Method string
+	// Args holds the arguments in order. A variadic parameter is recorded
+	// as a single slice.
+	Args []any
+}
+
+// callRecorder records the calls made to a fake. It is embedded in every
+// fake, which makes its methods safe for concurrent use.
+type callRecorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *callRecorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made to the method with the given name, in the
+// order they were made. An empty name returns the calls to all methods.
+func (r *callRecorder) Calls(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if method == "" {
+		return slices.Clone(r.calls)
+	}
+	var out []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CallCount returns the number of calls made to the method with the given
+// name.
+func (r *callRecorder) CallCount(method string) int {
+	return len(r.Calls(method))
+}
+
+// unset returns the panic message for a call to a method whose Func field
+// is nil.
+func unset(fake, method string) string {
+	return fmt.Sprintf("backlogtest: %s.%s called but %sFunc is not set", fake, method, method)
+}
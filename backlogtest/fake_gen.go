@@ -0,0 +1,2065 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package backlogtest
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/url"
+
+	"github.com/nattokin/go-backlog"
+)
+
+var (
+	_ backlog.IssueAPI                 = (*FakeIssueAPI)(nil)
+	_ backlog.IssueAttachmentAPI       = (*FakeIssueAttachmentAPI)(nil)
+	_ backlog.IssueCommentAPI          = (*FakeIssueCommentAPI)(nil)
+	_ backlog.IssueSharedFileAPI       = (*FakeIssueSharedFileAPI)(nil)
+	_ backlog.IssueStarAPI             = (*FakeIssueStarAPI)(nil)
+	_ backlog.NotificationAPI          = (*FakeNotificationAPI)(nil)
+	_ backlog.ProjectAPI               = (*FakeProjectAPI)(nil)
+	_ backlog.ProjectActivityAPI       = (*FakeProjectActivityAPI)(nil)
+	_ backlog.ProjectCategoryAPI       = (*FakeProjectCategoryAPI)(nil)
+	_ backlog.ProjectCustomFieldAPI    = (*FakeProjectCustomFieldAPI)(nil)
+	_ backlog.ProjectIssueTypeAPI      = (*FakeProjectIssueTypeAPI)(nil)
+	_ backlog.ProjectSharedFileAPI     = (*FakeProjectSharedFileAPI)(nil)
+	_ backlog.ProjectStatusAPI         = (*FakeProjectStatusAPI)(nil)
+	_ backlog.ProjectTeamAPI           = (*FakeProjectTeamAPI)(nil)
+	_ backlog.ProjectUserAPI           = (*FakeProjectUserAPI)(nil)
+	_ backlog.ProjectVersionAPI        = (*FakeProjectVersionAPI)(nil)
+	_ backlog.ProjectWebhookAPI        = (*FakeProjectWebhookAPI)(nil)
+	_ backlog.PullRequestAPI           = (*FakePullRequestAPI)(nil)
+	_ backlog.PullRequestAttachmentAPI = (*FakePullRequestAttachmentAPI)(nil)
+	_ backlog.PullRequestCommentAPI    = (*FakePullRequestCommentAPI)(nil)
+	_ backlog.PullRequestStarAPI       = (*FakePullRequestStarAPI)(nil)
+	_ backlog.RawAPI                   = (*FakeRawAPI)(nil)
+	_ backlog.RecentlyViewedAPI        = (*FakeRecentlyViewedAPI)(nil)
+	_ backlog.RepositoryAPI            = (*FakeRepositoryAPI)(nil)
+	_ backlog.SpaceAPI                 = (*FakeSpaceAPI)(nil)
+	_ backlog.SpaceActivityAPI         = (*FakeSpaceActivityAPI)(nil)
+	_ backlog.SpaceAttachmentAPI       = (*FakeSpaceAttachmentAPI)(nil)
+	_ backlog.SpacePriorityAPI         = (*FakeSpacePriorityAPI)(nil)
+	_ backlog.SpaceResolutionAPI       = (*FakeSpaceResolutionAPI)(nil)
+	_ backlog.StarAPI                  = (*FakeStarAPI)(nil)
+	_ backlog.TeamAPI                  = (*FakeTeamAPI)(nil)
+	_ backlog.UserAPI                  = (*FakeUserAPI)(nil)
+	_ backlog.UserActivityAPI          = (*FakeUserActivityAPI)(nil)
+	_ backlog.UserStarAPI              = (*FakeUserStarAPI)(nil)
+	_ backlog.WatchingAPI              = (*FakeWatchingAPI)(nil)
+	_ backlog.WikiAPI                  = (*FakeWikiAPI)(nil)
+	_ backlog.WikiAttachmentAPI        = (*FakeWikiAttachmentAPI)(nil)
+	_ backlog.WikiHistoryAPI           = (*FakeWikiHistoryAPI)(nil)
+	_ backlog.WikiSharedFileAPI        = (*FakeWikiSharedFileAPI)(nil)
+	_ backlog.WikiStarAPI              = (*FakeWikiStarAPI)(nil)
+)
+
+// FakeIssueAPI is a fake implementation of [backlog.IssueAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeIssueAPI struct {
+	callRecorder
+
+	ListFunc           func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Issue, error)
+	AllFunc            func(ctx context.Context, perPage int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Issue, error], error)
+	AllPrefetchFunc    func(ctx context.Context, perPage int, prefetch int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Issue, error], error)
+	CountFunc          func(ctx context.Context, opts ...backlog.RequestOption) (int, error)
+	OneFunc            func(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error)
+	CreateFunc         func(ctx context.Context, projectID int, summary string, issueTypeID int, priorityID int, opts ...backlog.RequestOption) (*backlog.Issue, error)
+	UpdateFunc         func(ctx context.Context, issueIDOrKey string, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Issue, error)
+	DeleteFunc         func(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error)
+	ParticipantsFunc   func(ctx context.Context, issueIDOrKey string) ([]*backlog.User, error)
+	BulkUpdateFunc     func(ctx context.Context, concurrency int, issueIDOrKeys []string, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.BulkUpdateReport, error)
+	BulkUpdateEachFunc func(ctx context.Context, concurrency int, updates []*backlog.IssueUpdate) (*backlog.BulkUpdateReport, error)
+}
+
+// List calls ListFunc.
+func (f *FakeIssueAPI) List(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Issue, error) {
+	f.record("List", ctx, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeIssueAPI", "List"))
+	}
+	return f.ListFunc(ctx, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeIssueAPI) All(ctx context.Context, perPage int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Issue, error], error) {
+	f.record("All", ctx, perPage, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeIssueAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, opts...)
+}
+
+// AllPrefetch calls AllPrefetchFunc.
+func (f *FakeIssueAPI) AllPrefetch(ctx context.Context, perPage int, prefetch int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Issue, error], error) {
+	f.record("AllPrefetch", ctx, perPage, prefetch, opts)
+	if f.AllPrefetchFunc == nil {
+		panic(unset("FakeIssueAPI", "AllPrefetch"))
+	}
+	return f.AllPrefetchFunc(ctx, perPage, prefetch, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakeIssueAPI) Count(ctx context.Context, opts ...backlog.RequestOption) (int, error) {
+	f.record("Count", ctx, opts)
+	if f.CountFunc == nil {
+		panic(unset("FakeIssueAPI", "Count"))
+	}
+	return f.CountFunc(ctx, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakeIssueAPI) One(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error) {
+	f.record("One", ctx, issueIDOrKey)
+	if f.OneFunc == nil {
+		panic(unset("FakeIssueAPI", "One"))
+	}
+	return f.OneFunc(ctx, issueIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeIssueAPI) Create(ctx context.Context, projectID int, summary string, issueTypeID int, priorityID int, opts ...backlog.RequestOption) (*backlog.Issue, error) {
+	f.record("Create", ctx, projectID, summary, issueTypeID, priorityID, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeIssueAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectID, summary, issueTypeID, priorityID, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeIssueAPI) Update(ctx context.Context, issueIDOrKey string, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Issue, error) {
+	f.record("Update", ctx, issueIDOrKey, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeIssueAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, issueIDOrKey, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeIssueAPI) Delete(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error) {
+	f.record("Delete", ctx, issueIDOrKey)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeIssueAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, issueIDOrKey)
+}
+
+// Participants calls ParticipantsFunc.
+func (f *FakeIssueAPI) Participants(ctx context.Context, issueIDOrKey string) ([]*backlog.User, error) {
+	f.record("Participants", ctx, issueIDOrKey)
+	if f.ParticipantsFunc == nil {
+		panic(unset("FakeIssueAPI", "Participants"))
+	}
+	return f.ParticipantsFunc(ctx, issueIDOrKey)
+}
+
+// BulkUpdate calls BulkUpdateFunc.
+func (f *FakeIssueAPI) BulkUpdate(ctx context.Context, concurrency int, issueIDOrKeys []string, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.BulkUpdateReport, error) {
+	f.record("BulkUpdate", ctx, concurrency, issueIDOrKeys, option, opts)
+	if f.BulkUpdateFunc == nil {
+		panic(unset("FakeIssueAPI", "BulkUpdate"))
+	}
+	return f.BulkUpdateFunc(ctx, concurrency, issueIDOrKeys, option, opts...)
+}
+
+// BulkUpdateEach calls BulkUpdateEachFunc.
+func (f *FakeIssueAPI) BulkUpdateEach(ctx context.Context, concurrency int, updates []*backlog.IssueUpdate) (*backlog.BulkUpdateReport, error) {
+	f.record("BulkUpdateEach", ctx, concurrency, updates)
+	if f.BulkUpdateEachFunc == nil {
+		panic(unset("FakeIssueAPI", "BulkUpdateEach"))
+	}
+	return f.BulkUpdateEachFunc(ctx, concurrency, updates)
+}
+
+// FakeIssueAttachmentAPI is a fake implementation of [backlog.IssueAttachmentAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeIssueAttachmentAPI struct {
+	callRecorder
+
+	ListFunc     func(ctx context.Context, issueIDOrKey string) ([]*backlog.Attachment, error)
+	RemoveFunc   func(ctx context.Context, issueIDOrKey string, attachmentID int) (*backlog.Attachment, error)
+	DownloadFunc func(ctx context.Context, issueIDOrKey string, attachmentID int) (*backlog.FileData, error)
+}
+
+// List calls ListFunc.
+func (f *FakeIssueAttachmentAPI) List(ctx context.Context, issueIDOrKey string) ([]*backlog.Attachment, error) {
+	f.record("List", ctx, issueIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeIssueAttachmentAPI", "List"))
+	}
+	return f.ListFunc(ctx, issueIDOrKey)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakeIssueAttachmentAPI) Remove(ctx context.Context, issueIDOrKey string, attachmentID int) (*backlog.Attachment, error) {
+	f.record("Remove", ctx, issueIDOrKey, attachmentID)
+	if f.RemoveFunc == nil {
+		panic(unset("FakeIssueAttachmentAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, issueIDOrKey, attachmentID)
+}
+
+// Download calls DownloadFunc.
+func (f *FakeIssueAttachmentAPI) Download(ctx context.Context, issueIDOrKey string, attachmentID int) (*backlog.FileData, error) {
+	f.record("Download", ctx, issueIDOrKey, attachmentID)
+	if f.DownloadFunc == nil {
+		panic(unset("FakeIssueAttachmentAPI", "Download"))
+	}
+	return f.DownloadFunc(ctx, issueIDOrKey, attachmentID)
+}
+
+// FakeIssueCommentAPI is a fake implementation of [backlog.IssueCommentAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeIssueCommentAPI struct {
+	callRecorder
+
+	ListFunc          func(ctx context.Context, issueIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Comment, error)
+	AllFunc           func(ctx context.Context, perPage int, issueIDOrKey string, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Comment, error], error)
+	AddFunc           func(ctx context.Context, issueIDOrKey string, content string, opts ...backlog.RequestOption) (*backlog.Comment, error)
+	CountFunc         func(ctx context.Context, issueIDOrKey string) (int, error)
+	OneFunc           func(ctx context.Context, issueIDOrKey string, commentID int) (*backlog.Comment, error)
+	DeleteFunc        func(ctx context.Context, issueIDOrKey string, commentID int) (*backlog.Comment, error)
+	UpdateFunc        func(ctx context.Context, issueIDOrKey string, commentID int, content string) (*backlog.Comment, error)
+	NotificationsFunc func(ctx context.Context, issueIDOrKey string, commentID int) ([]*backlog.Notification, error)
+	NotifyFunc        func(ctx context.Context, issueIDOrKey string, commentID int, userIDs []int) (*backlog.Comment, error)
+}
+
+// List calls ListFunc.
+func (f *FakeIssueCommentAPI) List(ctx context.Context, issueIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Comment, error) {
+	f.record("List", ctx, issueIDOrKey, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "List"))
+	}
+	return f.ListFunc(ctx, issueIDOrKey, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeIssueCommentAPI) All(ctx context.Context, perPage int, issueIDOrKey string, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Comment, error], error) {
+	f.record("All", ctx, perPage, issueIDOrKey, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, issueIDOrKey, opts...)
+}
+
+// Add calls AddFunc.
+func (f *FakeIssueCommentAPI) Add(ctx context.Context, issueIDOrKey string, content string, opts ...backlog.RequestOption) (*backlog.Comment, error) {
+	f.record("Add", ctx, issueIDOrKey, content, opts)
+	if f.AddFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "Add"))
+	}
+	return f.AddFunc(ctx, issueIDOrKey, content, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakeIssueCommentAPI) Count(ctx context.Context, issueIDOrKey string) (int, error) {
+	f.record("Count", ctx, issueIDOrKey)
+	if f.CountFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "Count"))
+	}
+	return f.CountFunc(ctx, issueIDOrKey)
+}
+
+// One calls OneFunc.
+func (f *FakeIssueCommentAPI) One(ctx context.Context, issueIDOrKey string, commentID int) (*backlog.Comment, error) {
+	f.record("One", ctx, issueIDOrKey, commentID)
+	if f.OneFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "One"))
+	}
+	return f.OneFunc(ctx, issueIDOrKey, commentID)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeIssueCommentAPI) Delete(ctx context.Context, issueIDOrKey string, commentID int) (*backlog.Comment, error) {
+	f.record("Delete", ctx, issueIDOrKey, commentID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, issueIDOrKey, commentID)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeIssueCommentAPI) Update(ctx context.Context, issueIDOrKey string, commentID int, content string) (*backlog.Comment, error) {
+	f.record("Update", ctx, issueIDOrKey, commentID, content)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, issueIDOrKey, commentID, content)
+}
+
+// Notifications calls NotificationsFunc.
+func (f *FakeIssueCommentAPI) Notifications(ctx context.Context, issueIDOrKey string, commentID int) ([]*backlog.Notification, error) {
+	f.record("Notifications", ctx, issueIDOrKey, commentID)
+	if f.NotificationsFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "Notifications"))
+	}
+	return f.NotificationsFunc(ctx, issueIDOrKey, commentID)
+}
+
+// Notify calls NotifyFunc.
+func (f *FakeIssueCommentAPI) Notify(ctx context.Context, issueIDOrKey string, commentID int, userIDs []int) (*backlog.Comment, error) {
+	f.record("Notify", ctx, issueIDOrKey, commentID, userIDs)
+	if f.NotifyFunc == nil {
+		panic(unset("FakeIssueCommentAPI", "Notify"))
+	}
+	return f.NotifyFunc(ctx, issueIDOrKey, commentID, userIDs)
+}
+
+// FakeIssueSharedFileAPI is a fake implementation of [backlog.IssueSharedFileAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeIssueSharedFileAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, issueIDOrKey string) ([]*backlog.SharedFile, error)
+	LinkFunc   func(ctx context.Context, issueIDOrKey string, fileIDs []int) ([]*backlog.SharedFile, error)
+	UnlinkFunc func(ctx context.Context, issueIDOrKey string, fileID int) (*backlog.SharedFile, error)
+}
+
+// List calls ListFunc.
+func (f *FakeIssueSharedFileAPI) List(ctx context.Context, issueIDOrKey string) ([]*backlog.SharedFile, error) {
+	f.record("List", ctx, issueIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeIssueSharedFileAPI", "List"))
+	}
+	return f.ListFunc(ctx, issueIDOrKey)
+}
+
+// Link calls LinkFunc.
+func (f *FakeIssueSharedFileAPI) Link(ctx context.Context, issueIDOrKey string, fileIDs []int) ([]*backlog.SharedFile, error) {
+	f.record("Link", ctx, issueIDOrKey, fileIDs)
+	if f.LinkFunc == nil {
+		panic(unset("FakeIssueSharedFileAPI", "Link"))
+	}
+	return f.LinkFunc(ctx, issueIDOrKey, fileIDs)
+}
+
+// Unlink calls UnlinkFunc.
+func (f *FakeIssueSharedFileAPI) Unlink(ctx context.Context, issueIDOrKey string, fileID int) (*backlog.SharedFile, error) {
+	f.record("Unlink", ctx, issueIDOrKey, fileID)
+	if f.UnlinkFunc == nil {
+		panic(unset("FakeIssueSharedFileAPI", "Unlink"))
+	}
+	return f.UnlinkFunc(ctx, issueIDOrKey, fileID)
+}
+
+// FakeIssueStarAPI is a fake implementation of [backlog.IssueStarAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeIssueStarAPI struct {
+	callRecorder
+
+	AddFunc    func(ctx context.Context, issueID int) error
+	RemoveFunc func(ctx context.Context, starID int) error
+}
+
+// Add calls AddFunc.
+func (f *FakeIssueStarAPI) Add(ctx context.Context, issueID int) error {
+	f.record("Add", ctx, issueID)
+	if f.AddFunc == nil {
+		panic(unset("FakeIssueStarAPI", "Add"))
+	}
+	return f.AddFunc(ctx, issueID)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakeIssueStarAPI) Remove(ctx context.Context, starID int) error {
+	f.record("Remove", ctx, starID)
+	if f.RemoveFunc == nil {
+		panic(unset("FakeIssueStarAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, starID)
+}
+
+// FakeNotificationAPI is a fake implementation of [backlog.NotificationAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeNotificationAPI struct {
+	callRecorder
+
+	ListFunc             func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Notification, error)
+	AllFunc              func(ctx context.Context, perPage int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Notification, error], error)
+	CountFunc            func(ctx context.Context, opts ...backlog.RequestOption) (int, error)
+	ResetUnreadCountFunc func(ctx context.Context) (int, error)
+	MarkAsReadFunc       func(ctx context.Context, notificationID int) error
+}
+
+// List calls ListFunc.
+func (f *FakeNotificationAPI) List(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Notification, error) {
+	f.record("List", ctx, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeNotificationAPI", "List"))
+	}
+	return f.ListFunc(ctx, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeNotificationAPI) All(ctx context.Context, perPage int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Notification, error], error) {
+	f.record("All", ctx, perPage, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeNotificationAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakeNotificationAPI) Count(ctx context.Context, opts ...backlog.RequestOption) (int, error) {
+	f.record("Count", ctx, opts)
+	if f.CountFunc == nil {
+		panic(unset("FakeNotificationAPI", "Count"))
+	}
+	return f.CountFunc(ctx, opts...)
+}
+
+// ResetUnreadCount calls ResetUnreadCountFunc.
+func (f *FakeNotificationAPI) ResetUnreadCount(ctx context.Context) (int, error) {
+	f.record("ResetUnreadCount", ctx)
+	if f.ResetUnreadCountFunc == nil {
+		panic(unset("FakeNotificationAPI", "ResetUnreadCount"))
+	}
+	return f.ResetUnreadCountFunc(ctx)
+}
+
+// MarkAsRead calls MarkAsReadFunc.
+func (f *FakeNotificationAPI) MarkAsRead(ctx context.Context, notificationID int) error {
+	f.record("MarkAsRead", ctx, notificationID)
+	if f.MarkAsReadFunc == nil {
+		panic(unset("FakeNotificationAPI", "MarkAsRead"))
+	}
+	return f.MarkAsReadFunc(ctx, notificationID)
+}
+
+// FakeProjectAPI is a fake implementation of [backlog.ProjectAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectAPI struct {
+	callRecorder
+
+	ListFunc      func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Project, error)
+	OneFunc       func(ctx context.Context, projectIDOrKey string) (*backlog.Project, error)
+	CreateFunc    func(ctx context.Context, key string, name string, opts ...backlog.RequestOption) (*backlog.Project, error)
+	UpdateFunc    func(ctx context.Context, projectIDOrKey string, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Project, error)
+	DeleteFunc    func(ctx context.Context, projectIDOrKey string) (*backlog.Project, error)
+	DiskUsageFunc func(ctx context.Context, projectIDOrKey string) (*backlog.DiskUsageProject, error)
+	IconFunc      func(ctx context.Context, projectIDOrKey string) (*backlog.FileData, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectAPI) List(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Project, error) {
+	f.record("List", ctx, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectAPI", "List"))
+	}
+	return f.ListFunc(ctx, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakeProjectAPI) One(ctx context.Context, projectIDOrKey string) (*backlog.Project, error) {
+	f.record("One", ctx, projectIDOrKey)
+	if f.OneFunc == nil {
+		panic(unset("FakeProjectAPI", "One"))
+	}
+	return f.OneFunc(ctx, projectIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectAPI) Create(ctx context.Context, key string, name string, opts ...backlog.RequestOption) (*backlog.Project, error) {
+	f.record("Create", ctx, key, name, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, key, name, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectAPI) Update(ctx context.Context, projectIDOrKey string, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Project, error) {
+	f.record("Update", ctx, projectIDOrKey, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectAPI) Delete(ctx context.Context, projectIDOrKey string) (*backlog.Project, error) {
+	f.record("Delete", ctx, projectIDOrKey)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey)
+}
+
+// DiskUsage calls DiskUsageFunc.
+func (f *FakeProjectAPI) DiskUsage(ctx context.Context, projectIDOrKey string) (*backlog.DiskUsageProject, error) {
+	f.record("DiskUsage", ctx, projectIDOrKey)
+	if f.DiskUsageFunc == nil {
+		panic(unset("FakeProjectAPI", "DiskUsage"))
+	}
+	return f.DiskUsageFunc(ctx, projectIDOrKey)
+}
+
+// Icon calls IconFunc.
+func (f *FakeProjectAPI) Icon(ctx context.Context, projectIDOrKey string) (*backlog.FileData, error) {
+	f.record("Icon", ctx, projectIDOrKey)
+	if f.IconFunc == nil {
+		panic(unset("FakeProjectAPI", "Icon"))
+	}
+	return f.IconFunc(ctx, projectIDOrKey)
+}
+
+// FakeProjectActivityAPI is a fake implementation of [backlog.ProjectActivityAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectActivityAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Activity, error)
+	AllFunc  func(ctx context.Context, perPage int, projectIDOrKey string, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Activity, error], error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectActivityAPI) List(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Activity, error) {
+	f.record("List", ctx, projectIDOrKey, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectActivityAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeProjectActivityAPI) All(ctx context.Context, perPage int, projectIDOrKey string, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Activity, error], error) {
+	f.record("All", ctx, perPage, projectIDOrKey, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeProjectActivityAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, projectIDOrKey, opts...)
+}
+
+// FakeProjectCategoryAPI is a fake implementation of [backlog.ProjectCategoryAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectCategoryAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string) ([]*backlog.Category, error)
+	CreateFunc func(ctx context.Context, projectIDOrKey string, name string) (*backlog.Category, error)
+	UpdateFunc func(ctx context.Context, projectIDOrKey string, categoryID int, name string) (*backlog.Category, error)
+	DeleteFunc func(ctx context.Context, projectIDOrKey string, categoryID int) (*backlog.Category, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectCategoryAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.Category, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectCategoryAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectCategoryAPI) Create(ctx context.Context, projectIDOrKey string, name string) (*backlog.Category, error) {
+	f.record("Create", ctx, projectIDOrKey, name)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectCategoryAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, name)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectCategoryAPI) Update(ctx context.Context, projectIDOrKey string, categoryID int, name string) (*backlog.Category, error) {
+	f.record("Update", ctx, projectIDOrKey, categoryID, name)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectCategoryAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, categoryID, name)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectCategoryAPI) Delete(ctx context.Context, projectIDOrKey string, categoryID int) (*backlog.Category, error) {
+	f.record("Delete", ctx, projectIDOrKey, categoryID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectCategoryAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, categoryID)
+}
+
+// FakeProjectCustomFieldAPI is a fake implementation of [backlog.ProjectCustomFieldAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectCustomFieldAPI struct {
+	callRecorder
+
+	ListFunc           func(ctx context.Context, projectIDOrKey string) ([]*backlog.CustomField, error)
+	CreateFunc         func(ctx context.Context, projectIDOrKey string, fieldType backlog.CustomFieldType, name string, opts ...backlog.RequestOption) (*backlog.CustomField, error)
+	UpdateFunc         func(ctx context.Context, projectIDOrKey string, customFieldID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.CustomField, error)
+	DeleteFunc         func(ctx context.Context, projectIDOrKey string, customFieldID int) (*backlog.CustomField, error)
+	AddListItemFunc    func(ctx context.Context, projectIDOrKey string, customFieldID int, name string) (*backlog.CustomField, error)
+	UpdateListItemFunc func(ctx context.Context, projectIDOrKey string, customFieldID int, itemID int, name string) (*backlog.CustomField, error)
+	DeleteListItemFunc func(ctx context.Context, projectIDOrKey string, customFieldID int, itemID int) (*backlog.CustomField, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectCustomFieldAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.CustomField, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectCustomFieldAPI) Create(ctx context.Context, projectIDOrKey string, fieldType backlog.CustomFieldType, name string, opts ...backlog.RequestOption) (*backlog.CustomField, error) {
+	f.record("Create", ctx, projectIDOrKey, fieldType, name, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, fieldType, name, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectCustomFieldAPI) Update(ctx context.Context, projectIDOrKey string, customFieldID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.CustomField, error) {
+	f.record("Update", ctx, projectIDOrKey, customFieldID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, customFieldID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectCustomFieldAPI) Delete(ctx context.Context, projectIDOrKey string, customFieldID int) (*backlog.CustomField, error) {
+	f.record("Delete", ctx, projectIDOrKey, customFieldID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, customFieldID)
+}
+
+// AddListItem calls AddListItemFunc.
+func (f *FakeProjectCustomFieldAPI) AddListItem(ctx context.Context, projectIDOrKey string, customFieldID int, name string) (*backlog.CustomField, error) {
+	f.record("AddListItem", ctx, projectIDOrKey, customFieldID, name)
+	if f.AddListItemFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "AddListItem"))
+	}
+	return f.AddListItemFunc(ctx, projectIDOrKey, customFieldID, name)
+}
+
+// UpdateListItem calls UpdateListItemFunc.
+func (f *FakeProjectCustomFieldAPI) UpdateListItem(ctx context.Context, projectIDOrKey string, customFieldID int, itemID int, name string) (*backlog.CustomField, error) {
+	f.record("UpdateListItem", ctx, projectIDOrKey, customFieldID, itemID, name)
+	if f.UpdateListItemFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "UpdateListItem"))
+	}
+	return f.UpdateListItemFunc(ctx, projectIDOrKey, customFieldID, itemID, name)
+}
+
+// DeleteListItem calls DeleteListItemFunc.
+func (f *FakeProjectCustomFieldAPI) DeleteListItem(ctx context.Context, projectIDOrKey string, customFieldID int, itemID int) (*backlog.CustomField, error) {
+	f.record("DeleteListItem", ctx, projectIDOrKey, customFieldID, itemID)
+	if f.DeleteListItemFunc == nil {
+		panic(unset("FakeProjectCustomFieldAPI", "DeleteListItem"))
+	}
+	return f.DeleteListItemFunc(ctx, projectIDOrKey, customFieldID, itemID)
+}
+
+// FakeProjectIssueTypeAPI is a fake implementation of [backlog.ProjectIssueTypeAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectIssueTypeAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string) ([]*backlog.IssueType, error)
+	CreateFunc func(ctx context.Context, projectIDOrKey string, name string, color string, opts ...backlog.RequestOption) (*backlog.IssueType, error)
+	UpdateFunc func(ctx context.Context, projectIDOrKey string, issueTypeID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.IssueType, error)
+	DeleteFunc func(ctx context.Context, projectIDOrKey string, issueTypeID int, substituteIssueTypeID int) (*backlog.IssueType, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectIssueTypeAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.IssueType, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectIssueTypeAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectIssueTypeAPI) Create(ctx context.Context, projectIDOrKey string, name string, color string, opts ...backlog.RequestOption) (*backlog.IssueType, error) {
+	f.record("Create", ctx, projectIDOrKey, name, color, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectIssueTypeAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, name, color, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectIssueTypeAPI) Update(ctx context.Context, projectIDOrKey string, issueTypeID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.IssueType, error) {
+	f.record("Update", ctx, projectIDOrKey, issueTypeID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectIssueTypeAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, issueTypeID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectIssueTypeAPI) Delete(ctx context.Context, projectIDOrKey string, issueTypeID int, substituteIssueTypeID int) (*backlog.IssueType, error) {
+	f.record("Delete", ctx, projectIDOrKey, issueTypeID, substituteIssueTypeID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectIssueTypeAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, issueTypeID, substituteIssueTypeID)
+}
+
+// FakeProjectSharedFileAPI is a fake implementation of [backlog.ProjectSharedFileAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectSharedFileAPI struct {
+	callRecorder
+
+	ListFunc     func(ctx context.Context, projectIDOrKey string) ([]*backlog.SharedFile, error)
+	DownloadFunc func(ctx context.Context, projectIDOrKey string, sharedFileID int) (*backlog.FileData, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectSharedFileAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.SharedFile, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectSharedFileAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Download calls DownloadFunc.
+func (f *FakeProjectSharedFileAPI) Download(ctx context.Context, projectIDOrKey string, sharedFileID int) (*backlog.FileData, error) {
+	f.record("Download", ctx, projectIDOrKey, sharedFileID)
+	if f.DownloadFunc == nil {
+		panic(unset("FakeProjectSharedFileAPI", "Download"))
+	}
+	return f.DownloadFunc(ctx, projectIDOrKey, sharedFileID)
+}
+
+// FakeProjectStatusAPI is a fake implementation of [backlog.ProjectStatusAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectStatusAPI struct {
+	callRecorder
+
+	ListFunc        func(ctx context.Context, projectIDOrKey string) ([]*backlog.Status, error)
+	CreateFunc      func(ctx context.Context, projectIDOrKey string, name string, color string) (*backlog.Status, error)
+	UpdateFunc      func(ctx context.Context, projectIDOrKey string, statusID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Status, error)
+	DeleteFunc      func(ctx context.Context, projectIDOrKey string, statusID int, substituteStatusID int) (*backlog.Status, error)
+	UpdateOrderFunc func(ctx context.Context, projectIDOrKey string, statusIDs []int) ([]*backlog.Status, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectStatusAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.Status, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectStatusAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectStatusAPI) Create(ctx context.Context, projectIDOrKey string, name string, color string) (*backlog.Status, error) {
+	f.record("Create", ctx, projectIDOrKey, name, color)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectStatusAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, name, color)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectStatusAPI) Update(ctx context.Context, projectIDOrKey string, statusID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Status, error) {
+	f.record("Update", ctx, projectIDOrKey, statusID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectStatusAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, statusID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectStatusAPI) Delete(ctx context.Context, projectIDOrKey string, statusID int, substituteStatusID int) (*backlog.Status, error) {
+	f.record("Delete", ctx, projectIDOrKey, statusID, substituteStatusID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectStatusAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, statusID, substituteStatusID)
+}
+
+// UpdateOrder calls UpdateOrderFunc.
+func (f *FakeProjectStatusAPI) UpdateOrder(ctx context.Context, projectIDOrKey string, statusIDs []int) ([]*backlog.Status, error) {
+	f.record("UpdateOrder", ctx, projectIDOrKey, statusIDs)
+	if f.UpdateOrderFunc == nil {
+		panic(unset("FakeProjectStatusAPI", "UpdateOrder"))
+	}
+	return f.UpdateOrderFunc(ctx, projectIDOrKey, statusIDs)
+}
+
+// FakeProjectTeamAPI is a fake implementation of [backlog.ProjectTeamAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectTeamAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string) ([]*backlog.Team, error)
+	AddFunc    func(ctx context.Context, projectIDOrKey string, teamID int) (*backlog.Team, error)
+	DeleteFunc func(ctx context.Context, projectIDOrKey string, teamID int) (*backlog.Team, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectTeamAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.Team, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectTeamAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Add calls AddFunc.
+func (f *FakeProjectTeamAPI) Add(ctx context.Context, projectIDOrKey string, teamID int) (*backlog.Team, error) {
+	f.record("Add", ctx, projectIDOrKey, teamID)
+	if f.AddFunc == nil {
+		panic(unset("FakeProjectTeamAPI", "Add"))
+	}
+	return f.AddFunc(ctx, projectIDOrKey, teamID)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectTeamAPI) Delete(ctx context.Context, projectIDOrKey string, teamID int) (*backlog.Team, error) {
+	f.record("Delete", ctx, projectIDOrKey, teamID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectTeamAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, teamID)
+}
+
+// FakeProjectUserAPI is a fake implementation of [backlog.ProjectUserAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectUserAPI struct {
+	callRecorder
+
+	ListFunc        func(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.User, error)
+	AddFunc         func(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error)
+	DeleteFunc      func(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error)
+	AddAdminFunc    func(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error)
+	AdminListFunc   func(ctx context.Context, projectIDOrKey string) ([]*backlog.User, error)
+	DeleteAdminFunc func(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectUserAPI) List(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.User, error) {
+	f.record("List", ctx, projectIDOrKey, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectUserAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, opts...)
+}
+
+// Add calls AddFunc.
+func (f *FakeProjectUserAPI) Add(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	f.record("Add", ctx, projectIDOrKey, userID)
+	if f.AddFunc == nil {
+		panic(unset("FakeProjectUserAPI", "Add"))
+	}
+	return f.AddFunc(ctx, projectIDOrKey, userID)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectUserAPI) Delete(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	f.record("Delete", ctx, projectIDOrKey, userID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectUserAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, userID)
+}
+
+// AddAdmin calls AddAdminFunc.
+func (f *FakeProjectUserAPI) AddAdmin(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	f.record("AddAdmin", ctx, projectIDOrKey, userID)
+	if f.AddAdminFunc == nil {
+		panic(unset("FakeProjectUserAPI", "AddAdmin"))
+	}
+	return f.AddAdminFunc(ctx, projectIDOrKey, userID)
+}
+
+// AdminList calls AdminListFunc.
+func (f *FakeProjectUserAPI) AdminList(ctx context.Context, projectIDOrKey string) ([]*backlog.User, error) {
+	f.record("AdminList", ctx, projectIDOrKey)
+	if f.AdminListFunc == nil {
+		panic(unset("FakeProjectUserAPI", "AdminList"))
+	}
+	return f.AdminListFunc(ctx, projectIDOrKey)
+}
+
+// DeleteAdmin calls DeleteAdminFunc.
+func (f *FakeProjectUserAPI) DeleteAdmin(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	f.record("DeleteAdmin", ctx, projectIDOrKey, userID)
+	if f.DeleteAdminFunc == nil {
+		panic(unset("FakeProjectUserAPI", "DeleteAdmin"))
+	}
+	return f.DeleteAdminFunc(ctx, projectIDOrKey, userID)
+}
+
+// FakeProjectVersionAPI is a fake implementation of [backlog.ProjectVersionAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectVersionAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Version, error)
+	CreateFunc func(ctx context.Context, projectIDOrKey string, name string, opts ...backlog.RequestOption) (*backlog.Version, error)
+	UpdateFunc func(ctx context.Context, projectIDOrKey string, versionID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Version, error)
+	DeleteFunc func(ctx context.Context, projectIDOrKey string, versionID int) (*backlog.Version, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectVersionAPI) List(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Version, error) {
+	f.record("List", ctx, projectIDOrKey, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectVersionAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, opts...)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectVersionAPI) Create(ctx context.Context, projectIDOrKey string, name string, opts ...backlog.RequestOption) (*backlog.Version, error) {
+	f.record("Create", ctx, projectIDOrKey, name, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectVersionAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, name, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectVersionAPI) Update(ctx context.Context, projectIDOrKey string, versionID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Version, error) {
+	f.record("Update", ctx, projectIDOrKey, versionID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectVersionAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, versionID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectVersionAPI) Delete(ctx context.Context, projectIDOrKey string, versionID int) (*backlog.Version, error) {
+	f.record("Delete", ctx, projectIDOrKey, versionID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectVersionAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, versionID)
+}
+
+// FakeProjectWebhookAPI is a fake implementation of [backlog.ProjectWebhookAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeProjectWebhookAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string) ([]*backlog.Webhook, error)
+	CreateFunc func(ctx context.Context, projectIDOrKey string, name string, hookURL string, opts ...backlog.RequestOption) (*backlog.Webhook, error)
+	OneFunc    func(ctx context.Context, projectIDOrKey string, webhookID int) (*backlog.Webhook, error)
+	UpdateFunc func(ctx context.Context, projectIDOrKey string, webhookID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Webhook, error)
+	DeleteFunc func(ctx context.Context, projectIDOrKey string, webhookID int) (*backlog.Webhook, error)
+}
+
+// List calls ListFunc.
+func (f *FakeProjectWebhookAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.Webhook, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeProjectWebhookAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// Create calls CreateFunc.
+func (f *FakeProjectWebhookAPI) Create(ctx context.Context, projectIDOrKey string, name string, hookURL string, opts ...backlog.RequestOption) (*backlog.Webhook, error) {
+	f.record("Create", ctx, projectIDOrKey, name, hookURL, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeProjectWebhookAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, name, hookURL, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakeProjectWebhookAPI) One(ctx context.Context, projectIDOrKey string, webhookID int) (*backlog.Webhook, error) {
+	f.record("One", ctx, projectIDOrKey, webhookID)
+	if f.OneFunc == nil {
+		panic(unset("FakeProjectWebhookAPI", "One"))
+	}
+	return f.OneFunc(ctx, projectIDOrKey, webhookID)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeProjectWebhookAPI) Update(ctx context.Context, projectIDOrKey string, webhookID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Webhook, error) {
+	f.record("Update", ctx, projectIDOrKey, webhookID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeProjectWebhookAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, webhookID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeProjectWebhookAPI) Delete(ctx context.Context, projectIDOrKey string, webhookID int) (*backlog.Webhook, error) {
+	f.record("Delete", ctx, projectIDOrKey, webhookID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeProjectWebhookAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, projectIDOrKey, webhookID)
+}
+
+// FakePullRequestAPI is a fake implementation of [backlog.PullRequestAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakePullRequestAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...backlog.RequestOption) ([]*backlog.PullRequest, error)
+	AllFunc    func(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, opts ...backlog.RequestOption) (iter.Seq2[*backlog.PullRequest, error], error)
+	CountFunc  func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...backlog.RequestOption) (int, error)
+	OneFunc    func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (*backlog.PullRequest, error)
+	CreateFunc func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, summary string, description string, base string, branch string, opts ...backlog.RequestOption) (*backlog.PullRequest, error)
+	UpdateFunc func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.PullRequest, error)
+}
+
+// List calls ListFunc.
+func (f *FakePullRequestAPI) List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...backlog.RequestOption) ([]*backlog.PullRequest, error) {
+	f.record("List", ctx, projectIDOrKey, repositoryIDOrName, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakePullRequestAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, repositoryIDOrName, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakePullRequestAPI) All(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, opts ...backlog.RequestOption) (iter.Seq2[*backlog.PullRequest, error], error) {
+	f.record("All", ctx, perPage, projectIDOrKey, repositoryIDOrName, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakePullRequestAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, projectIDOrKey, repositoryIDOrName, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakePullRequestAPI) Count(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...backlog.RequestOption) (int, error) {
+	f.record("Count", ctx, projectIDOrKey, repositoryIDOrName, opts)
+	if f.CountFunc == nil {
+		panic(unset("FakePullRequestAPI", "Count"))
+	}
+	return f.CountFunc(ctx, projectIDOrKey, repositoryIDOrName, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakePullRequestAPI) One(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (*backlog.PullRequest, error) {
+	f.record("One", ctx, projectIDOrKey, repositoryIDOrName, prNumber)
+	if f.OneFunc == nil {
+		panic(unset("FakePullRequestAPI", "One"))
+	}
+	return f.OneFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber)
+}
+
+// Create calls CreateFunc.
+func (f *FakePullRequestAPI) Create(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, summary string, description string, base string, branch string, opts ...backlog.RequestOption) (*backlog.PullRequest, error) {
+	f.record("Create", ctx, projectIDOrKey, repositoryIDOrName, summary, description, base, branch, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakePullRequestAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectIDOrKey, repositoryIDOrName, summary, description, base, branch, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakePullRequestAPI) Update(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.PullRequest, error) {
+	f.record("Update", ctx, projectIDOrKey, repositoryIDOrName, prNumber, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakePullRequestAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber, option, opts...)
+}
+
+// FakePullRequestAttachmentAPI is a fake implementation of [backlog.PullRequestAttachmentAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakePullRequestAttachmentAPI struct {
+	callRecorder
+
+	ListFunc     func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) ([]*backlog.Attachment, error)
+	RemoveFunc   func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*backlog.Attachment, error)
+	DownloadFunc func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*backlog.FileData, error)
+}
+
+// List calls ListFunc.
+func (f *FakePullRequestAttachmentAPI) List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) ([]*backlog.Attachment, error) {
+	f.record("List", ctx, projectIDOrKey, repositoryIDOrName, prNumber)
+	if f.ListFunc == nil {
+		panic(unset("FakePullRequestAttachmentAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakePullRequestAttachmentAPI) Remove(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*backlog.Attachment, error) {
+	f.record("Remove", ctx, projectIDOrKey, repositoryIDOrName, prNumber, attachmentID)
+	if f.RemoveFunc == nil {
+		panic(unset("FakePullRequestAttachmentAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber, attachmentID)
+}
+
+// Download calls DownloadFunc.
+func (f *FakePullRequestAttachmentAPI) Download(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*backlog.FileData, error) {
+	f.record("Download", ctx, projectIDOrKey, repositoryIDOrName, prNumber, attachmentID)
+	if f.DownloadFunc == nil {
+		panic(unset("FakePullRequestAttachmentAPI", "Download"))
+	}
+	return f.DownloadFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber, attachmentID)
+}
+
+// FakePullRequestCommentAPI is a fake implementation of [backlog.PullRequestCommentAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakePullRequestCommentAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...backlog.RequestOption) ([]*backlog.Comment, error)
+	AllFunc    func(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Comment, error], error)
+	AddFunc    func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, content string, opts ...backlog.RequestOption) (*backlog.Comment, error)
+	CountFunc  func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (int, error)
+	UpdateFunc func(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, commentID int, content string) (*backlog.Comment, error)
+}
+
+// List calls ListFunc.
+func (f *FakePullRequestCommentAPI) List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...backlog.RequestOption) ([]*backlog.Comment, error) {
+	f.record("List", ctx, projectIDOrKey, repositoryIDOrName, prNumber, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakePullRequestCommentAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakePullRequestCommentAPI) All(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Comment, error], error) {
+	f.record("All", ctx, perPage, projectIDOrKey, repositoryIDOrName, prNumber, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakePullRequestCommentAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, projectIDOrKey, repositoryIDOrName, prNumber, opts...)
+}
+
+// Add calls AddFunc.
+func (f *FakePullRequestCommentAPI) Add(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, content string, opts ...backlog.RequestOption) (*backlog.Comment, error) {
+	f.record("Add", ctx, projectIDOrKey, repositoryIDOrName, prNumber, content, opts)
+	if f.AddFunc == nil {
+		panic(unset("FakePullRequestCommentAPI", "Add"))
+	}
+	return f.AddFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber, content, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakePullRequestCommentAPI) Count(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (int, error) {
+	f.record("Count", ctx, projectIDOrKey, repositoryIDOrName, prNumber)
+	if f.CountFunc == nil {
+		panic(unset("FakePullRequestCommentAPI", "Count"))
+	}
+	return f.CountFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber)
+}
+
+// Update calls UpdateFunc.
+func (f *FakePullRequestCommentAPI) Update(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, commentID int, content string) (*backlog.Comment, error) {
+	f.record("Update", ctx, projectIDOrKey, repositoryIDOrName, prNumber, commentID, content)
+	if f.UpdateFunc == nil {
+		panic(unset("FakePullRequestCommentAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, projectIDOrKey, repositoryIDOrName, prNumber, commentID, content)
+}
+
+// FakePullRequestStarAPI is a fake implementation of [backlog.PullRequestStarAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakePullRequestStarAPI struct {
+	callRecorder
+
+	AddFunc    func(ctx context.Context, pullRequestID int) error
+	RemoveFunc func(ctx context.Context, starID int) error
+}
+
+// Add calls AddFunc.
+func (f *FakePullRequestStarAPI) Add(ctx context.Context, pullRequestID int) error {
+	f.record("Add", ctx, pullRequestID)
+	if f.AddFunc == nil {
+		panic(unset("FakePullRequestStarAPI", "Add"))
+	}
+	return f.AddFunc(ctx, pullRequestID)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakePullRequestStarAPI) Remove(ctx context.Context, starID int) error {
+	f.record("Remove", ctx, starID)
+	if f.RemoveFunc == nil {
+		panic(unset("FakePullRequestStarAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, starID)
+}
+
+// FakeRawAPI is a fake implementation of [backlog.RawAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeRawAPI struct {
+	callRecorder
+
+	GetFunc      func(ctx context.Context, spath string, query url.Values, v any) error
+	PostFunc     func(ctx context.Context, spath string, form url.Values, v any) error
+	PatchFunc    func(ctx context.Context, spath string, form url.Values, v any) error
+	PutFunc      func(ctx context.Context, spath string, form url.Values, v any) error
+	DeleteFunc   func(ctx context.Context, spath string, form url.Values, v any) error
+	DownloadFunc func(ctx context.Context, spath string, query url.Values) (*backlog.FileData, error)
+}
+
+// Get calls GetFunc.
+func (f *FakeRawAPI) Get(ctx context.Context, spath string, query url.Values, v any) error {
+	f.record("Get", ctx, spath, query, v)
+	if f.GetFunc == nil {
+		panic(unset("FakeRawAPI", "Get"))
+	}
+	return f.GetFunc(ctx, spath, query, v)
+}
+
+// Post calls PostFunc.
+func (f *FakeRawAPI) Post(ctx context.Context, spath string, form url.Values, v any) error {
+	f.record("Post", ctx, spath, form, v)
+	if f.PostFunc == nil {
+		panic(unset("FakeRawAPI", "Post"))
+	}
+	return f.PostFunc(ctx, spath, form, v)
+}
+
+// Patch calls PatchFunc.
+func (f *FakeRawAPI) Patch(ctx context.Context, spath string, form url.Values, v any) error {
+	f.record("Patch", ctx, spath, form, v)
+	if f.PatchFunc == nil {
+		panic(unset("FakeRawAPI", "Patch"))
+	}
+	return f.PatchFunc(ctx, spath, form, v)
+}
+
+// Put calls PutFunc.
+func (f *FakeRawAPI) Put(ctx context.Context, spath string, form url.Values, v any) error {
+	f.record("Put", ctx, spath, form, v)
+	if f.PutFunc == nil {
+		panic(unset("FakeRawAPI", "Put"))
+	}
+	return f.PutFunc(ctx, spath, form, v)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeRawAPI) Delete(ctx context.Context, spath string, form url.Values, v any) error {
+	f.record("Delete", ctx, spath, form, v)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeRawAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, spath, form, v)
+}
+
+// Download calls DownloadFunc.
+func (f *FakeRawAPI) Download(ctx context.Context, spath string, query url.Values) (*backlog.FileData, error) {
+	f.record("Download", ctx, spath, query)
+	if f.DownloadFunc == nil {
+		panic(unset("FakeRawAPI", "Download"))
+	}
+	return f.DownloadFunc(ctx, spath, query)
+}
+
+// FakeRecentlyViewedAPI is a fake implementation of [backlog.RecentlyViewedAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeRecentlyViewedAPI struct {
+	callRecorder
+
+	ListIssuesFunc   func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Issue, error)
+	AddIssueFunc     func(ctx context.Context, issueID int) (*backlog.Issue, error)
+	ListProjectsFunc func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Project, error)
+	ListWikisFunc    func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Wiki, error)
+	AddWikiFunc      func(ctx context.Context, wikiID int) (*backlog.Wiki, error)
+}
+
+// ListIssues calls ListIssuesFunc.
+func (f *FakeRecentlyViewedAPI) ListIssues(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Issue, error) {
+	f.record("ListIssues", ctx, opts)
+	if f.ListIssuesFunc == nil {
+		panic(unset("FakeRecentlyViewedAPI", "ListIssues"))
+	}
+	return f.ListIssuesFunc(ctx, opts...)
+}
+
+// AddIssue calls AddIssueFunc.
+func (f *FakeRecentlyViewedAPI) AddIssue(ctx context.Context, issueID int) (*backlog.Issue, error) {
+	f.record("AddIssue", ctx, issueID)
+	if f.AddIssueFunc == nil {
+		panic(unset("FakeRecentlyViewedAPI", "AddIssue"))
+	}
+	return f.AddIssueFunc(ctx, issueID)
+}
+
+// ListProjects calls ListProjectsFunc.
+func (f *FakeRecentlyViewedAPI) ListProjects(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Project, error) {
+	f.record("ListProjects", ctx, opts)
+	if f.ListProjectsFunc == nil {
+		panic(unset("FakeRecentlyViewedAPI", "ListProjects"))
+	}
+	return f.ListProjectsFunc(ctx, opts...)
+}
+
+// ListWikis calls ListWikisFunc.
+func (f *FakeRecentlyViewedAPI) ListWikis(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Wiki, error) {
+	f.record("ListWikis", ctx, opts)
+	if f.ListWikisFunc == nil {
+		panic(unset("FakeRecentlyViewedAPI", "ListWikis"))
+	}
+	return f.ListWikisFunc(ctx, opts...)
+}
+
+// AddWiki calls AddWikiFunc.
+func (f *FakeRecentlyViewedAPI) AddWiki(ctx context.Context, wikiID int) (*backlog.Wiki, error) {
+	f.record("AddWiki", ctx, wikiID)
+	if f.AddWikiFunc == nil {
+		panic(unset("FakeRecentlyViewedAPI", "AddWiki"))
+	}
+	return f.AddWikiFunc(ctx, wikiID)
+}
+
+// FakeRepositoryAPI is a fake implementation of [backlog.RepositoryAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeRepositoryAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context, projectIDOrKey string) ([]*backlog.Repository, error)
+	OneFunc  func(ctx context.Context, projectIDOrKey string, repoIDOrName string) (*backlog.Repository, error)
+}
+
+// List calls ListFunc.
+func (f *FakeRepositoryAPI) List(ctx context.Context, projectIDOrKey string) ([]*backlog.Repository, error) {
+	f.record("List", ctx, projectIDOrKey)
+	if f.ListFunc == nil {
+		panic(unset("FakeRepositoryAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey)
+}
+
+// One calls OneFunc.
+func (f *FakeRepositoryAPI) One(ctx context.Context, projectIDOrKey string, repoIDOrName string) (*backlog.Repository, error) {
+	f.record("One", ctx, projectIDOrKey, repoIDOrName)
+	if f.OneFunc == nil {
+		panic(unset("FakeRepositoryAPI", "One"))
+	}
+	return f.OneFunc(ctx, projectIDOrKey, repoIDOrName)
+}
+
+// FakeSpaceAPI is a fake implementation of [backlog.SpaceAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeSpaceAPI struct {
+	callRecorder
+
+	InfoFunc               func(ctx context.Context) (*backlog.Space, error)
+	DiskUsageFunc          func(ctx context.Context) (*backlog.DiskUsageSpace, error)
+	NotificationFunc       func(ctx context.Context) (*backlog.SpaceNotification, error)
+	UpdateNotificationFunc func(ctx context.Context, content string) (*backlog.SpaceNotification, error)
+	LicenceFunc            func(ctx context.Context) (*backlog.Licence, error)
+	LogoFunc               func(ctx context.Context) (*backlog.FileData, error)
+	RateLimitFunc          func(ctx context.Context) (*backlog.RateLimitStatus, error)
+}
+
+// Info calls InfoFunc.
+func (f *FakeSpaceAPI) Info(ctx context.Context) (*backlog.Space, error) {
+	f.record("Info", ctx)
+	if f.InfoFunc == nil {
+		panic(unset("FakeSpaceAPI", "Info"))
+	}
+	return f.InfoFunc(ctx)
+}
+
+// DiskUsage calls DiskUsageFunc.
+func (f *FakeSpaceAPI) DiskUsage(ctx context.Context) (*backlog.DiskUsageSpace, error) {
+	f.record("DiskUsage", ctx)
+	if f.DiskUsageFunc == nil {
+		panic(unset("FakeSpaceAPI", "DiskUsage"))
+	}
+	return f.DiskUsageFunc(ctx)
+}
+
+// Notification calls NotificationFunc.
+func (f *FakeSpaceAPI) Notification(ctx context.Context) (*backlog.SpaceNotification, error) {
+	f.record("Notification", ctx)
+	if f.NotificationFunc == nil {
+		panic(unset("FakeSpaceAPI", "Notification"))
+	}
+	return f.NotificationFunc(ctx)
+}
+
+// UpdateNotification calls UpdateNotificationFunc.
+func (f *FakeSpaceAPI) UpdateNotification(ctx context.Context, content string) (*backlog.SpaceNotification, error) {
+	f.record("UpdateNotification", ctx, content)
+	if f.UpdateNotificationFunc == nil {
+		panic(unset("FakeSpaceAPI", "UpdateNotification"))
+	}
+	return f.UpdateNotificationFunc(ctx, content)
+}
+
+// Licence calls LicenceFunc.
+func (f *FakeSpaceAPI) Licence(ctx context.Context) (*backlog.Licence, error) {
+	f.record("Licence", ctx)
+	if f.LicenceFunc == nil {
+		panic(unset("FakeSpaceAPI", "Licence"))
+	}
+	return f.LicenceFunc(ctx)
+}
+
+// Logo calls LogoFunc.
+func (f *FakeSpaceAPI) Logo(ctx context.Context) (*backlog.FileData, error) {
+	f.record("Logo", ctx)
+	if f.LogoFunc == nil {
+		panic(unset("FakeSpaceAPI", "Logo"))
+	}
+	return f.LogoFunc(ctx)
+}
+
+// RateLimit calls RateLimitFunc.
+func (f *FakeSpaceAPI) RateLimit(ctx context.Context) (*backlog.RateLimitStatus, error) {
+	f.record("RateLimit", ctx)
+	if f.RateLimitFunc == nil {
+		panic(unset("FakeSpaceAPI", "RateLimit"))
+	}
+	return f.RateLimitFunc(ctx)
+}
+
+// FakeSpaceActivityAPI is a fake implementation of [backlog.SpaceActivityAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeSpaceActivityAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Activity, error)
+	AllFunc  func(ctx context.Context, perPage int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Activity, error], error)
+	OneFunc  func(ctx context.Context, activityID int) (*backlog.Activity, error)
+}
+
+// List calls ListFunc.
+func (f *FakeSpaceActivityAPI) List(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Activity, error) {
+	f.record("List", ctx, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeSpaceActivityAPI", "List"))
+	}
+	return f.ListFunc(ctx, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeSpaceActivityAPI) All(ctx context.Context, perPage int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Activity, error], error) {
+	f.record("All", ctx, perPage, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeSpaceActivityAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakeSpaceActivityAPI) One(ctx context.Context, activityID int) (*backlog.Activity, error) {
+	f.record("One", ctx, activityID)
+	if f.OneFunc == nil {
+		panic(unset("FakeSpaceActivityAPI", "One"))
+	}
+	return f.OneFunc(ctx, activityID)
+}
+
+// FakeSpaceAttachmentAPI is a fake implementation of [backlog.SpaceAttachmentAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeSpaceAttachmentAPI struct {
+	callRecorder
+
+	UploadFunc func(ctx context.Context, fileName string, r io.Reader) (*backlog.Attachment, error)
+}
+
+// Upload calls UploadFunc.
+func (f *FakeSpaceAttachmentAPI) Upload(ctx context.Context, fileName string, r io.Reader) (*backlog.Attachment, error) {
+	f.record("Upload", ctx, fileName, r)
+	if f.UploadFunc == nil {
+		panic(unset("FakeSpaceAttachmentAPI", "Upload"))
+	}
+	return f.UploadFunc(ctx, fileName, r)
+}
+
+// FakeSpacePriorityAPI is a fake implementation of [backlog.SpacePriorityAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeSpacePriorityAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context) ([]*backlog.Priority, error)
+}
+
+// List calls ListFunc.
+func (f *FakeSpacePriorityAPI) List(ctx context.Context) ([]*backlog.Priority, error) {
+	f.record("List", ctx)
+	if f.ListFunc == nil {
+		panic(unset("FakeSpacePriorityAPI", "List"))
+	}
+	return f.ListFunc(ctx)
+}
+
+// FakeSpaceResolutionAPI is a fake implementation of [backlog.SpaceResolutionAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeSpaceResolutionAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context) ([]*backlog.Resolution, error)
+}
+
+// List calls ListFunc.
+func (f *FakeSpaceResolutionAPI) List(ctx context.Context) ([]*backlog.Resolution, error) {
+	f.record("List", ctx)
+	if f.ListFunc == nil {
+		panic(unset("FakeSpaceResolutionAPI", "List"))
+	}
+	return f.ListFunc(ctx)
+}
+
+// FakeStarAPI is a fake implementation of [backlog.StarAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeStarAPI struct {
+	callRecorder
+
+	AddFunc    func(ctx context.Context, option backlog.RequestOption) error
+	RemoveFunc func(ctx context.Context, id int) error
+}
+
+// Add calls AddFunc.
+func (f *FakeStarAPI) Add(ctx context.Context, option backlog.RequestOption) error {
+	f.record("Add", ctx, option)
+	if f.AddFunc == nil {
+		panic(unset("FakeStarAPI", "Add"))
+	}
+	return f.AddFunc(ctx, option)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakeStarAPI) Remove(ctx context.Context, id int) error {
+	f.record("Remove", ctx, id)
+	if f.RemoveFunc == nil {
+		panic(unset("FakeStarAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, id)
+}
+
+// FakeTeamAPI is a fake implementation of [backlog.TeamAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeTeamAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Team, error)
+	OneFunc    func(ctx context.Context, teamID int) (*backlog.Team, error)
+	CreateFunc func(ctx context.Context, name string, opts ...backlog.RequestOption) (*backlog.Team, error)
+	UpdateFunc func(ctx context.Context, teamID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Team, error)
+	DeleteFunc func(ctx context.Context, teamID int) (*backlog.Team, error)
+	IconFunc   func(ctx context.Context, teamID int) (*backlog.FileData, error)
+}
+
+// List calls ListFunc.
+func (f *FakeTeamAPI) List(ctx context.Context, opts ...backlog.RequestOption) ([]*backlog.Team, error) {
+	f.record("List", ctx, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeTeamAPI", "List"))
+	}
+	return f.ListFunc(ctx, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakeTeamAPI) One(ctx context.Context, teamID int) (*backlog.Team, error) {
+	f.record("One", ctx, teamID)
+	if f.OneFunc == nil {
+		panic(unset("FakeTeamAPI", "One"))
+	}
+	return f.OneFunc(ctx, teamID)
+}
+
+// Create calls CreateFunc.
+func (f *FakeTeamAPI) Create(ctx context.Context, name string, opts ...backlog.RequestOption) (*backlog.Team, error) {
+	f.record("Create", ctx, name, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeTeamAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, name, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeTeamAPI) Update(ctx context.Context, teamID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Team, error) {
+	f.record("Update", ctx, teamID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeTeamAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, teamID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeTeamAPI) Delete(ctx context.Context, teamID int) (*backlog.Team, error) {
+	f.record("Delete", ctx, teamID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeTeamAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, teamID)
+}
+
+// Icon calls IconFunc.
+func (f *FakeTeamAPI) Icon(ctx context.Context, teamID int) (*backlog.FileData, error) {
+	f.record("Icon", ctx, teamID)
+	if f.IconFunc == nil {
+		panic(unset("FakeTeamAPI", "Icon"))
+	}
+	return f.IconFunc(ctx, teamID)
+}
+
+// FakeUserAPI is a fake implementation of [backlog.UserAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeUserAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context) ([]*backlog.User, error)
+	OneFunc    func(ctx context.Context, id int) (*backlog.User, error)
+	MeFunc     func(ctx context.Context) (*backlog.User, error)
+	AddFunc    func(ctx context.Context, userID string, password string, name string, mailAddress string, roleType backlog.Role) (*backlog.User, error)
+	UpdateFunc func(ctx context.Context, id int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.User, error)
+	DeleteFunc func(ctx context.Context, id int) (*backlog.User, error)
+	IconFunc   func(ctx context.Context, id int) (*backlog.FileData, error)
+}
+
+// List calls ListFunc.
+func (f *FakeUserAPI) List(ctx context.Context) ([]*backlog.User, error) {
+	f.record("List", ctx)
+	if f.ListFunc == nil {
+		panic(unset("FakeUserAPI", "List"))
+	}
+	return f.ListFunc(ctx)
+}
+
+// One calls OneFunc.
+func (f *FakeUserAPI) One(ctx context.Context, id int) (*backlog.User, error) {
+	f.record("One", ctx, id)
+	if f.OneFunc == nil {
+		panic(unset("FakeUserAPI", "One"))
+	}
+	return f.OneFunc(ctx, id)
+}
+
+// Me calls MeFunc.
+func (f *FakeUserAPI) Me(ctx context.Context) (*backlog.User, error) {
+	f.record("Me", ctx)
+	if f.MeFunc == nil {
+		panic(unset("FakeUserAPI", "Me"))
+	}
+	return f.MeFunc(ctx)
+}
+
+// Add calls AddFunc.
+func (f *FakeUserAPI) Add(ctx context.Context, userID string, password string, name string, mailAddress string, roleType backlog.Role) (*backlog.User, error) {
+	f.record("Add", ctx, userID, password, name, mailAddress, roleType)
+	if f.AddFunc == nil {
+		panic(unset("FakeUserAPI", "Add"))
+	}
+	return f.AddFunc(ctx, userID, password, name, mailAddress, roleType)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeUserAPI) Update(ctx context.Context, id int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.User, error) {
+	f.record("Update", ctx, id, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeUserAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, id, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeUserAPI) Delete(ctx context.Context, id int) (*backlog.User, error) {
+	f.record("Delete", ctx, id)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeUserAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, id)
+}
+
+// Icon calls IconFunc.
+func (f *FakeUserAPI) Icon(ctx context.Context, id int) (*backlog.FileData, error) {
+	f.record("Icon", ctx, id)
+	if f.IconFunc == nil {
+		panic(unset("FakeUserAPI", "Icon"))
+	}
+	return f.IconFunc(ctx, id)
+}
+
+// FakeUserActivityAPI is a fake implementation of [backlog.UserActivityAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeUserActivityAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context, userID int, opts ...backlog.RequestOption) ([]*backlog.Activity, error)
+	AllFunc  func(ctx context.Context, perPage int, userID int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Activity, error], error)
+}
+
+// List calls ListFunc.
+func (f *FakeUserActivityAPI) List(ctx context.Context, userID int, opts ...backlog.RequestOption) ([]*backlog.Activity, error) {
+	f.record("List", ctx, userID, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeUserActivityAPI", "List"))
+	}
+	return f.ListFunc(ctx, userID, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeUserActivityAPI) All(ctx context.Context, perPage int, userID int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Activity, error], error) {
+	f.record("All", ctx, perPage, userID, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeUserActivityAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, userID, opts...)
+}
+
+// FakeUserStarAPI is a fake implementation of [backlog.UserStarAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeUserStarAPI struct {
+	callRecorder
+
+	ListFunc  func(ctx context.Context, userID int, opts ...backlog.RequestOption) ([]*backlog.Star, error)
+	AllFunc   func(ctx context.Context, perPage int, userID int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Star, error], error)
+	CountFunc func(ctx context.Context, userID int) (int, error)
+}
+
+// List calls ListFunc.
+func (f *FakeUserStarAPI) List(ctx context.Context, userID int, opts ...backlog.RequestOption) ([]*backlog.Star, error) {
+	f.record("List", ctx, userID, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeUserStarAPI", "List"))
+	}
+	return f.ListFunc(ctx, userID, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeUserStarAPI) All(ctx context.Context, perPage int, userID int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Star, error], error) {
+	f.record("All", ctx, perPage, userID, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeUserStarAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, userID, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakeUserStarAPI) Count(ctx context.Context, userID int) (int, error) {
+	f.record("Count", ctx, userID)
+	if f.CountFunc == nil {
+		panic(unset("FakeUserStarAPI", "Count"))
+	}
+	return f.CountFunc(ctx, userID)
+}
+
+// FakeWatchingAPI is a fake implementation of [backlog.WatchingAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeWatchingAPI struct {
+	callRecorder
+
+	ListFunc       func(ctx context.Context, userID int, opts ...backlog.RequestOption) ([]*backlog.Watching, error)
+	AllFunc        func(ctx context.Context, perPage int, userID int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Watching, error], error)
+	CountFunc      func(ctx context.Context, userID int, opts ...backlog.RequestOption) (int, error)
+	OneFunc        func(ctx context.Context, watchingID int) (*backlog.Watching, error)
+	AddFunc        func(ctx context.Context, issueIDOrKey string, opts ...backlog.RequestOption) (*backlog.Watching, error)
+	UpdateFunc     func(ctx context.Context, watchingID int, note string) (*backlog.Watching, error)
+	DeleteFunc     func(ctx context.Context, watchingID int) (*backlog.Watching, error)
+	MarkAsReadFunc func(ctx context.Context, watchingID int) error
+}
+
+// List calls ListFunc.
+func (f *FakeWatchingAPI) List(ctx context.Context, userID int, opts ...backlog.RequestOption) ([]*backlog.Watching, error) {
+	f.record("List", ctx, userID, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeWatchingAPI", "List"))
+	}
+	return f.ListFunc(ctx, userID, opts...)
+}
+
+// All calls AllFunc.
+func (f *FakeWatchingAPI) All(ctx context.Context, perPage int, userID int, opts ...backlog.RequestOption) (iter.Seq2[*backlog.Watching, error], error) {
+	f.record("All", ctx, perPage, userID, opts)
+	if f.AllFunc == nil {
+		panic(unset("FakeWatchingAPI", "All"))
+	}
+	return f.AllFunc(ctx, perPage, userID, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakeWatchingAPI) Count(ctx context.Context, userID int, opts ...backlog.RequestOption) (int, error) {
+	f.record("Count", ctx, userID, opts)
+	if f.CountFunc == nil {
+		panic(unset("FakeWatchingAPI", "Count"))
+	}
+	return f.CountFunc(ctx, userID, opts...)
+}
+
+// One calls OneFunc.
+func (f *FakeWatchingAPI) One(ctx context.Context, watchingID int) (*backlog.Watching, error) {
+	f.record("One", ctx, watchingID)
+	if f.OneFunc == nil {
+		panic(unset("FakeWatchingAPI", "One"))
+	}
+	return f.OneFunc(ctx, watchingID)
+}
+
+// Add calls AddFunc.
+func (f *FakeWatchingAPI) Add(ctx context.Context, issueIDOrKey string, opts ...backlog.RequestOption) (*backlog.Watching, error) {
+	f.record("Add", ctx, issueIDOrKey, opts)
+	if f.AddFunc == nil {
+		panic(unset("FakeWatchingAPI", "Add"))
+	}
+	return f.AddFunc(ctx, issueIDOrKey, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeWatchingAPI) Update(ctx context.Context, watchingID int, note string) (*backlog.Watching, error) {
+	f.record("Update", ctx, watchingID, note)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeWatchingAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, watchingID, note)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeWatchingAPI) Delete(ctx context.Context, watchingID int) (*backlog.Watching, error) {
+	f.record("Delete", ctx, watchingID)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeWatchingAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, watchingID)
+}
+
+// MarkAsRead calls MarkAsReadFunc.
+func (f *FakeWatchingAPI) MarkAsRead(ctx context.Context, watchingID int) error {
+	f.record("MarkAsRead", ctx, watchingID)
+	if f.MarkAsReadFunc == nil {
+		panic(unset("FakeWatchingAPI", "MarkAsRead"))
+	}
+	return f.MarkAsReadFunc(ctx, watchingID)
+}
+
+// FakeWikiAPI is a fake implementation of [backlog.WikiAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeWikiAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Wiki, error)
+	CountFunc  func(ctx context.Context, projectIDOrKey string) (int, error)
+	OneFunc    func(ctx context.Context, wikiID int) (*backlog.Wiki, error)
+	CreateFunc func(ctx context.Context, projectID int, name string, content string, opts ...backlog.RequestOption) (*backlog.Wiki, error)
+	UpdateFunc func(ctx context.Context, wikiID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Wiki, error)
+	DeleteFunc func(ctx context.Context, wikiID int, opts ...backlog.RequestOption) (*backlog.Wiki, error)
+}
+
+// List calls ListFunc.
+func (f *FakeWikiAPI) List(ctx context.Context, projectIDOrKey string, opts ...backlog.RequestOption) ([]*backlog.Wiki, error) {
+	f.record("List", ctx, projectIDOrKey, opts)
+	if f.ListFunc == nil {
+		panic(unset("FakeWikiAPI", "List"))
+	}
+	return f.ListFunc(ctx, projectIDOrKey, opts...)
+}
+
+// Count calls CountFunc.
+func (f *FakeWikiAPI) Count(ctx context.Context, projectIDOrKey string) (int, error) {
+	f.record("Count", ctx, projectIDOrKey)
+	if f.CountFunc == nil {
+		panic(unset("FakeWikiAPI", "Count"))
+	}
+	return f.CountFunc(ctx, projectIDOrKey)
+}
+
+// One calls OneFunc.
+func (f *FakeWikiAPI) One(ctx context.Context, wikiID int) (*backlog.Wiki, error) {
+	f.record("One", ctx, wikiID)
+	if f.OneFunc == nil {
+		panic(unset("FakeWikiAPI", "One"))
+	}
+	return f.OneFunc(ctx, wikiID)
+}
+
+// Create calls CreateFunc.
+func (f *FakeWikiAPI) Create(ctx context.Context, projectID int, name string, content string, opts ...backlog.RequestOption) (*backlog.Wiki, error) {
+	f.record("Create", ctx, projectID, name, content, opts)
+	if f.CreateFunc == nil {
+		panic(unset("FakeWikiAPI", "Create"))
+	}
+	return f.CreateFunc(ctx, projectID, name, content, opts...)
+}
+
+// Update calls UpdateFunc.
+func (f *FakeWikiAPI) Update(ctx context.Context, wikiID int, option backlog.RequestOption, opts ...backlog.RequestOption) (*backlog.Wiki, error) {
+	f.record("Update", ctx, wikiID, option, opts)
+	if f.UpdateFunc == nil {
+		panic(unset("FakeWikiAPI", "Update"))
+	}
+	return f.UpdateFunc(ctx, wikiID, option, opts...)
+}
+
+// Delete calls DeleteFunc.
+func (f *FakeWikiAPI) Delete(ctx context.Context, wikiID int, opts ...backlog.RequestOption) (*backlog.Wiki, error) {
+	f.record("Delete", ctx, wikiID, opts)
+	if f.DeleteFunc == nil {
+		panic(unset("FakeWikiAPI", "Delete"))
+	}
+	return f.DeleteFunc(ctx, wikiID, opts...)
+}
+
+// FakeWikiAttachmentAPI is a fake implementation of [backlog.WikiAttachmentAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeWikiAttachmentAPI struct {
+	callRecorder
+
+	AttachFunc   func(ctx context.Context, wikiID int, attachmentIDs []int) ([]*backlog.Attachment, error)
+	ListFunc     func(ctx context.Context, wikiID int) ([]*backlog.Attachment, error)
+	RemoveFunc   func(ctx context.Context, wikiID int, attachmentID int) (*backlog.Attachment, error)
+	DownloadFunc func(ctx context.Context, wikiID int, attachmentID int) (*backlog.FileData, error)
+}
+
+// Attach calls AttachFunc.
+func (f *FakeWikiAttachmentAPI) Attach(ctx context.Context, wikiID int, attachmentIDs []int) ([]*backlog.Attachment, error) {
+	f.record("Attach", ctx, wikiID, attachmentIDs)
+	if f.AttachFunc == nil {
+		panic(unset("FakeWikiAttachmentAPI", "Attach"))
+	}
+	return f.AttachFunc(ctx, wikiID, attachmentIDs)
+}
+
+// List calls ListFunc.
+func (f *FakeWikiAttachmentAPI) List(ctx context.Context, wikiID int) ([]*backlog.Attachment, error) {
+	f.record("List", ctx, wikiID)
+	if f.ListFunc == nil {
+		panic(unset("FakeWikiAttachmentAPI", "List"))
+	}
+	return f.ListFunc(ctx, wikiID)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakeWikiAttachmentAPI) Remove(ctx context.Context, wikiID int, attachmentID int) (*backlog.Attachment, error) {
+	f.record("Remove", ctx, wikiID, attachmentID)
+	if f.RemoveFunc == nil {
+		panic(unset("FakeWikiAttachmentAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, wikiID, attachmentID)
+}
+
+// Download calls DownloadFunc.
+func (f *FakeWikiAttachmentAPI) Download(ctx context.Context, wikiID int, attachmentID int) (*backlog.FileData, error) {
+	f.record("Download", ctx, wikiID, attachmentID)
+	if f.DownloadFunc == nil {
+		panic(unset("FakeWikiAttachmentAPI", "Download"))
+	}
+	return f.DownloadFunc(ctx, wikiID, attachmentID)
+}
+
+// FakeWikiHistoryAPI is a fake implementation of [backlog.WikiHistoryAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeWikiHistoryAPI struct {
+	callRecorder
+
+	ListFunc func(ctx context.Context, wikiID int) ([]*backlog.WikiHistory, error)
+}
+
+// List calls ListFunc.
+func (f *FakeWikiHistoryAPI) List(ctx context.Context, wikiID int) ([]*backlog.WikiHistory, error) {
+	f.record("List", ctx, wikiID)
+	if f.ListFunc == nil {
+		panic(unset("FakeWikiHistoryAPI", "List"))
+	}
+	return f.ListFunc(ctx, wikiID)
+}
+
+// FakeWikiSharedFileAPI is a fake implementation of [backlog.WikiSharedFileAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeWikiSharedFileAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, wikiID int) ([]*backlog.SharedFile, error)
+	LinkFunc   func(ctx context.Context, wikiID int, fileIDs []int) ([]*backlog.SharedFile, error)
+	UnlinkFunc func(ctx context.Context, wikiID int, fileID int) (*backlog.SharedFile, error)
+}
+
+// List calls ListFunc.
+func (f *FakeWikiSharedFileAPI) List(ctx context.Context, wikiID int) ([]*backlog.SharedFile, error) {
+	f.record("List", ctx, wikiID)
+	if f.ListFunc == nil {
+		panic(unset("FakeWikiSharedFileAPI", "List"))
+	}
+	return f.ListFunc(ctx, wikiID)
+}
+
+// Link calls LinkFunc.
+func (f *FakeWikiSharedFileAPI) Link(ctx context.Context, wikiID int, fileIDs []int) ([]*backlog.SharedFile, error) {
+	f.record("Link", ctx, wikiID, fileIDs)
+	if f.LinkFunc == nil {
+		panic(unset("FakeWikiSharedFileAPI", "Link"))
+	}
+	return f.LinkFunc(ctx, wikiID, fileIDs)
+}
+
+// Unlink calls UnlinkFunc.
+func (f *FakeWikiSharedFileAPI) Unlink(ctx context.Context, wikiID int, fileID int) (*backlog.SharedFile, error) {
+	f.record("Unlink", ctx, wikiID, fileID)
+	if f.UnlinkFunc == nil {
+		panic(unset("FakeWikiSharedFileAPI", "Unlink"))
+	}
+	return f.UnlinkFunc(ctx, wikiID, fileID)
+}
+
+// FakeWikiStarAPI is a fake implementation of [backlog.WikiStarAPI].
+// Each method calls the function in the field of the same name with a
+// Func suffix, and panics if that field is nil.
+type FakeWikiStarAPI struct {
+	callRecorder
+
+	ListFunc   func(ctx context.Context, wikiID int) ([]*backlog.Star, error)
+	AddFunc    func(ctx context.Context, wikiID int) error
+	RemoveFunc func(ctx context.Context, starID int) error
+}
+
+// List calls ListFunc.
+func (f *FakeWikiStarAPI) List(ctx context.Context, wikiID int) ([]*backlog.Star, error) {
+	f.record("List", ctx, wikiID)
+	if f.ListFunc == nil {
+		panic(unset("FakeWikiStarAPI", "List"))
+	}
+	return f.ListFunc(ctx, wikiID)
+}
+
+// Add calls AddFunc.
+func (f *FakeWikiStarAPI) Add(ctx context.Context, wikiID int) error {
+	f.record("Add", ctx, wikiID)
+	if f.AddFunc == nil {
+		panic(unset("FakeWikiStarAPI", "Add"))
+	}
+	return f.AddFunc(ctx, wikiID)
+}
+
+// Remove calls RemoveFunc.
+func (f *FakeWikiStarAPI) Remove(ctx context.Context, starID int) error {
+	f.record("Remove", ctx, starID)
+	if f.RemoveFunc == nil {
+		panic(unset("FakeWikiStarAPI", "Remove"))
+	}
+	return f.RemoveFunc(ctx, starID)
+}
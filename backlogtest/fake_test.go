@@ -0,0 +1,80 @@
+package backlogtest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/backlogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// summaries is an example of code that depends on a single service.
+func summaries(ctx context.Context, issues backlog.IssueAPI, keys ...string) ([]string, error) {
+	var out []string
+	for _, key := range keys {
+		issue, err := issues.One(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, issue.Summary)
+	}
+	return out, nil
+}
+
+func TestFakeIssueAPI(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &backlogtest.FakeIssueAPI{
+		OneFunc: func(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error) {
+			if issueIDOrKey == "PRJ-9" {
+				return nil, errors.New("not found")
+			}
+			return &backlog.Issue{Summary: "summary of " + issueIDOrKey}, nil
+		},
+	}
+
+	got, err := summaries(ctx, fake, "PRJ-1", "PRJ-2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"summary of PRJ-1", "summary of PRJ-2"}, got)
+
+	_, err = summaries(ctx, fake, "PRJ-9")
+	require.Error(t, err)
+
+	assert.Equal(t, 3, fake.CallCount("One"))
+	assert.Equal(t, []any{ctx, "PRJ-2"}, fake.Calls("One")[1].Args)
+	assert.Len(t, fake.Calls(""), 3)
+	assert.Zero(t, fake.CallCount("List"))
+}
+
+func TestFakeIssueAPI_Variadic(t *testing.T) {
+	t.Parallel()
+
+	fake := &backlogtest.FakeIssueAPI{
+		CountFunc: func(ctx context.Context, opts ...backlog.RequestOption) (int, error) {
+			return len(opts), nil
+		},
+	}
+	c, err := backlog.NewClient("https://example.backlog.com", "token")
+	require.NoError(t, err)
+
+	opts := []backlog.RequestOption{c.Issue.Option.WithKeyword("a"), c.Issue.Option.WithCount(10)}
+	n, err := fake.Count(context.Background(), opts...)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	require.Len(t, fake.Calls("Count"), 1)
+	assert.Len(t, fake.Calls("Count")[0].Args, 2)
+}
+
+func TestFake_Unset(t *testing.T) {
+	t.Parallel()
+
+	fake := &backlogtest.FakeWikiAPI{}
+	assert.PanicsWithValue(t, "backlogtest: FakeWikiAPI.One called but OneFunc is not set", func() {
+		_, _ = fake.One(context.Background(), 1)
+	})
+	assert.Equal(t, 1, fake.CallCount("One"))
+}
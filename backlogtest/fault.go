@@ -0,0 +1,266 @@
+package backlogtest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+)
+
+// ErrConnectionDropped is the cause of the error returned for a request
+// whose connection was dropped by [DropConnection].
+var ErrConnectionDropped = errors.New("backlogtest: connection dropped")
+
+// Fault injects a failure into a request. It receives the request and the
+// Doer it would have been sent with, and returns what the client sees
+// instead. Faults may call next to send the request and alter the response.
+type Fault func(req *http.Request, next backlog.Doer) (*http.Response, error)
+
+// FaultRule injects a fault into some of the requests sent by a [FaultDoer].
+type FaultRule struct {
+	// Method restricts the rule to requests with this HTTP method.
+	// Empty matches every method.
+	Method string
+
+	// Path restricts the rule to requests whose URL path matches this
+	// pattern, using the syntax of [path.Match], such as
+	// "/api/v2/issues/*". Empty matches every path.
+	Path string
+
+	// Probability is the chance, between 0 and 1, that the fault is
+	// injected into a matching request. Zero means every request.
+	Probability float64
+
+	// Times limits how many times the fault is injected. Zero means no limit.
+	Times int
+
+	Fault Fault
+}
+
+// FaultDoer is a [backlog.Doer] that injects faults into requests before or
+// instead of sending them with another Doer, to test how code copes with
+// Backlog outages.
+//
+// Rules are evaluated in order for each request, and the first matching rule
+// that fires injects its fault. Requests no rule fires for are sent
+// unchanged. The random numbers deciding whether a rule fires come from a
+// generator seeded by the caller, so that a sequence of requests fails the
+// same way on every run.
+//
+// A FaultDoer is safe for concurrent use.
+type FaultDoer struct {
+	next  backlog.Doer
+	rules []FaultRule
+
+	mu       sync.Mutex
+	rng      *rand.Rand
+	fired    []int
+	injected int
+}
+
+// NewFaultDoer returns a FaultDoer that sends requests with next and injects
+// faults according to rules, using seed to seed its random number generator.
+func NewFaultDoer(next backlog.Doer, seed uint64, rules ...FaultRule) *FaultDoer {
+	return &FaultDoer{
+		next:  next,
+		rules: rules,
+		rng:   rand.New(rand.NewPCG(seed, seed)),
+		fired: make([]int, len(rules)),
+	}
+}
+
+// Do implements [backlog.Doer].
+func (d *FaultDoer) Do(req *http.Request) (*http.Response, error) {
+	if fault := d.pick(req); fault != nil {
+		return fault(req, d.next)
+	}
+	return d.next.Do(req)
+}
+
+// Injected returns the number of requests a fault has been injected into.
+func (d *FaultDoer) Injected() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.injected
+}
+
+// pick returns the fault to inject into req, or nil.
+func (d *FaultDoer) pick(req *http.Request) Fault {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, r := range d.rules {
+		if !r.matches(req) || (r.Times > 0 && d.fired[i] >= r.Times) {
+			continue
+		}
+		if r.Probability > 0 && r.Probability < 1 && d.rng.Float64() >= r.Probability {
+			continue
+		}
+		d.fired[i]++
+		d.injected++
+		return r.Fault
+	}
+	return nil
+}
+
+func (r *FaultRule) matches(req *http.Request) bool {
+	if r.Method != "" && r.Method != req.Method {
+		return false
+	}
+	if r.Path != "" {
+		if ok, _ := path.Match(r.Path, req.URL.Path); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Latency returns a Fault that waits for d before sending the request.
+// Waiting is aborted when the request context is done, in which case the
+// context error is returned.
+func Latency(d time.Duration) Fault {
+	return func(req *http.Request, next backlog.Doer) (*http.Response, error) {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-req.Context().Done():
+			closeBody(req)
+			return nil, req.Context().Err()
+		case <-t.C:
+		}
+		return next.Do(req)
+	}
+}
+
+// DropConnection returns a Fault that fails the request without sending it,
+// as a connection reset would. The error wraps [ErrConnectionDropped] in a
+// *url.Error, like the errors of *http.Client.
+func DropConnection() Fault {
+	return func(req *http.Request, next backlog.Doer) (*http.Response, error) {
+		closeBody(req)
+		op := req.Method[:1] + strings.ToLower(req.Method[1:])
+		return nil, &url.Error{Op: op, URL: req.URL.String(), Err: ErrConnectionDropped}
+	}
+}
+
+// RateLimited returns a Fault that responds with 429 Too Many Requests and a
+// Retry-After header of retryAfter, rounded up to whole seconds, without
+// sending the request. The rate limit headers report no remaining requests.
+func RateLimited(retryAfter time.Duration) Fault {
+	return func(req *http.Request, next backlog.Doer) (*http.Response, error) {
+		closeBody(req)
+		seconds := int((retryAfter + time.Second - 1) / time.Second)
+		reset := time.Now().Add(time.Duration(seconds) * time.Second)
+		return errorResponse(req, &apiError{
+			status:  http.StatusTooManyRequests,
+			code:    backlog.ErrorCodeTooManyRequests,
+			message: "Too many requests.",
+		}, http.Header{
+			"Retry-After":           {strconv.Itoa(seconds)},
+			"X-Ratelimit-Limit":     {"150"},
+			"X-Ratelimit-Remaining": {"0"},
+			"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+		}), nil
+	}
+}
+
+// ServerError returns a Fault that responds with statusCode and a Backlog
+// error payload without sending the request. A statusCode of zero means
+// 503 Service Unavailable.
+func ServerError(statusCode int) Fault {
+	if statusCode == 0 {
+		statusCode = http.StatusServiceUnavailable
+	}
+	return func(req *http.Request, next backlog.Doer) (*http.Response, error) {
+		closeBody(req)
+		return errorResponse(req, &apiError{
+			status:  statusCode,
+			code:    backlog.ErrorCodeInternal,
+			message: http.StatusText(statusCode) + ".",
+		}, nil), nil
+	}
+}
+
+// TruncatedBody returns a Fault that sends the request and cuts the response
+// body in half. Reading past the cut fails with [io.ErrUnexpectedEOF], as
+// when the connection is lost during the transfer.
+func TruncatedBody() Fault {
+	return alterBody(func(body []byte) ([]byte, error) {
+		return body[:len(body)/2], io.ErrUnexpectedEOF
+	})
+}
+
+// MalformedJSON returns a Fault that sends the request and replaces the
+// response body with its first half, which reads without error but is not
+// valid JSON.
+func MalformedJSON() Fault {
+	return alterBody(func(body []byte) ([]byte, error) {
+		if len(body) < 2 {
+			return []byte("{"), nil
+		}
+		return body[:len(body)/2], nil
+	})
+}
+
+// alterBody returns a Fault that sends the request and replaces the response
+// body with the result of fn. A non-nil error is returned by the body reader
+// after the altered body.
+func alterBody(fn func(body []byte) ([]byte, error)) Fault {
+	return func(req *http.Request, next backlog.Doer) (*http.Response, error) {
+		resp, err := next.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		body, readErr := fn(body)
+		var r io.Reader = bytes.NewReader(body)
+		if readErr != nil {
+			r = io.MultiReader(r, errReader{readErr})
+		} else {
+			resp.ContentLength = int64(len(body))
+			resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+		resp.Body = io.NopCloser(r)
+		return resp, nil
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// closeBody closes the body of a request that is not sent, as a transport
+// does, so that a writer feeding it through a pipe is not blocked forever.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// errorResponse returns a response carrying err in the Backlog error format,
+// with the given extra headers.
+func errorResponse(req *http.Request, err *apiError, header http.Header) *http.Response {
+	rr := httptest.NewRecorder()
+	for k, v := range header {
+		rr.Header()[k] = v
+	}
+	writeError(rr, err)
+	resp := rr.Result()
+	resp.Request = req
+	return resp
+}
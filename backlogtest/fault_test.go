@@ -0,0 +1,237 @@
+package backlogtest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/backlogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFaultClient starts a fake server with one issue and returns a client
+// that sends requests to it through a FaultDoer.
+func newFaultClient(t *testing.T, rules []backlogtest.FaultRule, opts ...*backlog.ClientOption) (*backlogtest.FaultDoer, *backlog.Client) {
+	t.Helper()
+
+	srv := backlogtest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddProject("PRJ", "Project")
+	srv.AddIssue("PRJ", "summary")
+
+	doer := backlogtest.NewFaultDoer(srv.Client(), 1, rules...)
+	c, err := backlog.NewClient(srv.URL, "token", append(opts, backlog.WithDoer(doer))...)
+	require.NoError(t, err)
+	return doer, c
+}
+
+func TestFaultDoer_Faults(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		fault  backlogtest.Fault
+		assert func(t *testing.T, err error)
+	}{
+		"drop-connection": {
+			fault: backlogtest.DropConnection(),
+			assert: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, backlogtest.ErrConnectionDropped)
+			},
+		},
+		"rate-limited": {
+			fault: backlogtest.RateLimited(1500 * time.Millisecond),
+			assert: func(t *testing.T, err error) {
+				requireAPIError(t, err, http.StatusTooManyRequests, backlog.ErrorCodeTooManyRequests)
+				var apiErr *backlog.APIResponseError
+				require.ErrorAs(t, err, &apiErr)
+				require.NotNil(t, apiErr.RateLimit())
+				assert.Equal(t, 0, apiErr.RateLimit().Remaining)
+			},
+		},
+		"server-error": {
+			fault: backlogtest.ServerError(http.StatusBadGateway),
+			assert: func(t *testing.T, err error) {
+				requireAPIError(t, err, http.StatusBadGateway, backlog.ErrorCodeInternal)
+			},
+		},
+		"server-error-default": {
+			fault: backlogtest.ServerError(0),
+			assert: func(t *testing.T, err error) {
+				requireAPIError(t, err, http.StatusServiceUnavailable, backlog.ErrorCodeInternal)
+			},
+		},
+		"truncated-body": {
+			fault: backlogtest.TruncatedBody(),
+			assert: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+			},
+		},
+		"malformed-json": {
+			fault: backlogtest.MalformedJSON(),
+			assert: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doer, c := newFaultClient(t, []backlogtest.FaultRule{{Fault: tc.fault}})
+			_, err := c.Issue.One(context.Background(), "PRJ-1")
+			tc.assert(t, err)
+			assert.Equal(t, 1, doer.Injected())
+		})
+	}
+}
+
+func TestFaultDoer_ClosesBody(t *testing.T) {
+	t.Parallel()
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := map[string]struct {
+		ctx   context.Context
+		fault backlogtest.Fault
+	}{
+		"drop-connection":  {ctx: context.Background(), fault: backlogtest.DropConnection()},
+		"rate-limited":     {ctx: context.Background(), fault: backlogtest.RateLimited(time.Second)},
+		"server-error":     {ctx: context.Background(), fault: backlogtest.ServerError(0)},
+		"latency-canceled": {ctx: canceled, fault: backlogtest.Latency(time.Minute)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := &closeTrackingBody{Reader: strings.NewReader("file")}
+			req, err := http.NewRequestWithContext(tc.ctx, http.MethodPost, "https://example.backlog.com/api/v2/space/attachment", body)
+			require.NoError(t, err)
+
+			resp, _ := backlogtest.NewFaultDoer(&staticDoer{}, 1, backlogtest.FaultRule{Fault: tc.fault}).Do(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+			assert.True(t, body.closed)
+		})
+	}
+}
+
+func TestFaultDoer_Latency(t *testing.T) {
+	t.Parallel()
+
+	_, c := newFaultClient(t, []backlogtest.FaultRule{{Fault: backlogtest.Latency(20 * time.Millisecond)}})
+
+	start := time.Now()
+	_, err := c.Issue.One(context.Background(), "PRJ-1")
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	_, c = newFaultClient(t, []backlogtest.FaultRule{{Fault: backlogtest.Latency(time.Minute)}})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Issue.One(ctx, "PRJ-1")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFaultDoer_Rules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	doer, c := newFaultClient(t, []backlogtest.FaultRule{
+		{Method: http.MethodPatch, Fault: backlogtest.ServerError(0)},
+		{Path: "/api/v2/issues/*", Times: 1, Fault: backlogtest.DropConnection()},
+	})
+
+	// The list endpoint matches neither rule.
+	_, err := c.Issue.List(ctx)
+	require.NoError(t, err)
+
+	// The second rule fires once.
+	_, err = c.Issue.One(ctx, "PRJ-1")
+	require.ErrorIs(t, err, backlogtest.ErrConnectionDropped)
+	_, err = c.Issue.One(ctx, "PRJ-1")
+	require.NoError(t, err)
+
+	// The first matching rule wins.
+	_, err = c.Issue.Update(ctx, "PRJ-1", c.Issue.Option.WithSummary("new"))
+	requireAPIError(t, err, http.StatusServiceUnavailable, backlog.ErrorCodeInternal)
+
+	assert.Equal(t, 2, doer.Injected())
+}
+
+func TestFaultDoer_Retry(t *testing.T) {
+	t.Parallel()
+
+	doer, c := newFaultClient(t,
+		[]backlogtest.FaultRule{
+			{Times: 1, Fault: backlogtest.ServerError(0)},
+			{Times: 1, Fault: backlogtest.DropConnection()},
+		},
+		backlog.WithRetry(backlog.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+
+	issue, err := c.Issue.One(context.Background(), "PRJ-1")
+	require.NoError(t, err)
+	assert.Equal(t, "summary", issue.Summary)
+	assert.Equal(t, 2, doer.Injected())
+}
+
+func TestFaultDoer_Seed(t *testing.T) {
+	t.Parallel()
+
+	// outcomes returns which of 50 requests fail with the given seed.
+	outcomes := func(seed uint64) string {
+		next := &staticDoer{}
+		doer := backlogtest.NewFaultDoer(next, seed, backlogtest.FaultRule{
+			Probability: 0.3,
+			Fault:       backlogtest.DropConnection(),
+		})
+		var b strings.Builder
+		for range 50 {
+			req, _ := http.NewRequest(http.MethodGet, "https://example.backlog.com/api/v2/space", nil)
+			if _, err := doer.Do(req); err != nil {
+				b.WriteByte('x')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		return b.String()
+	}
+
+	got := outcomes(42)
+	assert.Equal(t, got, outcomes(42))
+	assert.NotEqual(t, got, outcomes(7))
+	assert.Contains(t, got, "x")
+	assert.Contains(t, got, ".")
+}
+
+// closeTrackingBody records whether it has been closed.
+type closeTrackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeTrackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+// staticDoer responds to every request with an empty JSON object.
+type staticDoer struct{}
+
+func (staticDoer) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
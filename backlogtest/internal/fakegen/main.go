@@ -0,0 +1,216 @@
+// Command fakegen generates the fakes of the backlogtest package from the
+// service interfaces declared in the backlog package.
+//
+// Usage:
+//
+//	go run ./internal/fakegen -src ../api.go -out fake_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const backlogImport = "github.com/nattokin/go-backlog"
+
+func main() {
+	src := flag.String("src", "../api.go", "file declaring the service interfaces")
+	out := flag.String("out", "fake_gen.go", "output file")
+	flag.Parse()
+
+	b, err := os.ReadFile(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := generate(b)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the source of a fake for every exported interface named
+// *API in src, the source of a file of the backlog package.
+func generate(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "api.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := map[string]string{}
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imports[path[strings.LastIndex(path, "/")+1:]] = path
+	}
+
+	g := &generator{imports: imports, used: map[string]bool{}}
+	var body bytes.Buffer
+	var names []string
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !ts.Name.IsExported() || !strings.HasSuffix(ts.Name.Name, "API") {
+				continue
+			}
+			names = append(names, ts.Name.Name)
+			g.fake(&body, ts.Name.Name, it)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by fakegen. DO NOT EDIT.\n\npackage backlogtest\n\nimport (\n")
+	var paths []string
+	for name := range g.used {
+		paths = append(paths, imports[name])
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&buf, "\t%q\n", path)
+	}
+	fmt.Fprintf(&buf, "\n\t%q\n)\n\n", backlogImport)
+	buf.WriteString("var (\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t_ backlog.%s = (*Fake%s)(nil)\n", name, name)
+	}
+	buf.WriteString(")\n")
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+type generator struct {
+	// imports maps package names imported by the source to their paths.
+	imports map[string]string
+	// used records the package names referenced by the generated code.
+	used map[string]bool
+}
+
+// param is a named parameter of a method.
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func (g *generator) fake(w *bytes.Buffer, iface string, it *ast.InterfaceType) {
+	fake := "Fake" + iface
+
+	fmt.Fprintf(w, "\n// %s is a fake implementation of [backlog.%s].\n", fake, iface)
+	fmt.Fprintf(w, "// Each method calls the function in the field of the same name with a\n")
+	fmt.Fprintf(w, "// Func suffix, and panics if that field is nil.\n")
+	fmt.Fprintf(w, "type %s struct {\n\tcallRecorder\n\n", fake)
+	for _, m := range it.Methods.List {
+		fmt.Fprintf(w, "\t%sFunc %s\n", m.Names[0].Name, g.funcType(m.Type.(*ast.FuncType)))
+	}
+	w.WriteString("}\n")
+
+	for _, m := range it.Methods.List {
+		name := m.Names[0].Name
+		ft := m.Type.(*ast.FuncType)
+		params := g.params(ft)
+
+		decls := make([]string, len(params))
+		args := make([]string, len(params))
+		names := make([]string, len(params))
+		for i, p := range params {
+			decls[i] = p.name + " " + p.typ
+			args[i] = p.name
+			names[i] = p.name
+			if p.variadic {
+				args[i] += "..."
+			}
+		}
+
+		fmt.Fprintf(w, "\n// %s calls %sFunc.\n", name, name)
+		fmt.Fprintf(w, "func (f *%s) %s(%s) %s {\n", fake, name, strings.Join(decls, ", "), g.results(ft))
+		fmt.Fprintf(w, "\tf.record(%q, %s)\n", name, strings.Join(names, ", "))
+		fmt.Fprintf(w, "\tif f.%sFunc == nil {\n\t\tpanic(unset(%q, %q))\n\t}\n", name, fake, name)
+		fmt.Fprintf(w, "\treturn f.%sFunc(%s)\n}\n", name, strings.Join(args, ", "))
+	}
+}
+
+func (g *generator) params(ft *ast.FuncType) []param {
+	var out []param
+	for _, field := range ft.Params.List {
+		typ := g.expr(field.Type)
+		_, variadic := field.Type.(*ast.Ellipsis)
+		for _, n := range field.Names {
+			out = append(out, param{name: n.Name, typ: typ, variadic: variadic})
+		}
+	}
+	return out
+}
+
+func (g *generator) results(ft *ast.FuncType) string {
+	if ft.Results == nil {
+		return ""
+	}
+	var types []string
+	for _, field := range ft.Results.List {
+		types = append(types, g.expr(field.Type))
+	}
+	if len(types) == 1 {
+		return types[0]
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+func (g *generator) funcType(ft *ast.FuncType) string {
+	var decls []string
+	for _, p := range g.params(ft) {
+		decls = append(decls, p.name+" "+p.typ)
+	}
+	return "func(" + strings.Join(decls, ", ") + ") " + g.results(ft)
+}
+
+// expr formats a type expression of the backlog package for use in the
+// backlogtest package, qualifying exported identifiers with "backlog.".
+func (g *generator) expr(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return "backlog." + e.Name
+		}
+		return e.Name
+	case *ast.SelectorExpr:
+		pkg := e.X.(*ast.Ident).Name
+		g.used[pkg] = true
+		return pkg + "." + e.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.expr(e.X)
+	case *ast.ArrayType:
+		return "[]" + g.expr(e.Elt)
+	case *ast.MapType:
+		return "map[" + g.expr(e.Key) + "]" + g.expr(e.Value)
+	case *ast.Ellipsis:
+		return "..." + g.expr(e.Elt)
+	case *ast.IndexExpr:
+		return g.expr(e.X) + "[" + g.expr(e.Index) + "]"
+	case *ast.IndexListExpr:
+		args := make([]string, len(e.Indices))
+		for i, idx := range e.Indices {
+			args[i] = g.expr(idx)
+		}
+		return g.expr(e.X) + "[" + strings.Join(args, ", ") + "]"
+	default:
+		panic(fmt.Sprintf("fakegen: unsupported type expression %T", e))
+	}
+}
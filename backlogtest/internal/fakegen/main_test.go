@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	t.Parallel()
+
+	src, err := os.ReadFile("../../../api.go")
+	require.NoError(t, err)
+	want, err := os.ReadFile("../../fake_gen.go")
+	require.NoError(t, err)
+
+	got, err := generate(src)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "fake_gen.go is stale; run go generate ./backlogtest")
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	src := []byte(`package backlog
+
+import "context"
+
+type ThingAPI interface {
+	Get(ctx context.Context, a, b int, opts ...RequestOption) (map[string]*Thing, error)
+	Touch(ctx context.Context) error
+}
+
+type notAnAPI interface{ Get() }
+`)
+
+	got, err := generate(src)
+	require.NoError(t, err)
+	code := string(got)
+	assert.Contains(t, code, "_ backlog.ThingAPI = (*FakeThingAPI)(nil)")
+	assert.Contains(t, code, "GetFunc   func(ctx context.Context, a int, b int, opts ...backlog.RequestOption) (map[string]*backlog.Thing, error)")
+	assert.Contains(t, code, "return f.GetFunc(ctx, a, b, opts...)")
+	assert.Contains(t, code, "func (f *FakeThingAPI) Touch(ctx context.Context) error {")
+	assert.NotContains(t, code, "notAnAPI")
+}
@@ -0,0 +1,536 @@
+package backlogtest
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// issue holds an issue together with its comments.
+type issue struct {
+	*model.Issue
+	comments []*model.Comment
+}
+
+// AddIssue adds an issue with the given summary to the project with the
+// given key and returns its issue key. The issue is created with the first
+// issue type of the project, normal priority and the "Open" status.
+// It panics if the project does not exist.
+func (s *Server) AddIssue(projectKey, summary string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.findProject(projectKey)
+	if p == nil {
+		panic("backlogtest: no project " + projectKey)
+	}
+	i := s.newIssue(p, summary, p.issueTypes[0], findPriority(3))
+	return i.IssueKey
+}
+
+func (s *Server) newIssue(p *project, summary string, issueType *model.IssueType, priority *model.Priority) *issue {
+	now := s.timestamp()
+	p.lastKeyID++
+	i := &issue{Issue: &model.Issue{
+		ID:          s.nextID("issue"),
+		ProjectID:   p.ID,
+		IssueKey:    p.ProjectKey + "-" + strconv.Itoa(p.lastKeyID),
+		KeyID:       p.lastKeyID,
+		IssueType:   issueType,
+		Summary:     summary,
+		Priority:    priority,
+		Status:      p.statuses[0],
+		CreatedUser: s.myself,
+		Created:     now,
+		UpdatedUser: s.myself,
+		Updated:     now,
+	}}
+	s.issues = append(s.issues, i)
+	return i
+}
+
+// findIssue returns the issue with the given ID or key.
+func (s *Server) findIssue(idOrKey string) *issue {
+	id, err := strconv.Atoi(idOrKey)
+	for _, i := range s.issues {
+		if (err == nil && i.ID == id) || i.IssueKey == idOrKey {
+			return i
+		}
+	}
+	return nil
+}
+
+func (s *Server) issueParam(r *http.Request) (*issue, error) {
+	i := s.findIssue(r.PathValue("issueIdOrKey"))
+	if i == nil {
+		return nil, errNoResource("issue")
+	}
+	return i, nil
+}
+
+// ──────────────────────────────────────────────────────────────
+//  List and count
+// ──────────────────────────────────────────────────────────────
+
+// issueSortKeys maps the supported values of the sort param to a comparison.
+var issueSortKeys = map[string]func(a, b *issue) int{
+	"issueType":      func(a, b *issue) int { return cmp.Compare(a.IssueType.DisplayOrder, b.IssueType.DisplayOrder) },
+	"summary":        func(a, b *issue) int { return strings.Compare(a.Summary, b.Summary) },
+	"status":         func(a, b *issue) int { return cmp.Compare(a.Status.DisplayOrder, b.Status.DisplayOrder) },
+	"priority":       func(a, b *issue) int { return cmp.Compare(b.Priority.ID, a.Priority.ID) },
+	"attachment":     func(a, b *issue) int { return cmp.Compare(len(a.Attachments), len(b.Attachments)) },
+	"created":        func(a, b *issue) int { return a.Created.Compare(b.Created) },
+	"createdUser":    func(a, b *issue) int { return cmp.Compare(a.CreatedUser.ID, b.CreatedUser.ID) },
+	"updated":        func(a, b *issue) int { return a.Updated.Compare(b.Updated) },
+	"updatedUser":    func(a, b *issue) int { return cmp.Compare(a.UpdatedUser.ID, b.UpdatedUser.ID) },
+	"assignee":       func(a, b *issue) int { return cmp.Compare(userID(a.Assignee), userID(b.Assignee)) },
+	"startDate":      func(a, b *issue) int { return strings.Compare(a.StartDate, b.StartDate) },
+	"dueDate":        func(a, b *issue) int { return strings.Compare(a.DueDate, b.DueDate) },
+	"estimatedHours": func(a, b *issue) int { return cmp.Compare(a.EstimatedHours, b.EstimatedHours) },
+	"actualHours":    func(a, b *issue) int { return cmp.Compare(a.ActualHours, b.ActualHours) },
+}
+
+func userID(u *model.User) int {
+	if u == nil {
+		return 0
+	}
+	return u.ID
+}
+
+// issueFilterIDs maps the repeated ID params to the ID of an issue they filter on.
+var issueFilterIDs = map[string]func(i *issue) []int{
+	"projectId[]":     func(i *issue) []int { return []int{i.ProjectID} },
+	"issueTypeId[]":   func(i *issue) []int { return []int{i.IssueType.ID} },
+	"statusId[]":      func(i *issue) []int { return []int{i.Status.ID} },
+	"priorityId[]":    func(i *issue) []int { return []int{i.Priority.ID} },
+	"assigneeId[]":    func(i *issue) []int { return []int{userID(i.Assignee)} },
+	"createdUserId[]": func(i *issue) []int { return []int{i.CreatedUser.ID} },
+	"id[]":            func(i *issue) []int { return []int{i.ID} },
+	"parentIssueId[]": func(i *issue) []int { return []int{i.ParentIssueID} },
+	"resolutionId[]": func(i *issue) []int {
+		if len(i.Resolutions) == 0 {
+			return nil
+		}
+		return []int{i.Resolutions[0].ID}
+	},
+	// Categories, versions and milestones are not modeled, so filtering
+	// on them matches no issue.
+	"categoryId[]":  func(i *issue) []int { return nil },
+	"versionId[]":   func(i *issue) []int { return nil },
+	"milestoneId[]": func(i *issue) []int { return nil },
+}
+
+// filterIssues returns the issues matching the filter params of r, sorted
+// in ascending order of the sort param.
+func (s *Server) filterIssues(r *http.Request) ([]*issue, error) {
+	var preds []func(i *issue) bool
+
+	for param, field := range issueFilterIDs {
+		ids, err := ints(r, param)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) > 0 {
+			preds = append(preds, func(i *issue) bool {
+				return slices.ContainsFunc(field(i), func(id int) bool { return slices.Contains(ids, id) })
+			})
+		}
+	}
+
+	if kw := strings.ToLower(r.Form.Get("keyword")); kw != "" {
+		preds = append(preds, func(i *issue) bool {
+			return strings.Contains(strings.ToLower(i.IssueKey+" "+i.Summary+" "+i.Description), kw)
+		})
+	}
+
+	for _, f := range []struct {
+		param string
+		value func(i *issue) string
+		since bool
+	}{
+		{"createdSince", func(i *issue) string { return i.Created.Format(time.DateOnly) }, true},
+		{"createdUntil", func(i *issue) string { return i.Created.Format(time.DateOnly) }, false},
+		{"updatedSince", func(i *issue) string { return i.Updated.Format(time.DateOnly) }, true},
+		{"updatedUntil", func(i *issue) string { return i.Updated.Format(time.DateOnly) }, false},
+		{"startDateSince", func(i *issue) string { return i.StartDate }, true},
+		{"startDateUntil", func(i *issue) string { return i.StartDate }, false},
+		{"dueDateSince", func(i *issue) string { return i.DueDate }, true},
+		{"dueDateUntil", func(i *issue) string { return i.DueDate }, false},
+	} {
+		bound, err := date(f.param, r.Form.Get(f.param))
+		if err != nil {
+			return nil, err
+		}
+		if bound == "" {
+			continue
+		}
+		preds = append(preds, func(i *issue) bool {
+			v := f.value(i)
+			return v != "" && (f.since && v >= bound || !f.since && v <= bound)
+		})
+	}
+
+	for param, has := range map[string]func(i *issue) bool{
+		"hasDueDate": func(i *issue) bool { return i.DueDate != "" },
+		"attachment": func(i *issue) bool { return len(i.Attachments) > 0 },
+		"sharedFile": func(i *issue) bool { return len(i.SharedFiles) > 0 },
+	} {
+		want, ok, err := optionalBool(r, param)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			preds = append(preds, func(i *issue) bool { return has(i) == want })
+		}
+	}
+
+	parentChild, err := optionalInt(r, "parentChild", 0)
+	if err != nil {
+		return nil, err
+	}
+	if parentChild < 0 || parentChild > 4 {
+		return nil, errInvalidParam("parentChild")
+	}
+	if parentChild != 0 {
+		parents := map[int]bool{}
+		for _, i := range s.issues {
+			if i.ParentIssueID != 0 {
+				parents[i.ParentIssueID] = true
+			}
+		}
+		preds = append(preds, func(i *issue) bool {
+			child := i.ParentIssueID != 0
+			switch parentChild {
+			case 1:
+				return !child
+			case 2:
+				return child
+			case 3:
+				return !child && !parents[i.ID]
+			default:
+				return parents[i.ID]
+			}
+		})
+	}
+
+	sortKey := cmp.Or(r.Form.Get("sort"), "created")
+	compare, ok := issueSortKeys[sortKey]
+	if !ok {
+		return nil, errInvalidParam("sort")
+	}
+
+	out := []*issue{}
+	for _, i := range s.issues {
+		if !slices.ContainsFunc(preds, func(pred func(*issue) bool) bool { return !pred(i) }) {
+			out = append(out, i)
+		}
+	}
+	slices.SortStableFunc(out, func(a, b *issue) int {
+		return cmp.Or(compare(a, b), cmp.Compare(a.ID, b.ID))
+	})
+	return out, nil
+}
+
+func (s *Server) listIssues(r *http.Request) (any, error) {
+	matched, err := s.filterIssues(r)
+	if err != nil {
+		return nil, err
+	}
+	matched, err = page(r, matched, true)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.Issue, len(matched))
+	for n, i := range matched {
+		out[n] = i.Issue
+	}
+	return out, nil
+}
+
+func (s *Server) countIssues(r *http.Request) (any, error) {
+	matched, err := s.filterIssues(r)
+	if err != nil {
+		return nil, err
+	}
+	return &countResult{Count: len(matched)}, nil
+}
+
+// ──────────────────────────────────────────────────────────────
+//  CRUD
+// ──────────────────────────────────────────────────────────────
+
+func (s *Server) getIssue(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return i.Issue, nil
+}
+
+func (s *Server) addIssue(r *http.Request) (any, error) {
+	projectID, err := requiredInt(r, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	p := s.findProject(strconv.Itoa(projectID))
+	if p == nil {
+		return nil, errNoResource("project")
+	}
+	if _, err := requiredString(r, "summary"); err != nil {
+		return nil, err
+	}
+	if _, err := requiredInt(r, "issueTypeId"); err != nil {
+		return nil, err
+	}
+	if _, err := requiredInt(r, "priorityId"); err != nil {
+		return nil, err
+	}
+	params, err := s.parseIssueParams(r, p)
+	if err != nil {
+		return nil, err
+	}
+
+	i := s.newIssue(p, "", nil, nil)
+	s.applyIssueParams(i, params)
+	return i.Issue, nil
+}
+
+func (s *Server) updateIssue(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	params, err := s.parseIssueParams(r, s.findProject(strconv.Itoa(i.ProjectID)))
+	if err != nil {
+		return nil, err
+	}
+
+	changes := s.applyIssueParams(i, params)
+	if len(changes) > 0 || params.comment != "" {
+		now := s.timestamp()
+		i.comments = append(i.comments, &model.Comment{
+			ID:          s.nextID("comment"),
+			Content:     params.comment,
+			ChangeLogs:  changes,
+			CreatedUser: s.myself,
+			Created:     now,
+			Updated:     now,
+		})
+		i.UpdatedUser = s.myself
+		i.Updated = now
+	}
+	return i.Issue, nil
+}
+
+func (s *Server) deleteIssue(r *http.Request) (any, error) {
+	i, err := s.issueParam(r)
+	if err != nil {
+		return nil, err
+	}
+	s.removeIssues(func(v *issue) bool { return v == i })
+	return i.Issue, nil
+}
+
+// removeIssues deletes the issues for which del returns true, along with
+// their attachments.
+func (s *Server) removeIssues(del func(i *issue) bool) {
+	s.issues = slices.DeleteFunc(s.issues, func(i *issue) bool {
+		if !del(i) {
+			return false
+		}
+		for _, a := range i.Attachments {
+			delete(s.attachments, a.ID)
+		}
+		return true
+	})
+}
+
+// issueParams holds the validated params of an add or update issue request.
+// Nil fields were not given.
+type issueParams struct {
+	summary        *string
+	description    *string
+	issueType      *model.IssueType
+	priority       *model.Priority
+	status         *model.Status
+	resolution     *model.Resolution
+	assignee       *model.User
+	startDate      *string
+	dueDate        *string
+	estimatedHours *float64
+	actualHours    *float64
+	parentIssueID  *int
+	attachments    []*attachment
+	comment        string
+}
+
+func (s *Server) parseIssueParams(r *http.Request, p *project) (*issueParams, error) {
+	params := &issueParams{comment: r.Form.Get("comment")}
+
+	for param, field := range map[string]**string{
+		"summary":     &params.summary,
+		"description": &params.description,
+	} {
+		if r.Form.Has(param) {
+			v := r.Form.Get(param)
+			*field = &v
+		}
+	}
+	if params.summary != nil && *params.summary == "" {
+		return nil, errRequired("summary")
+	}
+
+	for param, field := range map[string]**string{
+		"startDate": &params.startDate,
+		"dueDate":   &params.dueDate,
+	} {
+		if r.Form.Has(param) {
+			v, err := date(param, r.Form.Get(param))
+			if err != nil {
+				return nil, err
+			}
+			*field = &v
+		}
+	}
+
+	for param, field := range map[string]**float64{
+		"estimatedHours": &params.estimatedHours,
+		"actualHours":    &params.actualHours,
+	} {
+		if v := r.Form.Get(param); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f < 0 {
+				return nil, errInvalidParam(param)
+			}
+			*field = &f
+		}
+	}
+
+	// lookups resolves the ID params to the objects they refer to.
+	lookups := []struct {
+		param string
+		find  func(id int) bool
+	}{
+		{"issueTypeId", func(id int) bool { params.issueType = p.findIssueType(id); return params.issueType != nil }},
+		{"priorityId", func(id int) bool { params.priority = findPriority(id); return params.priority != nil }},
+		{"statusId", func(id int) bool { params.status = p.findStatus(id); return params.status != nil }},
+		{"resolutionId", func(id int) bool { params.resolution = findResolution(id); return params.resolution != nil }},
+		{"assigneeId", func(id int) bool { params.assignee = s.findUser(id); return params.assignee != nil }},
+		{"parentIssueId", func(id int) bool {
+			parent := s.findIssue(strconv.Itoa(id))
+			params.parentIssueID = &id
+			return parent != nil && parent.ProjectID == p.ID && parent.ParentIssueID == 0
+		}},
+	}
+	for _, l := range lookups {
+		if !r.Form.Has(l.param) {
+			continue
+		}
+		id, err := strconv.Atoi(r.Form.Get(l.param))
+		if err != nil || !l.find(id) {
+			return nil, errInvalidParam(l.param)
+		}
+	}
+
+	attachments, err := s.attachmentsParam(r)
+	if err != nil {
+		return nil, err
+	}
+	params.attachments = attachments
+
+	return params, nil
+}
+
+// applyIssueParams applies params to i and returns the resulting change log.
+func (s *Server) applyIssueParams(i *issue, params *issueParams) []*model.ChangeLog {
+	var changes []*model.ChangeLog
+	change := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, &model.ChangeLog{Field: field, OriginalValue: from, NewValue: to})
+		}
+	}
+
+	if params.summary != nil {
+		change("summary", i.Summary, *params.summary)
+		i.Summary = *params.summary
+	}
+	if params.description != nil {
+		change("description", i.Description, *params.description)
+		i.Description = *params.description
+	}
+	if params.issueType != nil {
+		if i.IssueType != nil {
+			change("issueType", i.IssueType.Name, params.issueType.Name)
+		}
+		i.IssueType = params.issueType
+	}
+	if params.priority != nil {
+		if i.Priority != nil {
+			change("priority", i.Priority.Name, params.priority.Name)
+		}
+		i.Priority = params.priority
+	}
+	if params.status != nil {
+		change("status", i.Status.Name, params.status.Name)
+		i.Status = params.status
+	}
+	if params.resolution != nil {
+		var from string
+		if len(i.Resolutions) > 0 {
+			from = i.Resolutions[0].Name
+		}
+		change("resolution", from, params.resolution.Name)
+		i.Resolutions = []*model.Resolution{params.resolution}
+	}
+	if params.assignee != nil {
+		var from string
+		if i.Assignee != nil {
+			from = i.Assignee.Name
+		}
+		change("assigner", from, params.assignee.Name)
+		i.Assignee = params.assignee
+	}
+	if params.startDate != nil {
+		change("startDate", i.StartDate, *params.startDate)
+		i.StartDate = *params.startDate
+	}
+	if params.dueDate != nil {
+		change("limitDate", i.DueDate, *params.dueDate)
+		i.DueDate = *params.dueDate
+	}
+	if params.estimatedHours != nil {
+		change("estimatedHours", formatHours(i.EstimatedHours), formatHours(*params.estimatedHours))
+		i.EstimatedHours = *params.estimatedHours
+	}
+	if params.actualHours != nil {
+		change("actualHours", formatHours(i.ActualHours), formatHours(*params.actualHours))
+		i.ActualHours = *params.actualHours
+	}
+	if params.parentIssueID != nil {
+		change("parentIssue", strconv.Itoa(i.ParentIssueID), strconv.Itoa(*params.parentIssueID))
+		i.ParentIssueID = *params.parentIssueID
+	}
+	for _, a := range params.attachments {
+		a.attached = true
+		i.Attachments = append(i.Attachments, a.Attachment)
+		changes = append(changes, &model.ChangeLog{
+			Field:          "attachment",
+			NewValue:       a.Name,
+			AttachmentInfo: &model.AttachmentInfo{ID: a.ID, Name: a.Name},
+		})
+	}
+	return changes
+}
+
+func formatHours(h float64) string {
+	if h == 0 {
+		return ""
+	}
+	return fmt.Sprint(h)
+}
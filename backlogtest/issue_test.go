@@ -0,0 +1,281 @@
+package backlogtest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_IssueFilters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	projectID := srv.AddProject("PRJ", "Project")
+	otherID := srv.AddProject("OTHER", "Other")
+
+	for _, summary := range []string{"fix login", "add search", "fix logout"} {
+		_, err := c.Issue.Create(ctx, projectID, summary, 1, backlog.PriorityNormal)
+		require.NoError(t, err)
+	}
+	_, err := c.Issue.Create(ctx, otherID, "fix other", 3, backlog.PriorityHigh,
+		c.Issue.Option.WithDueDate("2024-04-30"))
+	require.NoError(t, err)
+	_, err = c.Issue.Update(ctx, "PRJ-2", c.Issue.Option.WithStatusID(backlog.IssueStatusInProgress))
+	require.NoError(t, err)
+
+	o := c.Issue.Option
+	cases := map[string]struct {
+		opts []backlog.RequestOption
+		want []string
+	}{
+		"default": {
+			want: []string{"OTHER-1", "PRJ-3", "PRJ-2", "PRJ-1"},
+		},
+		"project": {
+			opts: []backlog.RequestOption{o.WithProjectIDs([]int{otherID})},
+			want: []string{"OTHER-1"},
+		},
+		"status": {
+			opts: []backlog.RequestOption{o.WithStatusIDs([]int{backlog.IssueStatusInProgress})},
+			want: []string{"PRJ-2"},
+		},
+		"priority": {
+			opts: []backlog.RequestOption{o.WithPriorityIDs([]int{backlog.PriorityHigh})},
+			want: []string{"OTHER-1"},
+		},
+		"keyword": {
+			opts: []backlog.RequestOption{o.WithKeyword("FIX LOG")},
+			want: []string{"PRJ-3", "PRJ-1"},
+		},
+		"has-due-date": {
+			opts: []backlog.RequestOption{o.WithHasDueDate(true)},
+			want: []string{"OTHER-1"},
+		},
+		"due-date-until": {
+			opts: []backlog.RequestOption{o.WithDueDateUntil("2024-04-01")},
+			want: []string{},
+		},
+		"sort-summary-asc": {
+			opts: []backlog.RequestOption{o.WithIssueSort(backlog.IssueSortSummary), o.WithOrder(backlog.OrderAsc)},
+			want: []string{"PRJ-2", "PRJ-1", "PRJ-3", "OTHER-1"},
+		},
+		"count-offset": {
+			opts: []backlog.RequestOption{o.WithCount(2), o.WithOffset(1)},
+			want: []string{"PRJ-3", "PRJ-2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			issues, err := c.Issue.List(ctx, tc.opts...)
+			require.NoError(t, err)
+			keys := make([]string, len(issues))
+			for i, issue := range issues {
+				keys[i] = issue.IssueKey
+			}
+			assert.Equal(t, tc.want, keys)
+		})
+	}
+}
+
+func TestServer_IssueCount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	srv.AddProject("PRJ", "Project")
+	for range 3 {
+		srv.AddIssue("PRJ", "summary")
+	}
+
+	count, err := c.Issue.Count(ctx, c.Issue.Option.WithStatusIDs([]int{backlog.IssueStatusOpen}))
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	count, err = c.Issue.Count(ctx, c.Issue.Option.WithStatusIDs([]int{backlog.IssueStatusClosed}))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestServer_IssueErrors(t *testing.T) {
+	t.Parallel()
+
+	srv, c := newClient(t)
+	projectID := srv.AddProject("PRJ", "Project")
+
+	cases := map[string]struct {
+		call   func(ctx context.Context) error
+		status int
+		code   int
+	}{
+		"no-issue": {
+			call: func(ctx context.Context) error {
+				_, err := c.Issue.One(ctx, "PRJ-99")
+				return err
+			},
+			status: http.StatusNotFound,
+			code:   backlog.ErrorCodeNoResource,
+		},
+		"no-project": {
+			call: func(ctx context.Context) error {
+				_, err := c.Issue.Create(ctx, 99, "summary", 1, backlog.PriorityNormal)
+				return err
+			},
+			status: http.StatusNotFound,
+			code:   backlog.ErrorCodeNoResource,
+		},
+		"invalid-issue-type": {
+			call: func(ctx context.Context) error {
+				_, err := c.Issue.Create(ctx, projectID, "summary", 99, backlog.PriorityNormal)
+				return err
+			},
+			status: http.StatusBadRequest,
+			code:   backlog.ErrorCodeInvalidRequest,
+		},
+		"invalid-attachment": {
+			call: func(ctx context.Context) error {
+				_, err := c.Issue.Create(ctx, projectID, "summary", 1, backlog.PriorityNormal,
+					c.Issue.Option.WithAttachmentIDs([]int{99}))
+				return err
+			},
+			status: http.StatusBadRequest,
+			code:   backlog.ErrorCodeInvalidRequest,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			requireAPIError(t, tc.call(context.Background()), tc.status, tc.code)
+		})
+	}
+}
+
+func TestServer_IssueWorkflow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	projectID := srv.AddProject("PRJ", "Project")
+
+	issue, err := c.Issue.Create(ctx, projectID, "summary", 1, backlog.PriorityNormal)
+	require.NoError(t, err)
+	assert.Equal(t, "PRJ-1", issue.IssueKey)
+	assert.Equal(t, backlog.IssueStatusOpen, issue.Status.ID)
+
+	updated, err := c.Issue.Update(ctx, issue.IssueKey,
+		c.Issue.Option.WithSummary("new summary"),
+		c.Issue.Option.WithAssigneeID(srv.MyselfID()),
+		c.Issue.Option.WithComment("taking this"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "new summary", updated.Summary)
+	require.NotNil(t, updated.Assignee)
+	assert.Equal(t, srv.MyselfID(), updated.Assignee.ID)
+
+	comments, err := c.Issue.Comment.List(ctx, issue.IssueKey)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "taking this", comments[0].Content)
+	fields := make([]string, len(comments[0].ChangeLogs))
+	for i, cl := range comments[0].ChangeLogs {
+		fields[i] = cl.Field
+	}
+	assert.ElementsMatch(t, []string{"summary", "assigner"}, fields)
+
+	_, err = c.Issue.Delete(ctx, issue.IssueKey)
+	require.NoError(t, err)
+
+	_, err = c.Issue.One(ctx, issue.IssueKey)
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
+
+func TestServer_Comments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	srv.AddProject("PRJ", "Project")
+	issueKey := srv.AddIssue("PRJ", "summary")
+
+	for _, content := range []string{"first", "second", "third"} {
+		_, err := c.Issue.Comment.Add(ctx, issueKey, content)
+		require.NoError(t, err)
+	}
+
+	_, err := c.Issue.Comment.Add(ctx, issueKey, "")
+	var validationErr *backlog.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	count, err := c.Issue.Comment.Count(ctx, issueKey)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	comments, err := c.Issue.Comment.List(ctx, issueKey, c.Issue.Comment.Option.WithOrder(backlog.OrderAsc), c.Issue.Comment.Option.WithCount(2))
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, "first", comments[0].Content)
+	assert.Equal(t, "second", comments[1].Content)
+
+	updated, err := c.Issue.Comment.Update(ctx, issueKey, comments[0].ID, "edited")
+	require.NoError(t, err)
+	assert.Equal(t, "edited", updated.Content)
+
+	one, err := c.Issue.Comment.One(ctx, issueKey, comments[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "edited", one.Content)
+
+	_, err = c.Issue.Comment.Delete(ctx, issueKey, comments[0].ID)
+	require.NoError(t, err)
+
+	_, err = c.Issue.Comment.One(ctx, issueKey, comments[0].ID)
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
+
+func TestServer_IssueAttachments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	projectID := srv.AddProject("PRJ", "Project")
+
+	uploaded, err := c.Space.Attachment.Upload(ctx, "notes.txt", strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "notes.txt", uploaded.Name)
+	assert.Equal(t, 5, uploaded.Size)
+
+	issue, err := c.Issue.Create(ctx, projectID, "summary", 1, backlog.PriorityNormal,
+		c.Issue.Option.WithAttachmentIDs([]int{uploaded.ID}))
+	require.NoError(t, err)
+	require.Len(t, issue.Attachments, 1)
+
+	// An attachment can only be attached once.
+	_, err = c.Issue.Create(ctx, projectID, "summary", 1, backlog.PriorityNormal,
+		c.Issue.Option.WithAttachmentIDs([]int{uploaded.ID}))
+	requireAPIError(t, err, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	file, err := c.Issue.Attachment.Download(ctx, issue.IssueKey, uploaded.ID)
+	require.NoError(t, err)
+	defer file.Body.Close()
+	body, err := io.ReadAll(file.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "notes.txt", file.Filename)
+	assert.Equal(t, "hello", string(body))
+
+	_, err = c.Issue.Attachment.Remove(ctx, issue.IssueKey, uploaded.ID)
+	require.NoError(t, err)
+
+	attachments, err := c.Issue.Attachment.List(ctx, issue.IssueKey)
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+}
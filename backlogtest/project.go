@@ -0,0 +1,381 @@
+package backlogtest
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// project holds a project together with its master data.
+type project struct {
+	*model.Project
+	statuses   []*model.Status
+	issueTypes []*model.IssueType
+	// lastKeyID is the key number of the most recently created issue.
+	lastKeyID int
+}
+
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// defaultStatuses are created with every project. Their IDs match the
+// IssueStatus constants of the backlog package.
+var defaultStatuses = []struct {
+	id    int
+	name  string
+	color string
+}{
+	{1, "Open", "#ed8077"},
+	{2, "In Progress", "#4488c5"},
+	{3, "Resolved", "#5eb5a6"},
+	{4, "Closed", "#b0be3c"},
+}
+
+var priorities = []*model.Priority{
+	{ID: 2, Name: "High"},
+	{ID: 3, Name: "Normal"},
+	{ID: 4, Name: "Low"},
+}
+
+var resolutions = []*model.Resolution{
+	{ID: 0, Name: "Fixed"},
+	{ID: 1, Name: "Won't Fix"},
+	{ID: 2, Name: "Invalid"},
+	{ID: 3, Name: "Duplication"},
+	{ID: 4, Name: "Cannot Reproduce"},
+}
+
+// AddProject adds a project with the given key and name and returns its ID.
+// The project is created with the default statuses and the issue types
+// "Task" and "Bug". It panics if key is invalid or already in use.
+func (s *Server) AddProject(key, name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.newProject(key, name)
+	if err != nil {
+		panic("backlogtest: " + err.Error())
+	}
+	return p.ID
+}
+
+func (s *Server) newProject(key, name string) (*project, error) {
+	if !projectKeyPattern.MatchString(key) {
+		return nil, errInvalidParam("key")
+	}
+	if slices.ContainsFunc(s.projects, func(p *project) bool { return p.ProjectKey == key }) {
+		return nil, errInvalidRequest("The project key is already in use.")
+	}
+
+	p := &project{Project: &model.Project{
+		ID:                 s.nextID("project"),
+		ProjectKey:         key,
+		Name:               name,
+		TextFormattingRule: "markdown",
+		UseWiki:            true,
+		UseFileSharing:     true,
+		DisplayOrder:       len(s.projects),
+	}}
+	for i, st := range defaultStatuses {
+		p.statuses = append(p.statuses, &model.Status{
+			ID:           st.id,
+			ProjectID:    p.ID,
+			Name:         st.name,
+			Color:        st.color,
+			DisplayOrder: 1000 * (i + 1),
+		})
+	}
+	for i, name := range []string{"Task", "Bug"} {
+		p.issueTypes = append(p.issueTypes, &model.IssueType{
+			ID:           s.nextID("issueType"),
+			ProjectID:    p.ID,
+			Name:         name,
+			Color:        "#7ea800",
+			DisplayOrder: i,
+		})
+	}
+	s.projects = append(s.projects, p)
+	return p, nil
+}
+
+// findProject returns the project with the given ID or key.
+func (s *Server) findProject(idOrKey string) *project {
+	id, err := strconv.Atoi(idOrKey)
+	for _, p := range s.projects {
+		if (err == nil && p.ID == id) || p.ProjectKey == idOrKey {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) projectParam(r *http.Request) (*project, error) {
+	p := s.findProject(r.PathValue("projectIdOrKey"))
+	if p == nil {
+		return nil, errNoResource("project")
+	}
+	return p, nil
+}
+
+func (p *project) findStatus(id int) *model.Status {
+	for _, st := range p.statuses {
+		if st.ID == id {
+			return st
+		}
+	}
+	return nil
+}
+
+func (p *project) findIssueType(id int) *model.IssueType {
+	for _, it := range p.issueTypes {
+		if it.ID == id {
+			return it
+		}
+	}
+	return nil
+}
+
+func findPriority(id int) *model.Priority {
+	for _, pr := range priorities {
+		if pr.ID == id {
+			return pr
+		}
+	}
+	return nil
+}
+
+func findResolution(id int) *model.Resolution {
+	for _, res := range resolutions {
+		if res.ID == id {
+			return res
+		}
+	}
+	return nil
+}
+
+func (s *Server) listPriorities(r *http.Request) (any, error) {
+	return priorities, nil
+}
+
+func (s *Server) listResolutions(r *http.Request) (any, error) {
+	return resolutions, nil
+}
+
+func (s *Server) listProjects(r *http.Request) (any, error) {
+	archived, filter, err := optionalBool(r, "archived")
+	if err != nil {
+		return nil, err
+	}
+	out := []*model.Project{}
+	for _, p := range s.projects {
+		if !filter || p.Archived == archived {
+			out = append(out, p.Project)
+		}
+	}
+	return out, nil
+}
+
+func (s *Server) getProject(r *http.Request) (any, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return p.Project, nil
+}
+
+func (s *Server) addProject(r *http.Request) (any, error) {
+	name, err := requiredString(r, "name")
+	if err != nil {
+		return nil, err
+	}
+	key, err := requiredString(r, "key")
+	if err != nil {
+		return nil, err
+	}
+	// Validate the optional params before the project is stored.
+	if err := applyProjectParams(r, &model.Project{}); err != nil {
+		return nil, err
+	}
+	p, err := s.newProject(key, name)
+	if err != nil {
+		return nil, err
+	}
+	_ = applyProjectParams(r, p.Project)
+	return p.Project, nil
+}
+
+func (s *Server) updateProject(r *http.Request) (any, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, err
+	}
+	// Validate every param before the project is modified.
+	if err := applyProjectParams(r, &model.Project{}); err != nil {
+		return nil, err
+	}
+	archived, setArchived, err := optionalBool(r, "archived")
+	if err != nil {
+		return nil, err
+	}
+	key := r.Form.Get("key")
+	if key != "" && key != p.ProjectKey {
+		if !projectKeyPattern.MatchString(key) {
+			return nil, errInvalidParam("key")
+		}
+		if s.findProject(key) != nil {
+			return nil, errInvalidRequest("The project key is already in use.")
+		}
+		p.ProjectKey = key
+		for _, i := range s.issues {
+			if i.ProjectID == p.ID {
+				i.IssueKey = key + "-" + strconv.Itoa(i.KeyID)
+			}
+		}
+	}
+	if name := r.Form.Get("name"); name != "" {
+		p.Name = name
+	}
+	_ = applyProjectParams(r, p.Project)
+	if setArchived {
+		p.Archived = archived
+	}
+	return p.Project, nil
+}
+
+func applyProjectParams(r *http.Request, p *model.Project) error {
+	for param, field := range map[string]*bool{
+		"chartEnabled":                      &p.ChartEnabled,
+		"subtaskingEnabled":                 &p.SubtaskingEnabled,
+		"projectLeaderCanEditProjectLeader": &p.ProjectLeaderCanEditProjectLeader,
+	} {
+		v, ok, err := optionalBool(r, param)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*field = v
+		}
+	}
+	switch rule := r.Form.Get("textFormattingRule"); rule {
+	case "":
+	case "backlog", "markdown":
+		p.TextFormattingRule = rule
+	default:
+		return errInvalidParam("textFormattingRule")
+	}
+	return nil
+}
+
+func (s *Server) deleteProject(r *http.Request) (any, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, err
+	}
+	s.projects = slices.DeleteFunc(s.projects, func(v *project) bool { return v == p })
+	s.removeIssues(func(i *issue) bool { return i.ProjectID == p.ID })
+	s.wikis = slices.DeleteFunc(s.wikis, func(w *wiki) bool { return w.ProjectID == p.ID })
+	return p.Project, nil
+}
+
+func (s *Server) listStatuses(r *http.Request) (any, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return p.statuses, nil
+}
+
+func (s *Server) addStatus(r *http.Request) (any, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, err
+	}
+	name, err := requiredString(r, "name")
+	if err != nil {
+		return nil, err
+	}
+	color, err := requiredString(r, "color")
+	if err != nil {
+		return nil, err
+	}
+	if slices.ContainsFunc(p.statuses, func(st *model.Status) bool { return st.Name == name }) {
+		return nil, errInvalidRequest("The status name is already in use.")
+	}
+
+	// Custom statuses are listed before the "Closed" status.
+	st := &model.Status{
+		ID:           s.nextID("status"),
+		ProjectID:    p.ID,
+		Name:         name,
+		Color:        color,
+		DisplayOrder: p.statuses[len(p.statuses)-2].DisplayOrder + 1,
+	}
+	p.statuses = slices.Insert(p.statuses, len(p.statuses)-1, st)
+	return st, nil
+}
+
+func (s *Server) statusParam(r *http.Request) (*project, *model.Status, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := pathInt(r, "id", "status")
+	if err != nil {
+		return nil, nil, err
+	}
+	st := p.findStatus(id)
+	if st == nil {
+		return nil, nil, errNoResource("status")
+	}
+	return p, st, nil
+}
+
+func (s *Server) updateStatus(r *http.Request) (any, error) {
+	_, st, err := s.statusParam(r)
+	if err != nil {
+		return nil, err
+	}
+	if name := r.Form.Get("name"); name != "" {
+		st.Name = name
+	}
+	if color := r.Form.Get("color"); color != "" {
+		st.Color = color
+	}
+	return st, nil
+}
+
+func (s *Server) deleteStatus(r *http.Request) (any, error) {
+	p, st, err := s.statusParam(r)
+	if err != nil {
+		return nil, err
+	}
+	if st.ID <= len(defaultStatuses) {
+		return nil, errInvalidRequest("The default statuses cannot be deleted.")
+	}
+	substituteID, err := requiredInt(r, "substituteStatusId")
+	if err != nil {
+		return nil, err
+	}
+	substitute := p.findStatus(substituteID)
+	if substitute == nil || substitute == st {
+		return nil, errInvalidParam("substituteStatusId")
+	}
+
+	for _, i := range s.issues {
+		if i.ProjectID == p.ID && i.Status.ID == st.ID {
+			i.Status = substitute
+		}
+	}
+	p.statuses = slices.DeleteFunc(p.statuses, func(v *model.Status) bool { return v == st })
+	return st, nil
+}
+
+func (s *Server) listIssueTypes(r *http.Request) (any, error) {
+	p, err := s.projectParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return p.issueTypes, nil
+}
@@ -0,0 +1,96 @@
+package backlogtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Projects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	srv.AddProject("SEED", "Seed")
+
+	created, err := c.Project.Create(ctx, "PRJ", "Project", c.Project.Option.WithChartEnabled(true))
+	require.NoError(t, err)
+	assert.Equal(t, "PRJ", created.ProjectKey)
+	assert.True(t, created.ChartEnabled)
+
+	_, err = c.Project.Create(ctx, "PRJ", "Duplicate")
+	requireAPIError(t, err, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	_, err = c.Project.Create(ctx, "lower", "Invalid key")
+	requireAPIError(t, err, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	issueKey := srv.AddIssue("PRJ", "summary")
+	assert.Equal(t, "PRJ-1", issueKey)
+
+	updated, err := c.Project.Update(ctx, "PRJ", c.Project.Option.WithKey("NEW"), c.Project.Option.WithArchived(true))
+	require.NoError(t, err)
+	assert.Equal(t, "NEW", updated.ProjectKey)
+	assert.True(t, updated.Archived)
+
+	issue, err := c.Issue.One(ctx, "NEW-1")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, issue.ProjectID)
+
+	archived, err := c.Project.List(ctx, c.Project.Option.WithArchived(true))
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.Equal(t, created.ID, archived[0].ID)
+
+	_, err = c.Project.Delete(ctx, "NEW")
+	require.NoError(t, err)
+
+	_, err = c.Project.One(ctx, "NEW")
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+
+	_, err = c.Issue.One(ctx, "NEW-1")
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
+
+func TestServer_Statuses(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	srv.AddProject("PRJ", "Project")
+	issueKey := srv.AddIssue("PRJ", "summary")
+
+	status, err := c.Project.Status.Create(ctx, "PRJ", "Review", "#ea733b")
+	require.NoError(t, err)
+	assert.Equal(t, 5, status.ID)
+
+	statuses, err := c.Project.Status.List(ctx, "PRJ")
+	require.NoError(t, err)
+	names := make([]string, len(statuses))
+	for i, st := range statuses {
+		names[i] = st.Name
+	}
+	assert.Equal(t, []string{"Open", "In Progress", "Resolved", "Review", "Closed"}, names)
+
+	_, err = c.Issue.Update(ctx, issueKey, c.Issue.Option.WithStatusID(status.ID))
+	require.NoError(t, err)
+
+	_, err = c.Project.Status.Delete(ctx, "PRJ", backlog.IssueStatusOpen, backlog.IssueStatusClosed)
+	requireAPIError(t, err, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	_, err = c.Project.Status.Delete(ctx, "PRJ", status.ID, backlog.IssueStatusResolved)
+	require.NoError(t, err)
+
+	issue, err := c.Issue.One(ctx, issueKey)
+	require.NoError(t, err)
+	assert.Equal(t, backlog.IssueStatusResolved, issue.Status.ID)
+
+	issueTypes, err := c.Project.IssueType.List(ctx, "PRJ")
+	require.NoError(t, err)
+	require.Len(t, issueTypes, 2)
+	assert.Equal(t, "Task", issueTypes[0].Name)
+	assert.Equal(t, "Bug", issueTypes[1].Name)
+}
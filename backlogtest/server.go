@@ -0,0 +1,425 @@
+// Package backlogtest provides an in-memory fake of the Backlog API for
+// integration tests.
+//
+// A [Server] is an [httptest.Server] that serves a subset of the Backlog API
+// v2 and keeps users, projects, statuses, issue types, issues, comments,
+// wikis and attachments in memory. Point a client at it with
+//
+//	srv := backlogtest.NewServer()
+//	defer srv.Close()
+//	c, _ := backlog.NewClient(srv.URL, "token")
+//
+// and exercise whole workflows: objects created through the client can be
+// read back, filtered, updated and deleted. Failures are reported with the
+// same status codes and error payloads as Backlog, so they surface as
+// *backlog.APIResponseError just like against a real space.
+//
+// The server starts with one user, who is the authenticated user for every
+// request. Seed further state with the Add methods, such as
+// [Server.AddProject] and [Server.AddIssue].
+//
+// A [Cassette] records the interactions of a client with a real space once
+// and replays them in later runs, and a [FaultDoer] injects latency, dropped
+// connections and error responses to test how code copes with outages.
+package backlogtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// Server is a fake Backlog API server backed by in-memory state.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	token string
+	now   func() time.Time
+
+	mu          sync.Mutex
+	ids         map[string]int
+	myself      *model.User
+	users       []*model.User
+	projects    []*project
+	issues      []*issue
+	wikis       []*wiki
+	attachments map[int]*attachment
+}
+
+// Option configures a [Server].
+type Option func(*Server)
+
+// WithToken makes the server reject requests whose API key or bearer token
+// is not token. By default any non-empty credential is accepted.
+func WithToken(token string) Option {
+	return func(s *Server) { s.token = token }
+}
+
+// WithClock sets the function used to timestamp created and updated
+// objects. By default the current time is used.
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) { s.now = now }
+}
+
+// NewServer starts and returns a new fake Backlog server.
+// The caller should call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		now:         time.Now,
+		ids:         map[string]int{"status": len(defaultStatuses)},
+		attachments: map[int]*attachment{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.myself = &model.User{
+		ID:          s.nextID("user"),
+		UserID:      "admin",
+		Name:        "admin",
+		RoleType:    1,
+		Lang:        "en",
+		MailAddress: "admin@example.com",
+	}
+	s.users = append(s.users, s.myself)
+
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Routing
+// ──────────────────────────────────────────────────────────────
+
+// handlerFunc handles a request with the server lock held and returns the
+// value to encode as the JSON response body, a *download, or an *apiError.
+type handlerFunc func(r *http.Request) (any, error)
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	handle := func(pattern string, h handlerFunc) {
+		mux.Handle(pattern, s.wrap(h))
+	}
+
+	handle("GET /api/v2/users", s.listUsers)
+	handle("GET /api/v2/users/myself", s.getMyself)
+	handle("GET /api/v2/users/{userId}", s.getUser)
+	handle("POST /api/v2/users", s.addUser)
+	handle("PATCH /api/v2/users/{userId}", s.updateUser)
+	handle("DELETE /api/v2/users/{userId}", s.deleteUser)
+
+	handle("GET /api/v2/priorities", s.listPriorities)
+	handle("GET /api/v2/resolutions", s.listResolutions)
+
+	handle("GET /api/v2/projects", s.listProjects)
+	handle("GET /api/v2/projects/{projectIdOrKey}", s.getProject)
+	handle("POST /api/v2/projects", s.addProject)
+	handle("PATCH /api/v2/projects/{projectIdOrKey}", s.updateProject)
+	handle("DELETE /api/v2/projects/{projectIdOrKey}", s.deleteProject)
+	handle("GET /api/v2/projects/{projectIdOrKey}/statuses", s.listStatuses)
+	handle("POST /api/v2/projects/{projectIdOrKey}/statuses", s.addStatus)
+	handle("PATCH /api/v2/projects/{projectIdOrKey}/statuses/{id}", s.updateStatus)
+	handle("DELETE /api/v2/projects/{projectIdOrKey}/statuses/{id}", s.deleteStatus)
+	handle("GET /api/v2/projects/{projectIdOrKey}/issueTypes", s.listIssueTypes)
+
+	handle("GET /api/v2/issues", s.listIssues)
+	handle("GET /api/v2/issues/count", s.countIssues)
+	handle("GET /api/v2/issues/{issueIdOrKey}", s.getIssue)
+	handle("POST /api/v2/issues", s.addIssue)
+	handle("PATCH /api/v2/issues/{issueIdOrKey}", s.updateIssue)
+	handle("DELETE /api/v2/issues/{issueIdOrKey}", s.deleteIssue)
+
+	handle("GET /api/v2/issues/{issueIdOrKey}/comments", s.listComments)
+	handle("GET /api/v2/issues/{issueIdOrKey}/comments/count", s.countComments)
+	handle("GET /api/v2/issues/{issueIdOrKey}/comments/{commentId}", s.getComment)
+	handle("POST /api/v2/issues/{issueIdOrKey}/comments", s.addComment)
+	handle("PATCH /api/v2/issues/{issueIdOrKey}/comments/{commentId}", s.updateComment)
+	handle("DELETE /api/v2/issues/{issueIdOrKey}/comments/{commentId}", s.deleteComment)
+
+	handle("POST /api/v2/space/attachment", s.uploadAttachment)
+	handle("GET /api/v2/issues/{issueIdOrKey}/attachments", s.listIssueAttachments)
+	handle("GET /api/v2/issues/{issueIdOrKey}/attachments/{attachmentId}", s.downloadIssueAttachment)
+	handle("DELETE /api/v2/issues/{issueIdOrKey}/attachments/{attachmentId}", s.deleteIssueAttachment)
+
+	handle("GET /api/v2/wikis", s.listWikis)
+	handle("GET /api/v2/wikis/count", s.countWikis)
+	handle("GET /api/v2/wikis/{wikiId}", s.getWiki)
+	handle("POST /api/v2/wikis", s.addWiki)
+	handle("PATCH /api/v2/wikis/{wikiId}", s.updateWiki)
+	handle("DELETE /api/v2/wikis/{wikiId}", s.deleteWiki)
+	handle("GET /api/v2/wikis/{wikiId}/attachments", s.listWikiAttachments)
+	handle("POST /api/v2/wikis/{wikiId}/attachments", s.attachWikiAttachments)
+	handle("GET /api/v2/wikis/{wikiId}/attachments/{attachmentId}", s.downloadWikiAttachment)
+	handle("DELETE /api/v2/wikis/{wikiId}/attachments/{attachmentId}", s.deleteWikiAttachment)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.authenticate(r) {
+			writeError(w, errAuthentication())
+			return
+		}
+		if _, pattern := mux.Handler(r); pattern == "" {
+			writeError(w, &apiError{status: http.StatusNotFound, code: backlog.ErrorCodeNoResource, message: "Undefined resource. " + r.URL.Path})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) authenticate(r *http.Request) bool {
+	cred := r.URL.Query().Get("apiKey")
+	if auth := r.Header.Get("Authorization"); cred == "" && strings.HasPrefix(auth, "Bearer ") {
+		cred = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if cred == "" {
+		return false
+	}
+	return s.token == "" || cred == s.token
+}
+
+func (s *Server) wrap(h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := parseForm(r); err != nil {
+			writeError(w, errInvalidRequest(err.Error()))
+			return
+		}
+
+		s.mu.Lock()
+		v, err := h(r)
+		var body []byte
+		if err == nil {
+			if _, ok := v.(*download); !ok {
+				body, err = json.Marshal(v)
+			}
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if d, ok := v.(*download); ok {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", d.name))
+			w.Header().Set("Content-Length", strconv.Itoa(len(d.data)))
+			_, _ = w.Write(d.data)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		_, _ = w.Write(body)
+	})
+}
+
+// parseForm populates r.Form, including the body of DELETE requests, which
+// [http.Request.ParseForm] ignores. Multipart bodies are left to the handler.
+func parseForm(r *http.Request) error {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		return nil
+	}
+	if r.Method == http.MethodDelete && r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			return err
+		}
+		r.PostForm = form
+	}
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Errors
+// ──────────────────────────────────────────────────────────────
+
+// apiError is an error response in the Backlog error payload format.
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string { return e.message }
+
+func writeError(w http.ResponseWriter, err error) {
+	ae, ok := err.(*apiError)
+	if !ok {
+		ae = &apiError{status: http.StatusInternalServerError, code: backlog.ErrorCodeInternal, message: err.Error()}
+	}
+	body, _ := json.Marshal(map[string]any{
+		"errors": []map[string]any{{"message": ae.message, "code": ae.code, "moreInfo": ""}},
+	})
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(ae.status)
+	_, _ = w.Write(body)
+}
+
+func errAuthentication() *apiError {
+	return &apiError{status: http.StatusUnauthorized, code: backlog.ErrorCodeAuthentication, message: "Authentication failure."}
+}
+
+// errNoResource reports a missing resource, such as errNoResource("issue")
+// for "No issue.".
+func errNoResource(kind string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: backlog.ErrorCodeNoResource, message: "No " + kind + "."}
+}
+
+func errInvalidRequest(message string) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: backlog.ErrorCodeInvalidRequest, message: message}
+}
+
+func errRequired(param string) *apiError {
+	return errInvalidRequest(fmt.Sprintf("Please input the required parameter '%s'.", param))
+}
+
+func errInvalidParam(param string) *apiError {
+	return errInvalidRequest(fmt.Sprintf("Invalid parameter '%s'.", param))
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Parameters
+// ──────────────────────────────────────────────────────────────
+
+// requiredString returns the non-empty value of param.
+func requiredString(r *http.Request, param string) (string, error) {
+	v := r.Form.Get(param)
+	if v == "" {
+		return "", errRequired(param)
+	}
+	return v, nil
+}
+
+// requiredInt returns the integer value of param.
+func requiredInt(r *http.Request, param string) (int, error) {
+	if !r.Form.Has(param) {
+		return 0, errRequired(param)
+	}
+	return optionalInt(r, param, 0)
+}
+
+// optionalInt returns the integer value of param, or def if it is absent.
+func optionalInt(r *http.Request, param string, def int) (int, error) {
+	v := r.Form.Get(param)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, errInvalidParam(param)
+	}
+	return n, nil
+}
+
+// optionalBool returns the boolean value of param and whether it was given.
+func optionalBool(r *http.Request, param string) (value, ok bool, err error) {
+	v := r.Form.Get(param)
+	if v == "" {
+		return false, false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, false, errInvalidParam(param)
+	}
+	return b, true, nil
+}
+
+// ints returns the integer values of a repeated param such as "statusId[]".
+func ints(r *http.Request, param string) ([]int, error) {
+	values := r.Form[param]
+	out := make([]int, 0, len(values))
+	for _, v := range values {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errInvalidParam(param)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// pathInt returns the integer value of the path wildcard name.
+func pathInt(r *http.Request, name, kind string) (int, error) {
+	n, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		return 0, errNoResource(kind)
+	}
+	return n, nil
+}
+
+// date validates a "YYYY-MM-DD" param value.
+func date(param, v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(time.DateOnly, v); err != nil {
+		return "", errInvalidParam(param)
+	}
+	return v, nil
+}
+
+// page applies the count, offset and order params to items, which must be
+// sorted in ascending order.
+func page[T any](r *http.Request, items []T, offsetParam bool) ([]T, error) {
+	count, err := optionalInt(r, "count", 20)
+	if err != nil {
+		return nil, err
+	}
+	if count < 1 || count > 100 {
+		return nil, errInvalidParam("count")
+	}
+	if r.Form.Get("order") != "asc" {
+		reversed := make([]T, len(items))
+		for i, v := range items {
+			reversed[len(items)-1-i] = v
+		}
+		items = reversed
+	}
+	if offsetParam {
+		offset, err := optionalInt(r, "offset", 0)
+		if err != nil {
+			return nil, err
+		}
+		if offset < 0 {
+			return nil, errInvalidParam("offset")
+		}
+		items = items[min(offset, len(items)):]
+	}
+	return items[:min(count, len(items))], nil
+}
+
+func (s *Server) nextID(kind string) int {
+	s.ids[kind]++
+	return s.ids[kind]
+}
+
+func (s *Server) timestamp() time.Time {
+	return s.now().UTC().Truncate(time.Second)
+}
+
+type countResult struct {
+	Count int `json:"count"`
+}
+
+// orEmpty returns list, or an empty slice if list is nil, so that it is
+// encoded as a JSON array.
+func orEmpty[T any](list []T) []T {
+	if list == nil {
+		return []T{}
+	}
+	return list
+}
@@ -0,0 +1,155 @@
+package backlogtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/backlogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClient starts a fake server and returns it with a client pointed at it.
+func newClient(t *testing.T, opts ...backlogtest.Option) (*backlogtest.Server, *backlog.Client) {
+	t.Helper()
+
+	srv := backlogtest.NewServer(opts...)
+	t.Cleanup(srv.Close)
+
+	c, err := backlog.NewClient(srv.URL, "token")
+	require.NoError(t, err)
+	return srv, c
+}
+
+// requireAPIError asserts that err is an *backlog.APIResponseError with the
+// given status and Backlog error code.
+func requireAPIError(t *testing.T, err error, status, code int) {
+	t.Helper()
+
+	var apiErr *backlog.APIResponseError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, status, apiErr.StatusCode())
+	require.Len(t, apiErr.Errors(), 1)
+	assert.Equal(t, code, apiErr.Errors()[0].Code)
+}
+
+func TestServer_Authentication(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		opts    []*backlog.ClientOption
+		token   string
+		wantErr bool
+	}{
+		"bearer": {
+			token: "secret",
+		},
+		"api-key": {
+			opts:  []*backlog.ClientOption{backlog.WithAPIKey()},
+			token: "secret",
+		},
+		"error-wrong-token": {
+			token:   "wrong",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := backlogtest.NewServer(backlogtest.WithToken("secret"))
+			t.Cleanup(srv.Close)
+
+			c, err := backlog.NewClient(srv.URL, tc.token, tc.opts...)
+			require.NoError(t, err)
+
+			user, err := c.User.Me(context.Background())
+			if tc.wantErr {
+				requireAPIError(t, err, http.StatusUnauthorized, backlog.ErrorCodeAuthentication)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, srv.MyselfID(), user.ID)
+		})
+	}
+}
+
+func TestServer_UndefinedResource(t *testing.T) {
+	t.Parallel()
+
+	_, c := newClient(t)
+
+	_, err := c.Space.Info(context.Background())
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
+
+func TestServer_WithClock(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 4, 1, 9, 30, 0, 0, time.UTC)
+	srv, c := newClient(t, backlogtest.WithClock(func() time.Time { return now }))
+	srv.AddProject("PRJ", "Project")
+
+	issue, err := c.Issue.One(context.Background(), srv.AddIssue("PRJ", "summary"))
+	require.NoError(t, err)
+	assert.True(t, now.Equal(issue.Created.Time))
+	assert.True(t, now.Equal(issue.Updated.Time))
+}
+
+func TestServer_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	srv, c := newClient(t)
+	projectID := srv.AddProject("PRJ", "Project")
+
+	errs := make(chan error, 20)
+	for range cap(errs) {
+		go func() {
+			_, err := c.Issue.Create(context.Background(), projectID, "summary", 1, 3)
+			errs <- err
+		}()
+	}
+	for range cap(errs) {
+		require.NoError(t, <-errs)
+	}
+
+	count, err := c.Issue.Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, cap(errs), count)
+}
+
+func TestServer_Users(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+
+	added, err := c.User.Add(ctx, "alice", "p@ssw0rd", "Alice", "alice@example.com", backlog.RoleNormalUser)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", added.UserID)
+	assert.Equal(t, backlog.RoleNormalUser, added.RoleType)
+
+	_, err = c.User.Add(ctx, "alice", "p@ssw0rd", "Alice", "alice@example.com", backlog.RoleNormalUser)
+	requireAPIError(t, err, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	updated, err := c.User.Update(ctx, added.ID, c.User.Option.WithName("Alice Liddell"))
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Liddell", updated.Name)
+
+	users, err := c.User.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	_, err = c.User.Delete(ctx, srv.MyselfID())
+	requireAPIError(t, err, http.StatusForbidden, backlog.ErrorCodeUnauthorizedOperation)
+
+	_, err = c.User.Delete(ctx, added.ID)
+	require.NoError(t, err)
+
+	_, err = c.User.One(ctx, added.ID)
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
@@ -0,0 +1,128 @@
+package backlogtest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// AddUser adds a user with the given login ID and name and returns its ID.
+func (s *Server) AddUser(userID, name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := &model.User{
+		ID:          s.nextID("user"),
+		UserID:      userID,
+		Name:        name,
+		RoleType:    2,
+		Lang:        "en",
+		MailAddress: userID + "@example.com",
+	}
+	s.users = append(s.users, u)
+	return u.ID
+}
+
+// MyselfID returns the ID of the authenticated user.
+func (s *Server) MyselfID() int {
+	return s.myself.ID
+}
+
+func (s *Server) findUser(id int) *model.User {
+	for _, u := range s.users {
+		if u.ID == id {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *Server) userParam(r *http.Request) (*model.User, error) {
+	id, err := pathInt(r, "userId", "user")
+	if err != nil {
+		return nil, err
+	}
+	u := s.findUser(id)
+	if u == nil {
+		return nil, errNoResource("user")
+	}
+	return u, nil
+}
+
+func (s *Server) listUsers(r *http.Request) (any, error) {
+	return s.users, nil
+}
+
+func (s *Server) getMyself(r *http.Request) (any, error) {
+	return s.myself, nil
+}
+
+func (s *Server) getUser(r *http.Request) (any, error) {
+	return s.userParam(r)
+}
+
+func (s *Server) addUser(r *http.Request) (any, error) {
+	u := &model.User{Lang: "en"}
+	var err error
+	if u.UserID, err = requiredString(r, "userId"); err != nil {
+		return nil, err
+	}
+	if _, err = requiredString(r, "password"); err != nil {
+		return nil, err
+	}
+	if u.Name, err = requiredString(r, "name"); err != nil {
+		return nil, err
+	}
+	if u.MailAddress, err = requiredString(r, "mailAddress"); err != nil {
+		return nil, err
+	}
+	if u.RoleType, err = requiredInt(r, "roleType"); err != nil {
+		return nil, err
+	}
+	if u.RoleType < 1 || u.RoleType > 6 {
+		return nil, errInvalidParam("roleType")
+	}
+	if slices.ContainsFunc(s.users, func(v *model.User) bool { return v.UserID == u.UserID }) {
+		return nil, errInvalidRequest("The user ID is already in use.")
+	}
+
+	u.ID = s.nextID("user")
+	s.users = append(s.users, u)
+	return u, nil
+}
+
+func (s *Server) updateUser(r *http.Request) (any, error) {
+	u, err := s.userParam(r)
+	if err != nil {
+		return nil, err
+	}
+	if v := r.Form.Get("name"); v != "" {
+		u.Name = v
+	}
+	if v := r.Form.Get("mailAddress"); v != "" {
+		u.MailAddress = v
+	}
+	if v := r.Form.Get("roleType"); v != "" {
+		role, err := strconv.Atoi(v)
+		if err != nil || role < 1 || role > 6 {
+			return nil, errInvalidParam("roleType")
+		}
+		u.RoleType = role
+	}
+	return u, nil
+}
+
+func (s *Server) deleteUser(r *http.Request) (any, error) {
+	u, err := s.userParam(r)
+	if err != nil {
+		return nil, err
+	}
+	if u == s.myself {
+		return nil, &apiError{status: http.StatusForbidden, code: backlog.ErrorCodeUnauthorizedOperation, message: "You cannot delete yourself."}
+	}
+	s.users = slices.DeleteFunc(s.users, func(v *model.User) bool { return v == u })
+	return u, nil
+}
@@ -0,0 +1,172 @@
+package backlogtest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// wiki holds a wiki page.
+type wiki struct {
+	*model.Wiki
+}
+
+// AddWiki adds a wiki page to the project with the given key and returns
+// its ID. It panics if the project does not exist or already has a page
+// with the same name.
+func (s *Server) AddWiki(projectKey, name, content string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.findProject(projectKey)
+	if p == nil {
+		panic("backlogtest: no project " + projectKey)
+	}
+	w, err := s.newWiki(p, name, content)
+	if err != nil {
+		panic("backlogtest: " + err.Error())
+	}
+	return w.ID
+}
+
+func (s *Server) newWiki(p *project, name, content string) (*wiki, error) {
+	if s.findWikiByName(p.ID, name) != nil {
+		return nil, errInvalidRequest("The wiki page name is already in use.")
+	}
+	now := s.timestamp()
+	w := &wiki{Wiki: &model.Wiki{
+		ID:          s.nextID("wiki"),
+		ProjectID:   p.ID,
+		Name:        name,
+		Content:     content,
+		CreatedUser: s.myself,
+		Created:     now,
+		UpdatedUser: s.myself,
+		Updated:     now,
+	}}
+	s.wikis = append(s.wikis, w)
+	return w, nil
+}
+
+func (s *Server) findWikiByName(projectID int, name string) *wiki {
+	for _, w := range s.wikis {
+		if w.ProjectID == projectID && w.Name == name {
+			return w
+		}
+	}
+	return nil
+}
+
+func (s *Server) wikiParam(r *http.Request) (*wiki, error) {
+	id, err := pathInt(r, "wikiId", "wiki")
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range s.wikis {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return nil, errNoResource("wiki")
+}
+
+// filterWikis returns the wiki pages of the project given by the
+// projectIdOrKey param that match the keyword param.
+func (s *Server) filterWikis(r *http.Request) ([]*model.Wiki, error) {
+	idOrKey, err := requiredString(r, "projectIdOrKey")
+	if err != nil {
+		return nil, err
+	}
+	p := s.findProject(idOrKey)
+	if p == nil {
+		return nil, errNoResource("project")
+	}
+	kw := strings.ToLower(r.Form.Get("keyword"))
+
+	out := []*model.Wiki{}
+	for _, w := range s.wikis {
+		if w.ProjectID == p.ID && strings.Contains(strings.ToLower(w.Name+" "+w.Content), kw) {
+			out = append(out, w.Wiki)
+		}
+	}
+	return out, nil
+}
+
+func (s *Server) listWikis(r *http.Request) (any, error) {
+	return s.filterWikis(r)
+}
+
+func (s *Server) countWikis(r *http.Request) (any, error) {
+	matched, err := s.filterWikis(r)
+	if err != nil {
+		return nil, err
+	}
+	return &countResult{Count: len(matched)}, nil
+}
+
+func (s *Server) getWiki(r *http.Request) (any, error) {
+	w, err := s.wikiParam(r)
+	if err != nil {
+		return nil, err
+	}
+	return w.Wiki, nil
+}
+
+func (s *Server) addWiki(r *http.Request) (any, error) {
+	projectID, err := requiredInt(r, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	p := s.findProject(strconv.Itoa(projectID))
+	if p == nil {
+		return nil, errNoResource("project")
+	}
+	name, err := requiredString(r, "name")
+	if err != nil {
+		return nil, err
+	}
+	content, err := requiredString(r, "content")
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.newWiki(p, name, content)
+	if err != nil {
+		return nil, err
+	}
+	return w.Wiki, nil
+}
+
+func (s *Server) updateWiki(r *http.Request) (any, error) {
+	w, err := s.wikiParam(r)
+	if err != nil {
+		return nil, err
+	}
+	name := r.Form.Get("name")
+	if name != "" && name != w.Name {
+		if s.findWikiByName(w.ProjectID, name) != nil {
+			return nil, errInvalidRequest("The wiki page name is already in use.")
+		}
+		w.Name = name
+	}
+	if r.Form.Has("content") {
+		w.Content = r.Form.Get("content")
+	}
+	w.UpdatedUser = s.myself
+	w.Updated = s.timestamp()
+	return w.Wiki, nil
+}
+
+func (s *Server) deleteWiki(r *http.Request) (any, error) {
+	w, err := s.wikiParam(r)
+	if err != nil {
+		return nil, err
+	}
+	s.wikis = slices.DeleteFunc(s.wikis, func(v *wiki) bool { return v == w })
+	for _, a := range w.Attachments {
+		delete(s.attachments, a.ID)
+	}
+	return w.Wiki, nil
+}
@@ -0,0 +1,90 @@
+package backlogtest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Wikis(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	projectID := srv.AddProject("PRJ", "Project")
+	srv.AddWiki("PRJ", "Home", "Welcome")
+
+	created, err := c.Wiki.Create(ctx, projectID, "Setup", "Install the tools")
+	require.NoError(t, err)
+	assert.Equal(t, projectID, created.ProjectID)
+
+	_, err = c.Wiki.Create(ctx, projectID, "Setup", "Duplicate")
+	requireAPIError(t, err, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	count, err := c.Wiki.Count(ctx, "PRJ")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	wikis, err := c.Wiki.List(ctx, "PRJ", c.Wiki.Option.WithKeyword("tools"))
+	require.NoError(t, err)
+	require.Len(t, wikis, 1)
+	assert.Equal(t, created.ID, wikis[0].ID)
+
+	updated, err := c.Wiki.Update(ctx, created.ID, c.Wiki.Option.WithContent("Run make"))
+	require.NoError(t, err)
+	assert.Equal(t, "Run make", updated.Content)
+
+	one, err := c.Wiki.One(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Setup", one.Name)
+	assert.Equal(t, "Run make", one.Content)
+
+	_, err = c.Wiki.Delete(ctx, created.ID)
+	require.NoError(t, err)
+
+	_, err = c.Wiki.One(ctx, created.ID)
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+
+	_, err = c.Wiki.List(ctx, "NONE")
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
+
+func TestServer_WikiAttachments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, c := newClient(t)
+	srv.AddProject("PRJ", "Project")
+	wikiID := srv.AddWiki("PRJ", "Home", "Welcome")
+
+	uploaded, err := c.Space.Attachment.Upload(ctx, "diagram.png", strings.NewReader("\x89PNG"))
+	require.NoError(t, err)
+
+	attached, err := c.Wiki.Attachment.Attach(ctx, wikiID, []int{uploaded.ID})
+	require.NoError(t, err)
+	require.Len(t, attached, 1)
+	assert.Equal(t, "diagram.png", attached[0].Name)
+
+	attachments, err := c.Wiki.Attachment.List(ctx, wikiID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+
+	file, err := c.Wiki.Attachment.Download(ctx, wikiID, uploaded.ID)
+	require.NoError(t, err)
+	defer file.Body.Close()
+	body, err := io.ReadAll(file.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "\x89PNG", string(body))
+
+	_, err = c.Wiki.Attachment.Remove(ctx, wikiID, uploaded.ID)
+	require.NoError(t, err)
+
+	_, err = c.Wiki.Attachment.Download(ctx, wikiID, uploaded.ID)
+	requireAPIError(t, err, http.StatusNotFound, backlog.ErrorCodeNoResource)
+}
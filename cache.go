@@ -0,0 +1,86 @@
+package backlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/nattokin/go-backlog/internal/client"
+)
+
+// CacheStore stores cached API responses for [WithCache].
+// Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the value stored under key, and whether it was found and has not expired.
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+// MemoryCacheStore is the default in-memory CacheStore.
+// Entries expire after the TTL they were stored with.
+type MemoryCacheStore struct {
+	inner *client.MemoryCacheStore
+}
+
+// NewMemoryCacheStore returns an empty MemoryCacheStore.
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{inner: client.NewMemoryCacheStore()}
+}
+
+// Get implements CacheStore.
+func (s *MemoryCacheStore) Get(ctx context.Context, key string) ([]byte, bool) {
+	return s.inner.Get(ctx, key)
+}
+
+// Set implements CacheStore.
+func (s *MemoryCacheStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	s.inner.Set(ctx, key, value, ttl)
+}
+
+// CacheConfig configures the response cache enabled by [WithCache].
+type CacheConfig struct {
+	// Store holds the cached responses. Nil means a new MemoryCacheStore.
+	//
+	// Cache keys contain the request URL but no credentials, so a store shared
+	// by clients with different credentials may serve data fetched by another
+	// user.
+	Store CacheStore
+
+	// TTL is how long responses are cached. Zero means 5 minutes.
+	TTL time.Duration
+}
+
+// CacheStats reports the effectiveness of the response cache.
+type CacheStats struct {
+	// Hits is the number of requests served from the cache.
+	Hits uint64
+	// Misses is the number of cacheable requests sent to the API.
+	Misses uint64
+}
+
+// WithCache returns a ClientOption that caches the responses of endpoints
+// returning rarely-changing project data:
+//   - Project.Status.List
+//   - Project.IssueType.List
+//   - Project.Category.List
+//   - Project.Version.List
+//   - Project.CustomField.List
+//
+// A successful create, update or delete of one of these resources through the
+// same Client invalidates every cached list of that resource; updating or
+// deleting a project invalidates all of them. Changes made by other clients
+// become visible once the cached entries expire.
+func WithCache(config CacheConfig) *ClientOption {
+	var store client.CacheStore
+	if config.Store != nil {
+		store = config.Store
+	}
+	return &ClientOption{inner: client.WithCache(client.NewCache(store, config.TTL))}
+}
+
+// CacheStats returns the hit and miss counts of the cache enabled by
+// [WithCache]. It returns a zero CacheStats if caching is not enabled.
+func (c *Client) CacheStats() CacheStats {
+	s := c.httpClient.Cache.Stats()
+	return CacheStats{Hits: s.Hits, Misses: s.Misses}
+}
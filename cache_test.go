@@ -0,0 +1,101 @@
+package backlog
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+type recordingCacheStore struct {
+	mu   sync.Mutex
+	data map[string][]byte
+	ttls []time.Duration
+}
+
+func (s *recordingCacheStore) Get(_ context.Context, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.data[key]
+	return v, ok
+}
+
+func (s *recordingCacheStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = value
+	s.ttls = append(s.ttls, ttl)
+}
+
+func TestWithCache(t *testing.T) {
+	calls := 0
+	mockDoer := &mock.Doer{T: t,
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			calls++
+			if req.Method == http.MethodGet {
+				return mock.NewResponse(`[{"id":1,"projectId":1,"name":"Open","color":"#ed8077","displayOrder":1000}]`), nil
+			}
+			return mock.NewResponse(`{"id":2,"projectId":1,"name":"New","color":"#ea2c00","displayOrder":2000}`), nil
+		},
+	}
+	store := &recordingCacheStore{data: map[string][]byte{}}
+	c, err := NewClient("https://example.com", "token",
+		WithDoer(mockDoer),
+		WithCache(CacheConfig{Store: store, TTL: time.Hour}),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	first, err := c.Project.Status.List(ctx, "PRJ")
+	require.NoError(t, err)
+	second, err := c.Project.Status.List(ctx, "PRJ")
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, c.CacheStats())
+	assert.Equal(t, []time.Duration{time.Hour}, store.ttls)
+
+	// A write through the same client invalidates the cached list.
+	_, err = c.Project.Status.Create(ctx, "PRJ", "New", "#ea2c00")
+	require.NoError(t, err)
+	_, err = c.Project.Status.List(ctx, "PRJ")
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 2}, c.CacheStats())
+}
+
+func TestWithCache_defaultStore(t *testing.T) {
+	c, err := NewClient("https://example.com", "token", WithCache(CacheConfig{}))
+	require.NoError(t, err)
+
+	require.NotNil(t, c.httpClient.Cache)
+	assert.NotNil(t, c.httpClient.Cache.Store)
+}
+
+func TestClient_CacheStats_disabled(t *testing.T) {
+	c, err := NewClient("https://example.com", "token")
+	require.NoError(t, err)
+
+	assert.Equal(t, CacheStats{}, c.CacheStats())
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	s := NewMemoryCacheStore()
+	ctx := context.Background()
+
+	s.Set(ctx, "key", []byte("value"), time.Minute)
+	got, ok := s.Get(ctx, "key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), got)
+
+	_, ok = s.Get(ctx, "missing")
+	assert.False(t, ok)
+}
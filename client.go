@@ -1,6 +1,8 @@
 package backlog
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 
 	"github.com/nattokin/go-backlog/internal/client"
@@ -29,10 +31,14 @@ type Client struct {
 
 	// Issue provides access to issue-related API endpoints.
 	Issue *IssueService
+	// Notification provides access to the authenticated user's notifications.
+	Notification *NotificationService
 	// Project provides access to project-related API endpoints.
 	Project *ProjectService
 	// PullRequest provides access to pull request-related API endpoints.
 	PullRequest *PullRequestService
+	// Raw sends requests to endpoints not covered by the other services.
+	Raw *RawService
 	// RecentlyViewed provides access to recently viewed resource endpoints.
 	RecentlyViewed *RecentlyViewedService
 	// Repository provides access to Git repository endpoints.
@@ -41,8 +47,12 @@ type Client struct {
 	Space *SpaceService
 	// Star provides access to star-related API endpoints.
 	Star *StarService
+	// Team provides access to team-related API endpoints.
+	Team *TeamService
 	// User provides access to user-related API endpoints.
 	User *UserService
+	// Watching provides access to watching list endpoints.
+	Watching *WatchingService
 	// Wiki provides access to wiki-related API endpoints.
 	Wiki *WikiService
 }
@@ -52,12 +62,23 @@ type Client struct {
 // ──────────────────────────────────────────────────────────────
 
 // NewClient creates and initializes a Backlog API Client.
-// It requires a baseURL (e.g. "https://example.backlog.com") and an API token.
+// It requires a baseURL (e.g. "https://example.backlog.com") and a credential.
+// By default the credential is sent as an OAuth 2.0 access token in the
+// Authorization header; use [WithAPIKey] to send it as a Backlog API key instead.
 //
 // It returns an [*InternalClientError] if the base URL or token is invalid.
 //
 // Supported options:
+//   - [WithAPIKey]
+//   - [WithCache]
 //   - [WithDoer]
+//   - [WithLogBodyLevel]
+//   - [WithLogger]
+//   - [WithMiddleware]
+//   - [WithRateLimitWait]
+//   - [WithRetry]
+//   - [WithTokenSource]
+//   - [WithTracer]
 func NewClient(baseURL, token string, opts ...*ClientOption) (*Client, error) {
 	innerOpts := make([]*client.ClientOption, len(opts))
 	for i, o := range opts {
@@ -77,6 +98,39 @@ func NewClient(baseURL, token string, opts ...*ClientOption) (*Client, error) {
 	return c, nil
 }
 
+// ──────────────────────────────────────────────────────────────
+//  Rate limit
+// ──────────────────────────────────────────────────────────────
+
+// RateLimit represents the API rate limit state reported by Backlog in the
+// X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset response headers.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is the time at which the current window ends.
+	Reset Timestamp
+}
+
+// RateLimit returns the most recent rate limit reported by the Backlog API,
+// or nil if no response carrying rate limit headers has been received yet.
+// It is safe to call from multiple goroutines.
+func (c *Client) RateLimit() *RateLimit {
+	return rateLimitFromInner(c.httpClient.RateLimiter.Snapshot())
+}
+
+func rateLimitFromInner(rl *client.RateLimit) *RateLimit {
+	if rl == nil {
+		return nil
+	}
+	return &RateLimit{
+		Limit:     rl.Limit,
+		Remaining: rl.Remaining,
+		Reset:     Timestamp{rl.Reset},
+	}
+}
+
 // ──────────────────────────────────────────────────────────────
 //  Service initialization
 // ──────────────────────────────────────────────────────────────
@@ -86,10 +140,14 @@ func initServices(c *Client) {
 
 	c.Issue = newIssueService(c.httpClient.Method, baseOptionService)
 
+	c.Notification = newNotificationService(c.httpClient.Method, baseOptionService)
+
 	c.Project = newProjectService(c.httpClient.Method, baseOptionService)
 
 	c.PullRequest = newPullRequestService(c.httpClient.Method, baseOptionService)
 
+	c.Raw = newRawService(c.httpClient.Method)
+
 	c.RecentlyViewed = newRecentlyViewedService(c.httpClient.Method, baseOptionService)
 
 	c.Repository = newRepositoryService(c.httpClient.Method)
@@ -98,8 +156,12 @@ func initServices(c *Client) {
 
 	c.Star = newStarService(c.httpClient.Method, baseOptionService)
 
+	c.Team = newTeamService(c.httpClient.Method, baseOptionService)
+
 	c.User = newUserService(c.httpClient.Method, baseOptionService)
 
+	c.Watching = newWatchingService(c.httpClient.Method, baseOptionService)
+
 	c.Wiki = newWikiService(c.httpClient.Method, baseOptionService)
 }
 
@@ -113,6 +175,41 @@ type ClientOption struct {
 	inner *client.ClientOption
 }
 
+// WithAPIKey returns a ClientOption that sends the credential passed to
+// [NewClient] as a Backlog API key (the apiKey query parameter) instead of an
+// OAuth 2.0 Bearer token. It applies to every request, including uploads and
+// downloads. The key is redacted from the URLs embedded in returned errors.
+func WithAPIKey() *ClientOption {
+	return &ClientOption{inner: client.WithAPIKey()}
+}
+
+// TokenSource supplies OAuth 2.0 access tokens to the Client.
+// The oauth2 sub-package provides an implementation that refreshes tokens
+// through the Backlog token endpoint.
+//
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns a valid access token. It is called for every request and
+	// should refresh the token first if it has expired.
+	Token(ctx context.Context) (string, error)
+
+	// Refresh returns a new access token after the API responded with
+	// 401 Unauthorized to a request sent with rejected. If the token has
+	// already been refreshed by another caller, the current token may be
+	// returned without contacting the token endpoint.
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// WithTokenSource returns a ClientOption that obtains the access token for
+// every request from ts instead of using the static token passed to
+// [NewClient], which may then be empty.
+//
+// When a request is rejected with 401 Unauthorized, the Client calls
+// ts.Refresh and sends the request once more with the new token.
+func WithTokenSource(ts TokenSource) *ClientOption {
+	return &ClientOption{inner: client.WithTokenSource(ts)}
+}
+
 // WithDoer returns a ClientOption that sets the HTTP client (Doer) for the Client.
 // This is useful for providing a custom *http.Client or a mock implementation during testing.
 //
@@ -120,3 +217,48 @@ type ClientOption struct {
 func WithDoer(doer Doer) *ClientOption {
 	return &ClientOption{inner: client.WithDoer(doer)}
 }
+
+// WithRateLimitWait returns a ClientOption that makes the Client wait for the
+// rate limit window to reset when the remaining request budget is exhausted,
+// instead of sending requests that would be rejected with 429 Too Many Requests.
+//
+// The budget is tracked from the X-RateLimit-* response headers and is shared
+// by all goroutines using the Client. Waiting is aborted when the request
+// context is done, in which case the context error is returned.
+func WithRateLimitWait() *ClientOption {
+	return &ClientOption{inner: client.WithRateLimitWait()}
+}
+
+// WithUploadProgress returns a ClientOption that reports the progress of file
+// uploads made by the Client, such as [SpaceAttachmentService.Upload], to fn.
+//
+// fn is called as file data is sent, with the name of the uploaded file, the
+// number of bytes sent so far and the file size, or -1 if the size is unknown.
+// If an upload is retried, the count starts again from zero. fn may be called
+// from multiple goroutines when files are uploaded concurrently.
+func WithUploadProgress(fn func(fileName string, sent, total int64)) *ClientOption {
+	return &ClientOption{inner: client.WithUploadProgress(fn)}
+}
+
+// WithLogger returns a ClientOption that logs every HTTP request sent to the
+// Backlog API to logger.
+//
+// Each request is logged with its operation name, method, path, query and form
+// parameter names, status, latency and the Backlog error codes of error
+// responses. Successful requests are logged at [slog.LevelInfo]; failed requests
+// and error responses at [slog.LevelWarn]. Retried requests are logged once
+// per attempt.
+//
+// Headers and bodies are logged at the level set by [WithLogBodyLevel]
+// (default [slog.LevelDebug]). The Authorization header and the values of the
+// apiKey and password parameters are always redacted.
+func WithLogger(logger *slog.Logger) *ClientOption {
+	return &ClientOption{inner: client.WithLogger(logger)}
+}
+
+// WithLogBodyLevel returns a ClientOption that sets the level at which the
+// logger configured by [WithLogger] records request headers, form bodies and
+// JSON response bodies. The default is [slog.LevelDebug].
+func WithLogBodyLevel(level slog.Level) *ClientOption {
+	return &ClientOption{inner: client.WithLogBodyLevel(level)}
+}
@@ -1,9 +1,14 @@
 package backlog
 
 import (
+	"context"
+	"io"
+	"log/slog"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,3 +60,153 @@ func TestNewClient(t *testing.T) {
 		assert.Nil(t, c)
 	})
 }
+
+func TestClient_RateLimit(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "150")
+	header.Set("X-RateLimit-Remaining", "149")
+	header.Set("X-RateLimit-Reset", "1700000000")
+
+	mockDoer := &mock.Doer{T: t,
+		DoFunc: func(_ *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		},
+	}
+	c, err := NewClient("https://example.com", "token", WithDoer(mockDoer))
+	require.NoError(t, err)
+	assert.Nil(t, c.RateLimit())
+
+	_, err = c.Space.Info(context.Background())
+	require.NoError(t, err)
+
+	got := c.RateLimit()
+	require.NotNil(t, got)
+	assert.Equal(t, 150, got.Limit)
+	assert.Equal(t, 149, got.Remaining)
+	assert.True(t, time.Unix(1700000000, 0).Equal(got.Reset.Time))
+}
+
+func TestWithRateLimitWait(t *testing.T) {
+	c, err := NewClient("https://example.com", "token", WithRateLimitWait())
+	require.NoError(t, err)
+	assert.True(t, c.httpClient.RateLimiter.Wait)
+
+	c, err = NewClient("https://example.com", "token")
+	require.NoError(t, err)
+	assert.False(t, c.httpClient.RateLimiter.Wait)
+}
+
+func TestWithRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:     3,
+		BaseDelay:       time.Millisecond,
+		MaxDelay:        time.Second,
+		RetryAllMethods: true,
+	}
+	c, err := NewClient("https://example.com", "token", WithRetry(policy))
+	require.NoError(t, err)
+
+	require.NotNil(t, c.httpClient.Retry)
+	assert.Equal(t, 3, c.httpClient.Retry.MaxAttempts)
+	assert.Equal(t, time.Millisecond, c.httpClient.Retry.BaseDelay)
+	assert.Equal(t, time.Second, c.httpClient.Retry.MaxDelay)
+	assert.True(t, c.httpClient.Retry.RetryAllMethods)
+
+	c, err = NewClient("https://example.com", "token")
+	require.NoError(t, err)
+	assert.Nil(t, c.httpClient.Retry)
+}
+
+func TestWithRetry_serviceCall(t *testing.T) {
+	calls := 0
+	mockDoer := &mock.Doer{T: t,
+		DoFunc: func(_ *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return mock.NewErrorResponse(http.StatusServiceUnavailable, `{"errors":[]}`), nil
+			}
+			return mock.NewResponse(`{"spaceKey":"nulab"}`), nil
+		},
+	}
+	c, err := NewClient("https://example.com", "token",
+		WithDoer(mockDoer),
+		WithRetry(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+	)
+	require.NoError(t, err)
+
+	got, err := c.Space.Info(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "nulab", got.SpaceKey)
+	assert.Equal(t, 2, calls)
+}
+
+func TestWithAPIKey(t *testing.T) {
+	var got *http.Request
+	mockDoer := &mock.Doer{T: t,
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			got = req
+			return mock.NewResponse(`{}`), nil
+		},
+	}
+	c, err := NewClient("https://example.com", "api-key", WithAPIKey(), WithDoer(mockDoer))
+	require.NoError(t, err)
+
+	_, err = c.Space.Info(context.Background())
+	require.NoError(t, err)
+
+	require.NotNil(t, got)
+	assert.Equal(t, "api-key", got.URL.Query().Get("apiKey"))
+	assert.Empty(t, got.Header.Get("Authorization"))
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) { return string(s), nil }
+func (s staticTokenSource) Refresh(context.Context, string) (string, error) {
+	return string(s), nil
+}
+
+func TestWithTokenSource(t *testing.T) {
+	var got *http.Request
+	mockDoer := &mock.Doer{T: t,
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			got = req
+			return mock.NewResponse(`{}`), nil
+		},
+	}
+	// The static token may be empty when a TokenSource is provided.
+	c, err := NewClient("https://example.com", "", WithTokenSource(staticTokenSource("access")), WithDoer(mockDoer))
+	require.NoError(t, err)
+
+	_, err = c.Space.Info(context.Background())
+	require.NoError(t, err)
+
+	require.NotNil(t, got)
+	assert.Equal(t, "Bearer access", got.Header.Get("Authorization"))
+}
+
+func TestWithLogger(t *testing.T) {
+	buf := &strings.Builder{}
+	logger := slog.New(slog.NewTextHandler(buf, nil))
+	mockDoer := &mock.Doer{T: t,
+		DoFunc: func(_ *http.Request) (*http.Response, error) {
+			return mock.NewResponse(`{}`), nil
+		},
+	}
+	c, err := NewClient("https://example.com", "token", WithDoer(mockDoer), WithLogger(logger), WithLogBodyLevel(slog.LevelInfo))
+	require.NoError(t, err)
+
+	require.NotNil(t, c.httpClient.Logger)
+	assert.Equal(t, slog.LevelInfo, c.httpClient.Logger.BodyLevel)
+
+	_, err = c.User.Add(context.Background(), "user", "p@ssw0rd", "name", "user@example.com", RoleNormalUser)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "operation=User.Add")
+	assert.Contains(t, buf.String(), "password=REDACTED")
+	assert.NotContains(t, buf.String(), "p@ssw0rd")
+}
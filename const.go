@@ -63,6 +63,64 @@ const (
 	IssueSortChildIssue     IssueSort = "childIssue"
 )
 
+// NotificationReason identifies why a notification was delivered to a user.
+// It names the values of [Notification.Reason].
+type NotificationReason int
+
+// Available notification reasons.
+const (
+	NotificationReasonIssueAssigned        NotificationReason = 1
+	NotificationReasonIssueCommented       NotificationReason = 2
+	NotificationReasonIssueCreated         NotificationReason = 3
+	NotificationReasonIssueUpdated         NotificationReason = 4
+	NotificationReasonFileAdded            NotificationReason = 5
+	NotificationReasonProjectUserAdded     NotificationReason = 6
+	NotificationReasonOther                NotificationReason = 9
+	NotificationReasonPullRequestAssigned  NotificationReason = 10
+	NotificationReasonPullRequestCommented NotificationReason = 11
+	NotificationReasonPullRequestAdded     NotificationReason = 12
+	NotificationReasonPullRequestUpdated   NotificationReason = 13
+)
+
+func (r NotificationReason) String() string {
+	switch r {
+	case NotificationReasonIssueAssigned:
+		return "IssueAssigned"
+	case NotificationReasonIssueCommented:
+		return "IssueCommented"
+	case NotificationReasonIssueCreated:
+		return "IssueCreated"
+	case NotificationReasonIssueUpdated:
+		return "IssueUpdated"
+	case NotificationReasonFileAdded:
+		return "FileAdded"
+	case NotificationReasonProjectUserAdded:
+		return "ProjectUserAdded"
+	case NotificationReasonOther:
+		return "Other"
+	case NotificationReasonPullRequestAssigned:
+		return "PullRequestAssigned"
+	case NotificationReasonPullRequestCommented:
+		return "PullRequestCommented"
+	case NotificationReasonPullRequestAdded:
+		return "PullRequestAdded"
+	case NotificationReasonPullRequestUpdated:
+		return "PullRequestUpdated"
+	default:
+		return fmt.Sprintf("unknown NotificationReason type %d", r)
+	}
+}
+
+// WatchingSort defines the field to sort watching list results by.
+type WatchingSort string
+
+// Available sort fields for watching list operations.
+const (
+	WatchingSortCreated      WatchingSort = "created"
+	WatchingSortUpdated      WatchingSort = "updated"
+	WatchingSortIssueUpdated WatchingSort = "issueUpdated"
+)
+
 // Order defines the sort order (ascending or descending).
 type Order string
 
@@ -123,6 +181,24 @@ const (
 	IssueStatusClosed     = 4
 )
 
+// Issue priority ID constants for use with [IssueOptionService.WithPriorityID]
+// and [IssueOptionService.WithPriorityIDs].
+const (
+	PriorityHigh   = 2
+	PriorityNormal = 3
+	PriorityLow    = 4
+)
+
+// Issue resolution ID constants for use with [IssueOptionService.WithResolutionID]
+// and [IssueOptionService.WithResolutionIDs].
+const (
+	ResolutionFixed           = 0
+	ResolutionWontFix         = 1
+	ResolutionInvalid         = 2
+	ResolutionDuplication     = 3
+	ResolutionCannotReproduce = 4
+)
+
 // Pull request status ID constants for use with [PullRequestOptionService.WithStatusIDs].
 const (
 	PullRequestStatusOpen   = 1
@@ -130,3 +206,21 @@ const (
 	PullRequestStatusMerged = 3
 	PullRequestStatusDraft  = 4
 )
+
+// Backlog API error code constants, as reported in [Error.Code].
+// The name of each error in the Backlog API documentation is given in its comment.
+const (
+	ErrorCodeInternal              = 1  // InternalError
+	ErrorCodeLicence               = 2  // LicenceError
+	ErrorCodeLicenceExpired        = 3  // LicenceExpiredError
+	ErrorCodeAccessDenied          = 4  // AccessDeniedError
+	ErrorCodeUnauthorizedOperation = 5  // UnauthorizedOperationError
+	ErrorCodeNoResource            = 6  // NoResourceError
+	ErrorCodeInvalidRequest        = 7  // InvalidRequestError
+	ErrorCodeSpaceOverCapacity     = 8  // SpaceOverCapacityError
+	ErrorCodeResourceOverflow      = 9  // ResourceOverflowError
+	ErrorCodeTooLargeFile          = 10 // TooLargeFileError
+	ErrorCodeAuthentication        = 11 // AuthenticationError
+	ErrorCodeRequiredMFA           = 12 // RequiredMFAError
+	ErrorCodeTooManyRequests       = 13 // TooManyRequestsError
+)
@@ -104,3 +104,65 @@ func TestRole_String(t *testing.T) {
 
 	}
 }
+
+func TestNotificationReason_String(t *testing.T) {
+	cases := map[string]struct {
+		reason backlog.NotificationReason
+		want   string
+	}{
+		"IssueAssigned": {
+			reason: backlog.NotificationReasonIssueAssigned,
+			want:   "IssueAssigned",
+		},
+		"IssueCommented": {
+			reason: backlog.NotificationReasonIssueCommented,
+			want:   "IssueCommented",
+		},
+		"IssueCreated": {
+			reason: backlog.NotificationReasonIssueCreated,
+			want:   "IssueCreated",
+		},
+		"IssueUpdated": {
+			reason: backlog.NotificationReasonIssueUpdated,
+			want:   "IssueUpdated",
+		},
+		"FileAdded": {
+			reason: backlog.NotificationReasonFileAdded,
+			want:   "FileAdded",
+		},
+		"ProjectUserAdded": {
+			reason: backlog.NotificationReasonProjectUserAdded,
+			want:   "ProjectUserAdded",
+		},
+		"Other": {
+			reason: backlog.NotificationReasonOther,
+			want:   "Other",
+		},
+		"PullRequestAssigned": {
+			reason: backlog.NotificationReasonPullRequestAssigned,
+			want:   "PullRequestAssigned",
+		},
+		"PullRequestCommented": {
+			reason: backlog.NotificationReasonPullRequestCommented,
+			want:   "PullRequestCommented",
+		},
+		"PullRequestAdded": {
+			reason: backlog.NotificationReasonPullRequestAdded,
+			want:   "PullRequestAdded",
+		},
+		"PullRequestUpdated": {
+			reason: backlog.NotificationReasonPullRequestUpdated,
+			want:   "PullRequestUpdated",
+		},
+		"Unknown": {
+			reason: backlog.NotificationReason(7),
+			want:   "unknown NotificationReason type 7",
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.reason.String())
+		})
+	}
+}
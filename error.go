@@ -3,6 +3,7 @@ package backlog
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/option"
@@ -19,7 +20,9 @@ type Error struct {
 }
 
 // APIResponseError represents an error response from the Backlog API.
-// Use [errors.As] to check whether a returned error is an *APIResponseError.
+// Use [errors.As] to check whether a returned error is an *APIResponseError,
+// and [IsNotFound] and the other predicates, or [errors.Is] with the sentinel
+// errors such as [ErrNotFound], to classify it.
 type APIResponseError struct {
 	inner *client.APIResponseError
 }
@@ -30,6 +33,10 @@ func (e *APIResponseError) Error() string { return e.inner.Error() }
 // StatusCode returns the HTTP status code of the error response.
 func (e *APIResponseError) StatusCode() int { return e.inner.StatusCode }
 
+// RateLimit returns the rate limit reported with the error response,
+// or nil if the response did not carry rate limit headers.
+func (e *APIResponseError) RateLimit() *RateLimit { return rateLimitFromInner(e.inner.RateLimit) }
+
 // Errors returns the individual error entries in the response.
 func (e *APIResponseError) Errors() []*Error {
 	out := make([]*Error, len(e.inner.Errors))
@@ -43,6 +50,72 @@ func (e *APIResponseError) Errors() []*Error {
 	return out
 }
 
+// HasCode reports whether any of the error entries in the response has the
+// given Backlog error code, such as [ErrorCodeNoResource].
+func (e *APIResponseError) HasCode(code int) bool {
+	for _, ce := range e.inner.Errors {
+		if ce.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Is reports whether the error response matches target, one of the sentinel
+// errors [ErrNotFound], [ErrRateLimited], [ErrAuthentication] and
+// [ErrPermissionDenied], so that it can be classified with [errors.Is].
+func (e *APIResponseError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.inner.StatusCode == http.StatusNotFound || e.HasCode(ErrorCodeNoResource)
+	case ErrRateLimited:
+		return e.inner.StatusCode == http.StatusTooManyRequests || e.HasCode(ErrorCodeTooManyRequests)
+	case ErrAuthentication:
+		return e.inner.StatusCode == http.StatusUnauthorized || e.HasCode(ErrorCodeAuthentication)
+	case ErrPermissionDenied:
+		return e.inner.StatusCode == http.StatusForbidden ||
+			e.HasCode(ErrorCodeAccessDenied) || e.HasCode(ErrorCodeUnauthorizedOperation)
+	default:
+		return false
+	}
+}
+
+// Sentinel errors that an *APIResponseError matches with [errors.Is].
+var (
+	// ErrNotFound matches responses with status 404 or error code
+	// [ErrorCodeNoResource].
+	ErrNotFound = errors.New("backlog: resource not found")
+
+	// ErrRateLimited matches responses with status 429 or error code
+	// [ErrorCodeTooManyRequests].
+	ErrRateLimited = errors.New("backlog: rate limited")
+
+	// ErrAuthentication matches responses with status 401 or error code
+	// [ErrorCodeAuthentication].
+	ErrAuthentication = errors.New("backlog: authentication failed")
+
+	// ErrPermissionDenied matches responses with status 403 or error code
+	// [ErrorCodeAccessDenied] or [ErrorCodeUnauthorizedOperation].
+	ErrPermissionDenied = errors.New("backlog: permission denied")
+)
+
+// IsNotFound reports whether err is an API error for a missing resource.
+// It is shorthand for errors.Is(err, ErrNotFound).
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsRateLimited reports whether err is an API error for exceeding the rate
+// limit. It is shorthand for errors.Is(err, ErrRateLimited).
+func IsRateLimited(err error) bool { return errors.Is(err, ErrRateLimited) }
+
+// IsAuthError reports whether err is an API error for a missing or invalid
+// credential. It is shorthand for errors.Is(err, ErrAuthentication).
+func IsAuthError(err error) bool { return errors.Is(err, ErrAuthentication) }
+
+// IsPermissionDenied reports whether err is an API error for an operation the
+// user is not allowed to perform. It is shorthand for
+// errors.Is(err, ErrPermissionDenied).
+func IsPermissionDenied(err error) bool { return errors.Is(err, ErrPermissionDenied) }
+
 // InvalidOptionKeyError is returned when an option method is called with a key
 // that is not valid for the target service method.
 // Use [errors.As] to check whether a returned error is an *InvalidOptionKeyError.
@@ -145,3 +218,17 @@ type InvalidDateStringError struct {
 func (e *InvalidDateStringError) Error() string {
 	return fmt.Sprintf("backlog: invalid date string %q: expected \"YYYY-MM-DD\" format", e.value)
 }
+
+// SizeMismatchError is returned by [FileData.WriteTo] and [FileData.SaveTo]
+// when the number of bytes read from the body differs from FileData.Size,
+// such as when a download is cut short.
+type SizeMismatchError struct {
+	// Want is the expected size in bytes.
+	Want int64
+	// Got is the number of bytes actually read.
+	Got int64
+}
+
+func (e *SizeMismatchError) Error() string {
+	return fmt.Sprintf("backlog: downloaded %d bytes, expected %d", e.Got, e.Want)
+}
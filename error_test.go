@@ -3,6 +3,7 @@ package backlog_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -49,6 +50,176 @@ func TestAPIResponseError_Errors(t *testing.T) {
 	assert.Equal(t, 6, errs[0].Code)
 }
 
+func TestAPIResponseError_RateLimit(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "150")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "1700000000")
+
+	c, err := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(&mock.Doer{DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"message":"Too many requests.","code":15,"moreInfo":""}]}`)),
+			}, nil
+		}}),
+	)
+	require.NoError(t, err)
+	_, err = c.Wiki.List(context.Background(), "PROJECT")
+	require.Error(t, err)
+
+	var target *backlog.APIResponseError
+	require.True(t, errors.As(err, &target))
+	rl := target.RateLimit()
+	require.NotNil(t, rl)
+	assert.Equal(t, 150, rl.Limit)
+	assert.Equal(t, 0, rl.Remaining)
+	assert.Equal(t, int64(1700000000), rl.Reset.Unix())
+}
+
+func TestAPIResponseError_RateLimit_missing(t *testing.T) {
+	err := callWikiAllWithStatus(t, 404)
+	require.Error(t, err)
+
+	var target *backlog.APIResponseError
+	require.True(t, errors.As(err, &target))
+	assert.Nil(t, target.RateLimit())
+}
+
+func TestAPIResponseError_HasCode(t *testing.T) {
+	err := callWikiListWithError(t, http.StatusBadRequest, backlog.ErrorCodeInvalidRequest)
+
+	var target *backlog.APIResponseError
+	require.True(t, errors.As(err, &target))
+	assert.True(t, target.HasCode(backlog.ErrorCodeInvalidRequest))
+	assert.False(t, target.HasCode(backlog.ErrorCodeNoResource))
+}
+
+func TestAPIResponseError_Is(t *testing.T) {
+	sentinels := []error{
+		backlog.ErrNotFound,
+		backlog.ErrRateLimited,
+		backlog.ErrAuthentication,
+		backlog.ErrPermissionDenied,
+	}
+
+	cases := map[string]struct {
+		statusCode int
+		code       int
+		want       error
+	}{
+		"not-found-status": {
+			statusCode: http.StatusNotFound,
+			code:       backlog.ErrorCodeInternal,
+			want:       backlog.ErrNotFound,
+		},
+		"not-found-code": {
+			statusCode: http.StatusBadRequest,
+			code:       backlog.ErrorCodeNoResource,
+			want:       backlog.ErrNotFound,
+		},
+		"rate-limited-status": {
+			statusCode: http.StatusTooManyRequests,
+			code:       backlog.ErrorCodeInternal,
+			want:       backlog.ErrRateLimited,
+		},
+		"rate-limited-code": {
+			statusCode: http.StatusBadRequest,
+			code:       backlog.ErrorCodeTooManyRequests,
+			want:       backlog.ErrRateLimited,
+		},
+		"authentication-status": {
+			statusCode: http.StatusUnauthorized,
+			code:       backlog.ErrorCodeInternal,
+			want:       backlog.ErrAuthentication,
+		},
+		"authentication-code": {
+			statusCode: http.StatusBadRequest,
+			code:       backlog.ErrorCodeAuthentication,
+			want:       backlog.ErrAuthentication,
+		},
+		"permission-status": {
+			statusCode: http.StatusForbidden,
+			code:       backlog.ErrorCodeInternal,
+			want:       backlog.ErrPermissionDenied,
+		},
+		"permission-access-denied": {
+			statusCode: http.StatusBadRequest,
+			code:       backlog.ErrorCodeAccessDenied,
+			want:       backlog.ErrPermissionDenied,
+		},
+		"permission-unauthorized-operation": {
+			statusCode: http.StatusBadRequest,
+			code:       backlog.ErrorCodeUnauthorizedOperation,
+			want:       backlog.ErrPermissionDenied,
+		},
+		"none": {
+			statusCode: http.StatusInternalServerError,
+			code:       backlog.ErrorCodeInternal,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := callWikiListWithError(t, tc.statusCode, tc.code)
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tc.want, errors.Is(err, sentinel), sentinel)
+			}
+		})
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	cases := map[string]struct {
+		err              error
+		notFound         bool
+		rateLimited      bool
+		authError        bool
+		permissionDenied bool
+	}{
+		"not-found": {
+			err:      callWikiListWithError(t, http.StatusNotFound, backlog.ErrorCodeNoResource),
+			notFound: true,
+		},
+		"rate-limited": {
+			err:         callWikiListWithError(t, http.StatusTooManyRequests, backlog.ErrorCodeTooManyRequests),
+			rateLimited: true,
+		},
+		"auth-error": {
+			err:       callWikiListWithError(t, http.StatusUnauthorized, backlog.ErrorCodeAuthentication),
+			authError: true,
+		},
+		"permission-denied": {
+			err:              callWikiListWithError(t, http.StatusForbidden, backlog.ErrorCodeUnauthorizedOperation),
+			permissionDenied: true,
+		},
+		"wrapped": {
+			err:      fmt.Errorf("load wiki: %w", callWikiListWithError(t, http.StatusNotFound, backlog.ErrorCodeNoResource)),
+			notFound: true,
+		},
+		"sentinel": {
+			err:         backlog.ErrRateLimited,
+			rateLimited: true,
+		},
+		"other-error": {
+			err: errors.New("boom"),
+		},
+		"nil": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.notFound, backlog.IsNotFound(tc.err))
+			assert.Equal(t, tc.rateLimited, backlog.IsRateLimited(tc.err))
+			assert.Equal(t, tc.authError, backlog.IsAuthError(tc.err))
+			assert.Equal(t, tc.permissionDenied, backlog.IsPermissionDenied(tc.err))
+		})
+	}
+}
+
 // ──────────────────────────────────────────────────────────────
 //  InvalidOptionKeyError
 // ──────────────────────────────────────────────────────────────
@@ -204,6 +375,26 @@ func callWikiAllWithStatus(t *testing.T, statusCode int) error {
 	return err
 }
 
+// callWikiListWithError returns the error of a Wiki.List call answered with
+// statusCode and a single error entry with the given Backlog error code.
+func callWikiListWithError(t *testing.T, statusCode, code int) error {
+	t.Helper()
+	body := fmt.Sprintf(`{"errors":[{"message":"error","code":%d,"moreInfo":""}]}`, code)
+	c, err := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(&mock.Doer{DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: statusCode,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}}),
+	)
+	require.NoError(t, err)
+	_, err = c.Wiki.List(context.Background(), "PROJECT")
+	return err
+}
+
 // callWikiAllWithInvalidOption drives convertError via an invalid option key.
 // WithContent is not valid for Wiki.All, triggering InvalidOptionKeyError.
 func callWikiAllWithInvalidOption(t *testing.T) error {
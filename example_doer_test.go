@@ -1,6 +1,9 @@
 package backlog_test
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/nattokin/go-backlog/internal/testutil/fixture"
 	"github.com/nattokin/go-backlog/internal/testutil/mock"
 )
@@ -31,6 +34,7 @@ var (
 	doerIssueList                 = newMockDoer(fixture.Issue.ListJSON)
 	doerIssueSingle               = newMockDoer(fixture.Issue.SingleJSON)
 	doerIssueCount                = newMockDoer(`{"count":2}`)
+	doerIssueCustomFields         = newMockDoer(fixture.Issue.CustomFieldsJSON)
 	doerIssueCommentCount         = newMockDoer(`{"count":2}`)
 	doerIssueCommentNotifications = newMockDoer(`[{"id":25,"alreadyRead":false,"reason":2,"resourceAlreadyRead":false}]`)
 
@@ -38,6 +42,12 @@ var (
 	doerIssueTypeList   = newMockDoer(fixture.IssueType.ListJSON)
 	doerIssueTypeSingle = newMockDoer(fixture.IssueType.SingleJSON)
 
+	// Notification
+	doerNotificationList = newMockDoer(fixture.Notification.ListJSON)
+
+	// Priority
+	doerPriorityList = newMockDoer(fixture.Priority.ListJSON)
+
 	// Project
 	doerProjectList      = newMockDoer(fixture.Project.ListJSON)
 	doerProjectSingle    = newMockDoer(fixture.Project.SingleJSON)
@@ -57,6 +67,9 @@ var (
 	doerRecentlyViewedWikiList    = newMockDoer(fixture.RecentlyViewed.WikiListJSON)
 	doerRecentlyViewedWikiSingle  = newMockDoer(fixture.RecentlyViewed.WikiSingleJSON)
 
+	// Resolution
+	doerResolutionList = newMockDoer(fixture.Resolution.ListJSON)
+
 	// Repository
 	doerRepositoryList   = newMockDoer(fixture.Repository.ListJSON)
 	doerRepositorySingle = newMockDoer(fixture.Repository.SingleJSON)
@@ -69,6 +82,9 @@ var (
 	doerSpaceSpace        = newMockDoer(fixture.Space.SpaceJSON)
 	doerSpaceDiskUsage    = newMockDoer(fixture.Space.DiskUsageJSON)
 	doerSpaceNotification = newMockDoer(fixture.Space.NotificationJSON)
+	doerSpaceLicence      = newMockDoer(fixture.Space.LicenceJSON)
+	doerSpaceLogo         = newMockBinaryDoer("image/png", "logo.png", []byte("PNG"))
+	doerSpaceRateLimit    = newMockDoer(fixture.Space.RateLimitJSON)
 
 	// Star
 	doerStarList  = newMockDoer(fixture.Star.ListJSON)
@@ -78,6 +94,10 @@ var (
 	doerStatusList   = newMockDoer(fixture.Status.ListJSON)
 	doerStatusSingle = newMockDoer(fixture.Status.SingleJSON)
 
+	// Team
+	doerTeamList   = newMockDoer(fixture.Team.ListJSON)
+	doerTeamSingle = newMockDoer(fixture.Team.SingleJSON)
+
 	// User
 	doerUserList   = newMockDoer(fixture.User.ListJSON)
 	doerUserSingle = newMockDoer(fixture.User.SingleJSON)
@@ -87,6 +107,10 @@ var (
 	doerVersionList   = newMockDoer(fixture.Version.ListJSON)
 	doerVersionSingle = newMockDoer(fixture.Version.SingleJSON)
 
+	// Watching
+	doerWatchingList   = newMockDoer(fixture.Watching.ListJSON)
+	doerWatchingSingle = newMockDoer(fixture.Watching.SingleJSON)
+
 	// Webhook
 	doerWebhookList     = newMockDoer(fixture.Webhook.ListJSON)
 	doerWebhookAllEvent = newMockDoer(fixture.Webhook.AllEventJSON)
@@ -123,3 +147,21 @@ func newMockBinaryDoer(contentType, filename string, body []byte) *mock.Doer {
 var doerNoContent = &mock.Doer{
 	DoFunc: mock.NewNoContentDoFunc(),
 }
+
+// doerIssueListWithCount is a *mock.Doer that answers the issue count endpoint
+// with a count of 2 and any other request with the issue list.
+// Used as a lightweight Doer for Example tests, which run without *testing.T.
+var doerIssueListWithCount = &mock.Doer{
+	DoFunc: func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/issues/count") {
+			return mock.NewResponse(`{"count":2}`), nil
+		}
+		return mock.NewResponse(fixture.Issue.ListJSON), nil
+	},
+}
+
+// doerNotFound is a *mock.Doer that always responds with HTTP 404 Not Found.
+// Used as a lightweight Doer for Example tests, which run without *testing.T.
+var doerNotFound = &mock.Doer{
+	DoFunc: mock.NewNotFoundDoFunc(),
+}
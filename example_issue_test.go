@@ -44,6 +44,54 @@ func ExampleIssueService_All() {
 	// Count: 2, IDs: [1 2]
 }
 
+func ExampleIssueService_AllPrefetch() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerIssueListWithCount),
+	)
+
+	// Fetch up to 4 pages of 100 issues in parallel.
+	seq, err := c.Issue.AllPrefetch(context.Background(), 100, 4)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	var ids []int
+	for issue, err := range seq {
+		if err != nil {
+			break
+		}
+		ids = append(ids, issue.ID)
+	}
+	fmt.Printf("Count: %d, IDs: %v\n", len(ids), ids)
+	// Output:
+	// Count: 2, IDs: [1 2]
+}
+
+func ExampleIssueService_BulkUpdate() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerIssueSingle),
+	)
+
+	report, err := c.Issue.BulkUpdate(context.Background(), 4,
+		[]string{"PRJ-1", "PRJ-2", "PRJ-3"},
+		c.Issue.Option.WithAssigneeID(1),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for _, res := range report.Failed() {
+		fmt.Println(res.Update.IssueIDOrKey, res.Err)
+	}
+	fmt.Printf("Succeeded: %d, Remaining: %d\n", len(report.Succeeded()), len(report.Remaining()))
+	// Output:
+	// Succeeded: 3, Remaining: 0
+}
+
 func ExampleIssueService_Count() {
 	c, _ := backlog.NewClient(
 		"https://example.backlog.com",
@@ -122,6 +170,28 @@ func ExampleIssueService_Participants() {
 	// Count: 4, ID: 1, Name: admin
 }
 
+func ExampleIssue_CustomFieldByName() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerIssueCustomFields),
+	)
+
+	issue, _ := c.Issue.One(context.Background(), "PRJ-1")
+	if points, ok := issue.CustomFieldByName("Points").AsNumber(); ok {
+		fmt.Printf("Points: %g\n", points)
+	}
+	if items, ok := issue.CustomFieldByName("Platforms").AsItems(); ok {
+		for _, item := range items {
+			fmt.Printf("Platform: %s\n", item.Name)
+		}
+	}
+	// Output:
+	// Points: 2.5
+	// Platform: iOS
+	// Platform: Android
+}
+
 func ExampleIssueAttachmentService_List() {
 	c, _ := backlog.NewClient(
 		"https://example.backlog.com",
@@ -174,6 +244,32 @@ func ExampleIssueCommentService_List() {
 	// Count: 2, ID: 1, Content: This is a comment.
 }
 
+func ExampleIssueCommentService_All() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerCommentList),
+	)
+
+	seq, err := c.Issue.Comment.All(context.Background(), 100, "PRJ-1",
+		c.Issue.Comment.Option.WithOrder(backlog.OrderAsc),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	var ids []int
+	for comment, err := range seq {
+		if err != nil {
+			break
+		}
+		ids = append(ids, comment.ID)
+	}
+	fmt.Printf("Count: %d, IDs: %v\n", len(ids), ids)
+	// Output:
+	// Count: 2, IDs: [1 2]
+}
+
 func ExampleIssueCommentService_Add() {
 	c, _ := backlog.NewClient(
 		"https://example.backlog.com",
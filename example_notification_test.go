@@ -0,0 +1,62 @@
+package backlog_test
+
+import (
+	"context"
+	"fmt"
+
+	backlog "github.com/nattokin/go-backlog"
+)
+
+func ExampleNotificationService_List() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerNotificationList),
+	)
+
+	notifications, err := c.Notification.List(context.Background(),
+		c.Notification.Option.WithCount(20),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for _, n := range notifications {
+		if n.AlreadyRead {
+			continue
+		}
+		reason := backlog.NotificationReason(n.Reason)
+		switch reason {
+		case backlog.NotificationReasonIssueAssigned, backlog.NotificationReasonIssueCommented:
+			fmt.Println(n.ID, reason, n.Issue.IssueKey)
+		default:
+			fmt.Println(n.ID, reason)
+		}
+	}
+	// Output:
+	// 22 IssueCommented TEST-1
+}
+
+func ExampleNotificationService_All() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerNotificationList),
+	)
+
+	seq, err := c.Notification.All(context.Background(), 100)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	var ids []int
+	for n, err := range seq {
+		if err != nil {
+			break
+		}
+		ids = append(ids, n.ID)
+	}
+	fmt.Printf("Count: %d, IDs: %v\n", len(ids), ids)
+	// Output:
+	// Count: 2, IDs: [22 21]
+}
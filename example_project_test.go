@@ -341,6 +341,19 @@ func ExampleProjectSharedFileService_Download() {
 	// ContentType: image/png, FileName: shared.png
 }
 
+func ExampleProjectTeamService_Add() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerTeamSingle),
+	)
+
+	team, _ := c.Project.Team.Add(context.Background(), "TEST", 1)
+	fmt.Printf("ID: %d, Name: %s\n", team.ID, team.Name)
+	// Output:
+	// ID: 1, Name: developers
+}
+
 func ExampleProjectUserService_List() {
 	c, _ := backlog.NewClient(
 		"https://example.backlog.com",
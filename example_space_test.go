@@ -60,6 +60,48 @@ func ExampleSpaceService_UpdateNotification() {
 	// Content: Backlog is a project management tool.
 }
 
+func ExampleSpaceService_Licence() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerSpaceLicence),
+	)
+
+	licence, _ := c.Space.Licence(context.Background())
+	fmt.Printf("Active: %t, StorageLimit: %d\n", licence.Active, licence.StorageLimit)
+	// Output:
+	// Active: true, StorageLimit: 1073741824
+}
+
+func ExampleSpaceService_Logo() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerSpaceLogo),
+	)
+
+	logo, _ := c.Space.Logo(context.Background())
+	defer logo.Body.Close()
+	fmt.Printf("Filename: %s, ContentType: %s\n", logo.Filename, logo.ContentType)
+	// Output:
+	// Filename: logo.png, ContentType: image/png
+}
+
+func ExampleSpaceService_RateLimit() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerSpaceRateLimit),
+	)
+
+	status, _ := c.Space.RateLimit(context.Background())
+	fmt.Printf("Read: %d/%d, Update: %d/%d\n",
+		status.Read.Remaining, status.Read.Limit,
+		status.Update.Remaining, status.Update.Limit)
+	// Output:
+	// Read: 598/600, Update: 150/150
+}
+
 func ExampleSpaceActivityService_List() {
 	c, _ := backlog.NewClient(
 		"https://example.backlog.com",
@@ -73,6 +115,28 @@ func ExampleSpaceActivityService_List() {
 	// ID: 3153, Type: 2
 }
 
+func ExampleSpaceActivityService_All() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerActivityList),
+	)
+
+	seq, err := c.Space.Activity.All(context.Background(), 100)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for activity, err := range seq {
+		if err != nil {
+			break
+		}
+		fmt.Printf("ID: %d, Type: %d\n", activity.ID, activity.Type)
+	}
+	// Output:
+	// ID: 3153, Type: 2
+}
+
 func ExampleSpaceActivityService_One() {
 	c, _ := backlog.NewClient(
 		"https://example.backlog.com",
@@ -98,3 +162,33 @@ func ExampleSpaceAttachmentService_Upload() {
 	// Output:
 	// ID: 1, Name: test.txt
 }
+
+func ExampleSpacePriorityService_List() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerPriorityList),
+	)
+
+	priorities, _ := c.Space.Priority.List(context.Background())
+	for _, p := range priorities {
+		fmt.Printf("ID: %d, Name: %s\n", p.ID, p.Name)
+	}
+	// Output:
+	// ID: 2, Name: High
+	// ID: 3, Name: Normal
+	// ID: 4, Name: Low
+}
+
+func ExampleSpaceResolutionService_List() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerResolutionList),
+	)
+
+	resolutions, _ := c.Space.Resolution.List(context.Background())
+	fmt.Printf("ID: %d, Name: %s\n", resolutions[0].ID, resolutions[0].Name)
+	// Output:
+	// ID: 0, Name: Fixed
+}
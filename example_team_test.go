@@ -0,0 +1,49 @@
+package backlog_test
+
+import (
+	"context"
+	"fmt"
+
+	backlog "github.com/nattokin/go-backlog"
+)
+
+func ExampleTeamService_List() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerTeamList),
+	)
+
+	teams, err := c.Team.List(context.Background(),
+		c.Team.Option.WithOrder(backlog.OrderAsc),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for _, t := range teams {
+		fmt.Println(t.Name, len(t.Members))
+	}
+	// Output:
+	// developers 1
+	// designers 0
+}
+
+func ExampleTeamService_Create() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerTeamSingle),
+	)
+
+	team, err := c.Team.Create(context.Background(), "developers",
+		c.Team.Option.WithMembers([]int{1, 2}),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(team.ID, team.Name, len(team.Members))
+	// Output:
+	// 1 developers 2
+}
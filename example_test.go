@@ -3,7 +3,11 @@ package backlog_test
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"os"
+	"time"
 
 	backlog "github.com/nattokin/go-backlog"
 )
@@ -72,3 +76,168 @@ func ExampleNewClient_withDoer() {
 	// Output:
 	// true
 }
+
+// ExampleNewClient_withAPIKey demonstrates authenticating with a Backlog API key
+// instead of an OAuth 2.0 access token.
+func ExampleNewClient_withAPIKey() {
+	c, err := backlog.NewClient(
+		"https://example.backlog.com",
+		"api-key",
+		backlog.WithAPIKey(),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(c != nil)
+	// Output:
+	// true
+}
+
+// ExampleNewClient_withRateLimitWait demonstrates enabling proactive rate
+// limiting. When the budget reported by the X-RateLimit-* headers is
+// exhausted, requests wait for the window to reset instead of failing with 429.
+func ExampleNewClient_withRateLimitWait() {
+	c, err := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithRateLimitWait(),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(c.RateLimit() == nil)
+	// Output:
+	// true
+}
+
+// ExampleNewClient_withRetry demonstrates retrying transient failures such as
+// 429 Too Many Requests or 503 Service Unavailable with exponential backoff.
+func ExampleNewClient_withRetry() {
+	c, err := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithRetry(backlog.RetryPolicy{
+			MaxAttempts: 5,
+			BaseDelay:   time.Second,
+		}),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(c != nil)
+	// Output:
+	// true
+}
+
+// ExampleWithMiddleware demonstrates intercepting every API call to observe
+// the service operation, the request and its outcome.
+func ExampleWithMiddleware() {
+	logCalls := func(next backlog.Handler) backlog.Handler {
+		return func(op string, req *http.Request) (*http.Response, error) {
+			resp, err := next(op, req)
+			fmt.Println(op, req.Method, req.URL.Path, err == nil)
+			return resp, err
+		}
+	}
+
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerIssueList),
+		backlog.WithMiddleware(logCalls),
+	)
+
+	_, _ = c.Issue.List(context.Background())
+	// Output:
+	// Issue.List GET /api/v2/issues true
+}
+
+// ExampleWithLogger demonstrates logging every API request with log/slog.
+func ExampleWithLogger() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		// Drop the time and latency to keep the output stable.
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "latency" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerIssueList),
+		backlog.WithLogger(logger),
+	)
+
+	_, _ = c.Issue.List(context.Background())
+	// Output:
+	// level=INFO msg="backlog: api request" operation=Issue.List method=GET path=/api/v2/issues status=200
+}
+
+// ExampleWithCache demonstrates caching project master data such as statuses.
+func ExampleWithCache() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerStatusList),
+		backlog.WithCache(backlog.CacheConfig{TTL: 10 * time.Minute}),
+	)
+
+	for range 3 {
+		_, _ = c.Project.Status.List(context.Background(), "MYPROJECT")
+	}
+	stats := c.CacheStats()
+	fmt.Printf("hits: %d, misses: %d\n", stats.Hits, stats.Misses)
+	// Output:
+	// hits: 2, misses: 1
+}
+
+// ExampleRawService_Get demonstrates calling an endpoint that the library
+// does not wrap and decoding its JSON response.
+func ExampleRawService_Get() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(newMockDoer(`[{"id":1,"note":"follow up"}]`)),
+	)
+
+	var watchings []struct {
+		ID   int    `json:"id"`
+		Note string `json:"note"`
+	}
+	err := c.Raw.Get(context.Background(), "users/1/watchings", url.Values{"count": {"50"}}, &watchings)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(watchings[0].ID, watchings[0].Note)
+	// Output:
+	// 1 follow up
+}
+
+// ExampleIsNotFound demonstrates classifying an API error without inspecting
+// its status code and error codes by hand.
+func ExampleIsNotFound() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerNotFound),
+	)
+
+	_, err := c.Issue.One(context.Background(), "PRJ-1")
+	switch {
+	case backlog.IsNotFound(err):
+		fmt.Println("not found")
+	case backlog.IsAuthError(err):
+		fmt.Println("check the token")
+	case err != nil:
+		fmt.Println(err)
+	}
+	// Output:
+	// not found
+}
@@ -0,0 +1,50 @@
+package backlog_test
+
+import (
+	"context"
+	"fmt"
+
+	backlog "github.com/nattokin/go-backlog"
+)
+
+func ExampleWatchingService_List() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerWatchingList),
+	)
+
+	watchings, err := c.Watching.List(context.Background(), 1,
+		c.Watching.Option.WithWatchingSort(backlog.WatchingSortIssueUpdated),
+		c.Watching.Option.WithOrder(backlog.OrderDesc),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for _, w := range watchings {
+		fmt.Println(w.Issue.IssueKey, w.ResourceAlreadyRead)
+	}
+	// Output:
+	// TEST-1 false
+	// TEST-2 true
+}
+
+func ExampleWatchingService_Add() {
+	c, _ := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithDoer(doerWatchingSingle),
+	)
+
+	w, err := c.Watching.Add(context.Background(), "TEST-2",
+		c.Watching.Option.WithNote("check later"),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(w.ID, w.Note)
+	// Output:
+	// 2 check later
+}
@@ -4,10 +4,8 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
-	"path/filepath"
 	"strconv"
 
 	"github.com/nattokin/go-backlog"
@@ -72,7 +70,8 @@ func downloadIssueAttachments(ctx context.Context, c *backlog.Client, issueID in
 			log.Printf("warning: failed to download %s: %v", a.Name, err)
 			continue
 		}
-		if err := saveFile(fd, outDir); err != nil {
+		fd.Size = int64(a.Size)
+		if _, err := fd.SaveTo(outDir); err != nil {
 			log.Printf("warning: failed to save %s: %v", a.Name, err)
 		}
 	}
@@ -92,25 +91,9 @@ func downloadWikiAttachments(ctx context.Context, c *backlog.Client, wikiID int,
 			log.Printf("warning: failed to download %s: %v", a.Name, err)
 			continue
 		}
-		if err := saveFile(fd, outDir); err != nil {
+		fd.Size = int64(a.Size)
+		if _, err := fd.SaveTo(outDir); err != nil {
 			log.Printf("warning: failed to save %s: %v", a.Name, err)
 		}
 	}
 }
-
-// saveFile writes FileData to a file in outDir and closes the body.
-func saveFile(fd *backlog.FileData, outDir string) error {
-	defer fd.Body.Close()
-
-	path := filepath.Join(outDir, fd.Filename)
-	f, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("create file: %w", err)
-	}
-	defer f.Close()
-
-	if _, err := io.Copy(f, fd.Body); err != nil {
-		return fmt.Errorf("write file: %w", err)
-	}
-	return nil
-}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -84,7 +83,8 @@ func main() {
 					log.Printf("warning: failed to download attachment %s: %v", a.Name, err)
 					continue
 				}
-				if err := saveFile(fd, attachDir); err != nil {
+				fd.Size = int64(a.Size)
+				if _, err := fd.SaveTo(attachDir); err != nil {
 					log.Printf("warning: failed to save attachment %s: %v", a.Name, err)
 				}
 			}
@@ -95,23 +95,6 @@ func main() {
 	}
 }
 
-// saveFile writes FileData to a file in outDir and closes the body.
-func saveFile(fd *backlog.FileData, outDir string) error {
-	defer fd.Body.Close()
-
-	path := filepath.Join(outDir, fd.Filename)
-	f, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("create file: %w", err)
-	}
-	defer f.Close()
-
-	if _, err := io.Copy(f, fd.Body); err != nil {
-		return fmt.Errorf("write file: %w", err)
-	}
-	return nil
-}
-
 // safeFilename replaces characters that are unsafe for filenames.
 func safeFilename(name string) string {
 	replacer := strings.NewReplacer(
@@ -0,0 +1,139 @@
+package backlog
+
+import (
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// defaultFilename is the name SaveTo uses when the server sent no usable filename.
+const defaultFilename = "download"
+
+// WriteTo writes the body to w and closes it. It implements [io.WriterTo].
+//
+// Progress, if set, is called as data is written. If Size is known and the
+// number of bytes read differs from it, WriteTo returns a *[SizeMismatchError].
+func (f *FileData) WriteTo(w io.Writer) (int64, error) {
+	defer f.Body.Close()
+
+	if f.Progress != nil {
+		w = &progressWriter{w: w, total: f.Size, fn: f.Progress}
+	}
+	n, err := io.Copy(w, f.Body)
+	if err != nil {
+		return n, err
+	}
+	if f.Size > 0 && n != f.Size {
+		return n, &SizeMismatchError{Want: f.Size, Got: n}
+	}
+	return n, nil
+}
+
+// SaveTo writes the body to a file in dir and closes it, returning the path of
+// the file.
+//
+// The file is named after Filename with any directory components and
+// characters that are unsafe in file names removed, so it is always created
+// directly in dir. An existing file with the same name is replaced. The file
+// gets the same permissions as one created by [os.Create].
+//
+// The data is first written to a temporary file in dir, which is renamed into
+// place only after the whole body was written and its size verified as in
+// [FileData.WriteTo]. On error, the temporary file is removed and no file is
+// left at the returned path.
+func (f *FileData) SaveTo(dir string) (string, error) {
+	path := filepath.Join(dir, sanitizeFilename(f.Filename))
+
+	tmp, err := createTemp(dir)
+	if err != nil {
+		f.Body.Close()
+		return "", err
+	}
+	_, err = f.WriteTo(tmp)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return path, nil
+}
+
+// createTemp creates a new temporary file in dir. Unlike [os.CreateTemp],
+// which uses mode 0600, it creates the file with mode 0666 before umask, so
+// that renaming it into place gives the permissions of [os.Create].
+func createTemp(dir string) (*os.File, error) {
+	for range 10000 {
+		name := filepath.Join(dir, ".backlog-"+strconv.FormatUint(rand.Uint64(), 36)+".tmp")
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o666)
+		if !os.IsExist(err) {
+			return f, err
+		}
+	}
+	return nil, &os.PathError{Op: "createtemp", Path: filepath.Join(dir, ".backlog-*.tmp"), Err: os.ErrExist}
+}
+
+// progressWriter reports the number of bytes written to w.
+type progressWriter struct {
+	w       io.Writer
+	total   int64
+	written int64
+	fn      func(written, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	if n > 0 {
+		p.written += int64(n)
+		p.fn(p.written, p.total)
+	}
+	return n, err
+}
+
+// windowsReservedNames are device names that cannot be used as file names on
+// Windows, with or without an extension.
+var windowsReservedNames = []string{
+	"CON", "PRN", "AUX", "NUL",
+	"COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+	"LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9",
+}
+
+// sanitizeFilename turns a server-provided name into a safe base name.
+// It keeps only the last path element, replaces control characters and
+// characters reserved on common file systems with '_', and returns
+// defaultFilename if nothing usable remains.
+func sanitizeFilename(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(`<>:"|?*`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	// Windows ignores trailing dots and spaces, and a name made only of dots
+	// refers to a directory.
+	name = strings.TrimLeft(strings.TrimRight(name, ". "), " ")
+	if name == "" {
+		return defaultFilename
+	}
+
+	stem, _, _ := strings.Cut(name, ".")
+	for _, reserved := range windowsReservedNames {
+		if strings.EqualFold(stem, reserved) {
+			return "_" + name
+		}
+	}
+	return name
+}
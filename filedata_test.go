@@ -0,0 +1,250 @@
+package backlog
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trackingBody records whether it was closed and can fail after its data.
+type trackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func newTrackingBody(data string, err error) *trackingBody {
+	r := io.Reader(bytes.NewReader([]byte(data)))
+	if err != nil {
+		r = io.MultiReader(r, &failingReader{err: err})
+	}
+	return &trackingBody{Reader: r}
+}
+
+type failingReader struct{ err error }
+
+func (r *failingReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestFileData_WriteTo(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("connection reset")
+
+	cases := map[string]struct {
+		data    string
+		readErr error
+		size    int64
+
+		wantN        int64
+		wantErr      error
+		wantMismatch bool
+	}{
+		"unknown-size": {
+			data:  "hello",
+			wantN: 5,
+		},
+		"matching-size": {
+			data:  "hello",
+			size:  5,
+			wantN: 5,
+		},
+		"short-body": {
+			data:         "hel",
+			size:         5,
+			wantN:        3,
+			wantMismatch: true,
+		},
+		"read-error": {
+			data:    "hel",
+			readErr: errRead,
+			size:    5,
+			wantN:   3,
+			wantErr: errRead,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := newTrackingBody(tc.data, tc.readErr)
+			var progress [][2]int64
+			fd := &FileData{
+				Body: body,
+				Size: tc.size,
+				Progress: func(written, total int64) {
+					progress = append(progress, [2]int64{written, total})
+				},
+			}
+
+			var buf bytes.Buffer
+			n, err := fd.WriteTo(&buf)
+
+			assert.True(t, body.closed)
+			assert.Equal(t, tc.wantN, n)
+			assert.Equal(t, tc.data, buf.String())
+			require.NotEmpty(t, progress)
+			assert.Equal(t, [2]int64{tc.wantN, tc.size}, progress[len(progress)-1])
+
+			switch {
+			case tc.wantErr != nil:
+				assert.ErrorIs(t, err, tc.wantErr)
+			case tc.wantMismatch:
+				var target *SizeMismatchError
+				require.ErrorAs(t, err, &target)
+				assert.Equal(t, tc.size, target.Want)
+				assert.Equal(t, tc.wantN, target.Got)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFileData_SaveTo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		body := newTrackingBody("content", nil)
+		fd := &FileData{Body: body, Filename: "../../etc/仕様.txt", Size: 7}
+
+		path, err := fd.SaveTo(dir)
+
+		require.NoError(t, err)
+		assert.True(t, body.closed)
+		assert.Equal(t, filepath.Join(dir, "仕様.txt"), path)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(data))
+		assertDirEntries(t, dir, "仕様.txt")
+	})
+
+	t.Run("replaces-existing-file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("old content"), 0o600))
+		fd := &FileData{Body: newTrackingBody("new", nil), Filename: "a.txt"}
+
+		path, err := fd.SaveTo(dir)
+
+		require.NoError(t, err)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(data))
+		assertDirEntries(t, dir, "a.txt")
+	})
+
+	t.Run("size-mismatch-leaves-no-file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		fd := &FileData{Body: newTrackingBody("cut", nil), Filename: "a.txt", Size: 10}
+
+		path, err := fd.SaveTo(dir)
+
+		var target *SizeMismatchError
+		assert.ErrorAs(t, err, &target)
+		assert.Empty(t, path)
+		assertDirEntries(t, dir)
+	})
+
+	t.Run("read-error-leaves-no-file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		errRead := errors.New("connection reset")
+		fd := &FileData{Body: newTrackingBody("cut", errRead), Filename: "a.txt"}
+
+		_, err := fd.SaveTo(dir)
+
+		assert.ErrorIs(t, err, errRead)
+		assertDirEntries(t, dir)
+	})
+
+	t.Run("file-mode", func(t *testing.T) {
+		t.Parallel()
+
+		// os.Create applies the umask of the process to mode 0666.
+		dir := t.TempDir()
+		ref, err := os.Create(filepath.Join(dir, "ref.txt"))
+		require.NoError(t, err)
+		require.NoError(t, ref.Close())
+		want, err := os.Stat(ref.Name())
+		require.NoError(t, err)
+
+		path, err := (&FileData{Body: newTrackingBody("content", nil), Filename: "a.txt"}).SaveTo(dir)
+
+		require.NoError(t, err)
+		got, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, want.Mode(), got.Mode())
+	})
+
+	t.Run("missing-dir", func(t *testing.T) {
+		t.Parallel()
+
+		body := newTrackingBody("content", nil)
+		fd := &FileData{Body: body, Filename: "a.txt"}
+
+		_, err := fd.SaveTo(filepath.Join(t.TempDir(), "missing"))
+
+		assert.Error(t, err)
+		assert.True(t, body.closed)
+	})
+}
+
+func assertDirEntries(t *testing.T, dir string, want ...string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	got := make([]string, 0, len(entries))
+	for _, e := range entries {
+		got = append(got, e.Name())
+	}
+	assert.ElementsMatch(t, want, got)
+}
+
+func Test_sanitizeFilename(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input string
+		want  string
+	}{
+		"plain":               {input: "file.png", want: "file.png"},
+		"japanese":            {input: "議事録 2024.txt", want: "議事録 2024.txt"},
+		"dotfile":             {input: ".gitignore", want: ".gitignore"},
+		"unix-path":           {input: "../../etc/passwd", want: "passwd"},
+		"windows-path":        {input: `..\..\Windows\win.ini`, want: "win.ini"},
+		"reserved-characters": {input: `a<b>c:d"e|f?g*h.txt`, want: "a_b_c_d_e_f_g_h.txt"},
+		"control-characters":  {input: "a\x00b\nc.txt", want: "a_b_c.txt"},
+		"trailing-dots":       {input: "file.txt. . ", want: "file.txt"},
+		"dot-dot":             {input: "..", want: "download"},
+		"trailing-separator":  {input: "dir/", want: "download"},
+		"empty":               {input: "", want: "download"},
+		"windows-device":      {input: "con.txt", want: "_con.txt"},
+		"windows-device-like": {input: "console.txt", want: "console.txt"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, sanitizeFilename(tc.input))
+		})
+	}
+}
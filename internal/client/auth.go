@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	apiKeyParam   = "apiKey"
+	redactedValue = "REDACTED"
+)
+
+// Authenticator applies credentials to an outgoing request.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BearerAuthenticator sends an OAuth 2.0 access token in the Authorization header.
+type BearerAuthenticator struct {
+	Token string
+}
+
+func (a *BearerAuthenticator) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// APIKeyAuthenticator sends a Backlog API key as the apiKey query parameter.
+type APIKeyAuthenticator struct {
+	Key string
+}
+
+func (a *APIKeyAuthenticator) Authenticate(req *http.Request) error {
+	q := req.URL.Query()
+	q.Set(apiKeyParam, a.Key)
+	req.URL.RawQuery = q.Encode()
+	return nil
+}
+
+// TokenSource supplies OAuth 2.0 access tokens.
+type TokenSource interface {
+	// Token returns a valid access token, refreshing it first if it has expired.
+	Token(ctx context.Context) (string, error)
+	// Refresh obtains a new access token after the API rejected the given one.
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// TokenSourceAuthenticator sends access tokens obtained from a TokenSource
+// in the Authorization header.
+type TokenSourceAuthenticator struct {
+	Source TokenSource
+}
+
+func (a *TokenSourceAuthenticator) Authenticate(req *http.Request) error {
+	token, err := a.Source.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Refresh asks the TokenSource for a new token after req was rejected with
+// 401 Unauthorized.
+func (a *TokenSourceAuthenticator) Refresh(req *http.Request) error {
+	rejected := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	_, err := a.Source.Refresh(req.Context(), rejected)
+	return err
+}
+
+// refresher is implemented by authenticators that can renew their credentials
+// after a 401 Unauthorized response.
+type refresher interface {
+	Refresh(req *http.Request) error
+}
+
+// RedactURL replaces the value of the apiKey query parameter in rawURL so that
+// the key does not leak into error messages or logs.
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || !strings.Contains(u.RawQuery, apiKeyParam) {
+		return rawURL
+	}
+	q := u.Query()
+	if !q.Has(apiKeyParam) {
+		return rawURL
+	}
+	q.Set(apiKeyParam, redactedValue)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// redactError removes credentials from the URL embedded in a *url.Error,
+// as returned by *http.Client.
+func redactError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		ue.URL = RedactURL(ue.URL)
+	}
+	return err
+}
@@ -0,0 +1,262 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestNewClient_authenticator(t *testing.T) {
+	t.Run("default-bearer", func(t *testing.T) {
+		c, err := client.NewClient("https://example.com", "token")
+		require.NoError(t, err)
+		assert.Equal(t, &client.BearerAuthenticator{Token: "token"}, c.Auth)
+	})
+
+	t.Run("api-key", func(t *testing.T) {
+		c, err := client.NewClient("https://example.com", "key", client.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, &client.APIKeyAuthenticator{Key: "key"}, c.Auth)
+	})
+}
+
+func TestClient_method_apiKey(t *testing.T) {
+	cases := map[string]struct {
+		call      func(c *client.Client) (*http.Response, error)
+		wantQuery url.Values
+	}{
+		"GET": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Get(context.Background(), "path", url.Values{"count": {"10"}})
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}, "count": {"10"}},
+		},
+		"POST": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Post(context.Background(), "path", url.Values{"name": {"x"}})
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}},
+		},
+		"PATCH": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Patch(context.Background(), "path", nil)
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}},
+		},
+		"PUT": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Put(context.Background(), "path", nil)
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}},
+		},
+		"DELETE": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Delete(context.Background(), "path", nil)
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}},
+		},
+		"UPLOAD": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Upload(context.Background(), "path", "file.txt", bytes.NewBufferString("data"))
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}},
+		},
+		"DOWNLOAD": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Method.Download(context.Background(), "path", nil)
+			},
+			wantQuery: url.Values{"apiKey": {"secret"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, captured := mock.NewCaptureClient(t, "{}")
+			c.Auth = &client.APIKeyAuthenticator{Key: "secret"}
+
+			_, err := tc.call(c)
+			require.NoError(t, err)
+
+			assert.Empty(t, captured.Header.Get("Authorization"))
+			assert.Equal(t, tc.wantQuery, captured.URL.Query())
+		})
+	}
+}
+
+func TestClient_Do_redactsAPIKey(t *testing.T) {
+	c, err := client.NewClient("https://example.com", "secret",
+		client.WithAPIKey(),
+		client.WithDoer(&mock.Doer{T: t, DoFunc: func(req *http.Request) (*http.Response, error) {
+			return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("connection refused")}
+		}}),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "space", nil)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "apiKey=REDACTED")
+}
+
+func TestRedactURL(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"api-key": {
+			in:   "https://example.com/api/v2/space?apiKey=secret&count=1",
+			want: "https://example.com/api/v2/space?apiKey=REDACTED&count=1",
+		},
+		"no-api-key": {
+			in:   "https://example.com/api/v2/space?count=1",
+			want: "https://example.com/api/v2/space?count=1",
+		},
+		"similar-param": {
+			in:   "https://example.com/api/v2/space?apiKeyword=x",
+			want: "https://example.com/api/v2/space?apiKeyword=x",
+		},
+		"invalid-url": {
+			in:   "://apiKey",
+			want: "://apiKey",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, client.RedactURL(tc.in))
+		})
+	}
+}
+
+// tokenSource is a TokenSource stub that records the tokens passed to Refresh.
+type tokenSource struct {
+	current    string
+	tokenErr   error
+	refreshErr error
+	rejected   []string
+}
+
+func (s *tokenSource) Token(_ context.Context) (string, error) {
+	return s.current, s.tokenErr
+}
+
+func (s *tokenSource) Refresh(_ context.Context, rejected string) (string, error) {
+	s.rejected = append(s.rejected, rejected)
+	if s.refreshErr != nil {
+		return "", s.refreshErr
+	}
+	s.current = "refreshed"
+	return s.current, nil
+}
+
+func TestNewClient_tokenSource(t *testing.T) {
+	ts := &tokenSource{current: "initial"}
+
+	c, err := client.NewClient("https://example.com", "", client.WithTokenSource(ts))
+	require.NoError(t, err)
+	assert.Equal(t, &client.TokenSourceAuthenticator{Source: ts}, c.Auth)
+}
+
+func TestClient_Do_tokenSource(t *testing.T) {
+	cases := map[string]struct {
+		source    *tokenSource
+		call      func(c *client.Client) (*http.Response, error)
+		responses []*http.Response
+
+		wantAuth     []string
+		wantRejected []string
+		wantErr      bool
+		wantStatus   int
+	}{
+		"token-per-request": {
+			source: &tokenSource{current: "initial"},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses: []*http.Response{mock.NewResponse(`{}`)},
+			wantAuth:  []string{"Bearer initial"},
+		},
+		"refresh-on-401": {
+			source: &tokenSource{current: "initial"},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Post(context.Background(), "test", url.Values{"k": {"v"}})
+			},
+			responses:    []*http.Response{mock.NewUnauthorizedResponse(), mock.NewResponse(`{}`)},
+			wantAuth:     []string{"Bearer initial", "Bearer refreshed"},
+			wantRejected: []string{"initial"},
+		},
+		"refresh-only-once": {
+			source: &tokenSource{current: "initial"},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses:    []*http.Response{mock.NewUnauthorizedResponse(), mock.NewUnauthorizedResponse()},
+			wantAuth:     []string{"Bearer initial", "Bearer refreshed"},
+			wantRejected: []string{"initial"},
+			wantErr:      true,
+			wantStatus:   http.StatusUnauthorized,
+		},
+		"error-token": {
+			source: &tokenSource{tokenErr: errors.New("token error")},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			wantErr: true,
+		},
+		"error-refresh": {
+			source: &tokenSource{current: "initial", refreshErr: errors.New("refresh error")},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses:    []*http.Response{mock.NewUnauthorizedResponse()},
+			wantAuth:     []string{"Bearer initial"},
+			wantRejected: []string{"initial"},
+			wantErr:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var auth []string
+			c, err := client.NewClient("https://example.com", "",
+				client.WithTokenSource(tc.source),
+				client.WithDoer(&mock.Doer{T: t, DoFunc: func(req *http.Request) (*http.Response, error) {
+					auth = append(auth, req.Header.Get("Authorization"))
+					require.LessOrEqual(t, len(auth), len(tc.responses), "unexpected extra request")
+					return tc.responses[len(auth)-1], nil
+				}}),
+			)
+			require.NoError(t, err)
+
+			_, err = tc.call(c)
+
+			assert.Equal(t, tc.wantAuth, auth)
+			assert.Equal(t, tc.wantRejected, tc.source.rejected)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				if tc.wantStatus != 0 {
+					var apiErr *client.APIResponseError
+					require.ErrorAs(t, err, &apiErr)
+					assert.Equal(t, tc.wantStatus, apiErr.StatusCode)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
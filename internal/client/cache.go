@@ -0,0 +1,226 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCacheTTL is the time cached responses are kept when no TTL is configured.
+const DefaultCacheTTL = 5 * time.Minute
+
+// cachedResources lists the project sub-resources whose list responses are cached.
+var cachedResources = []string{"statuses", "issueTypes", "categories", "versions", "customFields"}
+
+// CacheStore stores cached response bodies.
+// Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the value stored under key, and whether it was found and has not expired.
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+// CacheStats reports cache effectiveness.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// Cache is a read-through cache for the list endpoints of project master data
+// (statuses, issue types, categories, versions and custom fields).
+//
+// Successful GET responses are stored in Store. Any other successful request
+// to the same kind of resource invalidates all cached lists of that kind by
+// bumping a generation number that is part of the cache key.
+type Cache struct {
+	Store CacheStore
+	TTL   time.Duration
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+
+	mu          sync.Mutex
+	generations map[string]uint64
+}
+
+// NewCache returns a Cache backed by store, or by a MemoryCacheStore if store is nil.
+// A non-positive ttl selects DefaultCacheTTL.
+func NewCache(store CacheStore, ttl time.Duration) *Cache {
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{Store: store, TTL: ttl, generations: map[string]uint64{}}
+}
+
+// Stats returns the number of cache hits and misses so far.
+// It returns a zero CacheStats on a nil Cache.
+func (c *Cache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+// Middleware serves cacheable requests from the cache and invalidates cached
+// lists after successful writes. It has the signature of a Middleware.
+func (c *Cache) Middleware(next Handler) Handler {
+	return func(op string, req *http.Request) (*http.Response, error) {
+		kind, list, all := cacheResource(req.URL.Path)
+
+		switch {
+		case req.Method == http.MethodGet && list:
+			return c.readThrough(next, op, req, kind)
+		case req.Method != http.MethodGet && (kind != "" || all):
+			resp, err := next(op, req)
+			if err == nil {
+				c.invalidate(kind, all)
+			}
+			return resp, err
+		default:
+			return next(op, req)
+		}
+	}
+}
+
+func (c *Cache) readThrough(next Handler, op string, req *http.Request, kind string) (*http.Response, error) {
+	ctx := req.Context()
+	key := c.key(kind, req)
+	if body, ok := c.Store.Get(ctx, key); ok {
+		c.hits.Add(1)
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	c.misses.Add(1)
+
+	resp, err := next(op, req)
+	if err != nil || resp == nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	c.Store.Set(ctx, key, body, c.TTL)
+	return resp, nil
+}
+
+// key builds the cache key for req. It includes the generation of kind and
+// leaves out credentials.
+func (c *Cache) key(kind string, req *http.Request) string {
+	c.mu.Lock()
+	gen := c.generations[kind]
+	c.mu.Unlock()
+
+	u := *req.URL
+	q := u.Query()
+	q.Del(apiKeyParam)
+	u.RawQuery = q.Encode()
+	u.User = nil
+	return "backlog:" + strconv.FormatUint(gen, 10) + ":" + u.String()
+}
+
+func (c *Cache) invalidate(kind string, all bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if all {
+		for _, k := range cachedResources {
+			c.generations[k]++
+		}
+		return
+	}
+	c.generations[kind]++
+}
+
+// cacheResource classifies an API path. kind is the cached resource the path
+// belongs to, such as "statuses" for /projects/:projectIdOrKey/statuses/1, and
+// list reports whether the path is the list endpoint of kind. all reports
+// whether the path is a project itself, whose modification affects every
+// cached resource.
+func cacheResource(urlPath string) (kind string, list, all bool) {
+	prefix := "/api/" + apiVersion + "/"
+	i := strings.Index(urlPath, prefix)
+	if i < 0 {
+		return "", false, false
+	}
+	segs := strings.Split(strings.Trim(urlPath[i+len(prefix):], "/"), "/")
+	if len(segs) < 2 || segs[0] != "projects" {
+		return "", false, false
+	}
+	if len(segs) == 2 {
+		return "", false, true
+	}
+	for _, r := range cachedResources {
+		if segs[2] == r {
+			return r, len(segs) == 3, false
+		}
+	}
+	return "", false, false
+}
+
+// MemoryCacheStore is an in-memory CacheStore whose entries expire after their TTL.
+type MemoryCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCacheStore returns an empty MemoryCacheStore.
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{entries: map[string]memoryCacheEntry{}}
+}
+
+// Get implements CacheStore.
+func (s *MemoryCacheStore) Get(_ context.Context, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(e.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Set implements CacheStore. It also drops expired entries.
+func (s *MemoryCacheStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range s.entries {
+		if !now.Before(e.expires) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = memoryCacheEntry{value: value, expires: now.Add(ttl)}
+}
@@ -0,0 +1,193 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func newCachedClient(t *testing.T, calls *int) *client.Client {
+	t.Helper()
+
+	c, err := client.NewClient("https://example.com", "token",
+		client.WithDoer(&mock.Doer{T: t, DoFunc: func(req *http.Request) (*http.Response, error) {
+			*calls++
+			if req.Method == http.MethodGet {
+				return mock.NewResponse(`[{"id":1}]`), nil
+			}
+			return mock.NewResponse(`{"id":1}`), nil
+		}}),
+		client.WithCache(client.NewCache(nil, time.Minute)),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func readBody(t *testing.T, resp *http.Response) string {
+	t.Helper()
+
+	require.NotNil(t, resp)
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestNewCache(t *testing.T) {
+	c := client.NewCache(nil, 0)
+	assert.IsType(t, &client.MemoryCacheStore{}, c.Store)
+	assert.Equal(t, client.DefaultCacheTTL, c.TTL)
+
+	var nilCache *client.Cache
+	assert.Equal(t, client.CacheStats{}, nilCache.Stats())
+}
+
+func TestCache_readThrough(t *testing.T) {
+	calls := 0
+	c := newCachedClient(t, &calls)
+	ctx := context.Background()
+
+	for range 3 {
+		resp, err := c.Get(ctx, "projects/PRJ/statuses", nil)
+		require.NoError(t, err)
+		assert.Equal(t, `[{"id":1}]`, readBody(t, resp))
+	}
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, client.CacheStats{Hits: 2, Misses: 1}, c.Cache.Stats())
+
+	// Different projects and queries are cached separately.
+	_, err := c.Get(ctx, "projects/OTHER/statuses", nil)
+	require.NoError(t, err)
+	_, err = c.Get(ctx, "projects/PRJ/versions", url.Values{"archived": {"false"}})
+	require.NoError(t, err)
+	_, err = c.Get(ctx, "projects/PRJ/versions", url.Values{"archived": {"true"}})
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestCache_notCached(t *testing.T) {
+	calls := 0
+	c := newCachedClient(t, &calls)
+	ctx := context.Background()
+
+	for _, spath := range []string{"projects/PRJ", "projects/PRJ/users", "issues", "projects/PRJ/statuses/1"} {
+		for range 2 {
+			_, err := c.Get(ctx, spath, nil)
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, 8, calls)
+	assert.Equal(t, client.CacheStats{}, c.Cache.Stats())
+}
+
+func TestCache_invalidate(t *testing.T) {
+	cases := map[string]struct {
+		write       func(c *client.Client) error
+		invalidated []string
+	}{
+		"create": {
+			write: func(c *client.Client) error {
+				_, err := c.Post(context.Background(), "projects/PRJ/statuses", url.Values{"name": {"x"}})
+				return err
+			},
+			invalidated: []string{"projects/PRJ/statuses", "projects/OTHER/statuses"},
+		},
+		"update": {
+			write: func(c *client.Client) error {
+				_, err := c.Patch(context.Background(), "projects/PRJ/categories/1", nil)
+				return err
+			},
+			invalidated: []string{"projects/PRJ/categories"},
+		},
+		"delete-custom-field-item": {
+			write: func(c *client.Client) error {
+				_, err := c.Delete(context.Background(), "projects/PRJ/customFields/1/items/2", nil)
+				return err
+			},
+			invalidated: []string{"projects/PRJ/customFields"},
+		},
+		"delete-project": {
+			write: func(c *client.Client) error {
+				_, err := c.Delete(context.Background(), "projects/PRJ", nil)
+				return err
+			},
+			invalidated: []string{
+				"projects/PRJ/statuses", "projects/OTHER/statuses", "projects/PRJ/categories",
+				"projects/PRJ/customFields", "projects/PRJ/issueTypes", "projects/PRJ/versions",
+			},
+		},
+	}
+
+	all := []string{
+		"projects/PRJ/statuses", "projects/OTHER/statuses", "projects/PRJ/categories",
+		"projects/PRJ/customFields", "projects/PRJ/issueTypes", "projects/PRJ/versions",
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			c := newCachedClient(t, &calls)
+			for _, spath := range all {
+				_, err := c.Get(context.Background(), spath, nil)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, tc.write(c))
+
+			for _, spath := range all {
+				before := calls
+				_, err := c.Get(context.Background(), spath, nil)
+				require.NoError(t, err)
+				assert.Equal(t, slices.Contains(tc.invalidated, spath), calls > before, spath)
+			}
+		})
+	}
+}
+
+func TestCache_errorNotCached(t *testing.T) {
+	calls := 0
+	c, err := client.NewClient("https://example.com", "token",
+		client.WithDoer(&mock.Doer{T: t, DoFunc: func(_ *http.Request) (*http.Response, error) {
+			calls++
+			return mock.NewNotFoundResponse(), nil
+		}}),
+		client.WithCache(client.NewCache(nil, time.Minute)),
+	)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := c.Get(context.Background(), "projects/PRJ/statuses", nil)
+		require.Error(t, err)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, client.CacheStats{Misses: 2}, c.Cache.Stats())
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	s := client.NewMemoryCacheStore()
+	ctx := context.Background()
+
+	_, ok := s.Get(ctx, "key")
+	assert.False(t, ok)
+
+	s.Set(ctx, "key", []byte("value"), time.Minute)
+	got, ok := s.Get(ctx, "key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), got)
+
+	s.Set(ctx, "expired", []byte("value"), -time.Second)
+	_, ok = s.Get(ctx, "expired")
+	assert.False(t, ok)
+}
@@ -3,17 +3,19 @@
 package client
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/nattokin/go-backlog/internal/model"
 )
@@ -41,8 +43,9 @@ func (e *Error) Error() string {
 
 // APIResponseError represents Error Response of Backlog API.
 type APIResponseError struct {
-	StatusCode int      `json:"-"` // HTTP status code (4xx or 5xx)
-	Errors     []*Error `json:"errors,omitempty"`
+	StatusCode int        `json:"-"` // HTTP status code (4xx or 5xx)
+	RateLimit  *RateLimit `json:"-"` // Rate limit reported with the response, if any
+	Errors     []*Error   `json:"errors,omitempty"`
 }
 
 func (e *APIResponseError) Error() string {
@@ -75,11 +78,20 @@ type Doer interface {
 }
 
 type Client struct {
-	BaseURL *url.URL
-	Token   string
-	Doer    Doer
-	Wrapper Wrapper
-	Method  *Method
+	BaseURL     *url.URL
+	Token       string
+	Auth        Authenticator
+	Doer        Doer
+	Wrapper     Wrapper
+	RateLimiter *RateLimiter
+	Retry       *RetryPolicy
+	Middleware  []Middleware
+	Logger      *Logger
+	Cache       *Cache
+	Method      *Method
+
+	// UploadProgress, if set, is called as file data is sent by Upload.
+	UploadProgress ProgressFunc
 }
 
 // Method holds injected HTTP operation functions.
@@ -93,10 +105,21 @@ type Method struct {
 	Delete   func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
 	Upload   func(ctx context.Context, spath, fileName string, r io.Reader) (*http.Response, error)
 	Download func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+
+	// RateLimitRemaining reports the number of requests left in the current
+	// rate limit window, or -1 if unknown. It may be nil.
+	RateLimitRemaining func() int
 }
 
 func NewClient(baseURL, token string, opts ...*ClientOption) (*Client, error) {
-	if token == "" {
+	config := &clientConfig{LogBodyLevel: slog.LevelDebug}
+	for _, option := range opts {
+		if option != nil {
+			option.set(config)
+		}
+	}
+
+	if token == "" && config.TokenSource == nil {
 		return nil, NewInternalClientError("missing token")
 	}
 	if baseURL == "" {
@@ -108,22 +131,23 @@ func NewClient(baseURL, token string, opts ...*ClientOption) (*Client, error) {
 		return nil, err
 	}
 
-	config := &clientConfig{}
-	for _, option := range opts {
-		if option != nil {
-			option.set(config)
-		}
-	}
-
 	if config.Doer == nil {
 		config.Doer = http.DefaultClient
 	}
 
 	c := &Client{
-		BaseURL: u,
-		Doer:    config.Doer,
-		Token:   token,
-		Wrapper: &DefaultWrapper{},
+		BaseURL:     u,
+		Doer:        config.Doer,
+		Token:       token,
+		Auth:        newAuthenticator(token, config),
+		Wrapper:     &DefaultWrapper{},
+		RateLimiter: NewRateLimiter(config.RateLimitWait),
+		Retry:       config.Retry,
+		Middleware:  config.Middleware,
+		Logger:      NewLogger(config.Logger, config.LogBodyLevel),
+		Cache:       config.Cache,
+
+		UploadProgress: config.UploadProgress,
 	}
 
 	c.Method = &Method{
@@ -134,11 +158,24 @@ func NewClient(baseURL, token string, opts ...*ClientOption) (*Client, error) {
 		Delete:   c.Delete,
 		Upload:   c.Upload,
 		Download: c.Download,
+
+		RateLimitRemaining: c.RateLimiter.Remaining,
 	}
 
 	return c, nil
 }
 
+func newAuthenticator(token string, config *clientConfig) Authenticator {
+	switch {
+	case config.TokenSource != nil:
+		return &TokenSourceAuthenticator{Source: config.TokenSource}
+	case config.APIKey:
+		return &APIKeyAuthenticator{Key: token}
+	default:
+		return &BearerAuthenticator{Token: token}
+	}
+}
+
 func (c *Client) NewRequest(ctx context.Context, Method, spath string, opts ...*HttpRequestOption) (*http.Request, error) {
 	if spath == "" {
 		return nil, errors.New("spath must not be empty")
@@ -157,33 +194,116 @@ func (c *Client) NewRequest(ctx context.Context, Method, spath string, opts ...*
 		u.RawQuery = config.Query.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, Method, u.String(), config.Body)
+	body := config.Body
+	if config.GetBody != nil {
+		b, err := config.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	req, err := http.NewRequestWithContext(ctx, Method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
+	if config.GetBody != nil {
+		req.GetBody = config.GetBody
+		req.ContentLength = config.ContentLength
+	}
 
 	if config.Header != nil {
 		req.Header = config.Header.Clone()
 	}
-	req.Header.Set("Authorization", "Bearer "+c.Token)
+	if err := c.Auth.Authenticate(req); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
 // Do executes the given HTTP request using the injected Doer.
 // All HTTP calls pass through this function, ensuring consistent error handling.
+// The request passes through the client's middleware chain and cache, and
+// transient failures are retried according to the client's RetryPolicy, if any.
 func (c *Client) Do(ctx context.Context, Method, spath string, opts ...*HttpRequestOption) (*http.Response, error) {
 	req, err := c.NewRequest(ctx, Method, spath, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Doer.Do(req)
+	h := c.send
+	if c.Cache != nil {
+		h = c.Cache.Middleware(h)
+	}
+	return chain(h, c.Middleware)(Operation(ctx), req)
+}
+
+// send performs req with rate limiting, credential refresh and retries,
+// and converts the final response with CheckResponse.
+func (c *Client) send(_ string, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	attempt := 1
+	refreshed := false
+	for {
+		if err := c.RateLimiter.Reserve(ctx); err != nil {
+			return nil, err
+		}
+
+		start := time.Now()
+		resp, err := c.Doer.Do(req)
+		if err != nil {
+			err = redactError(err)
+		} else {
+			c.RateLimiter.Update(resp.Header)
+		}
+		c.Logger.logExchange(req, resp, err, attempt, time.Since(start))
+
+		if r, ok := c.Auth.(refresher); ok && !refreshed && err == nil &&
+			resp.StatusCode == http.StatusUnauthorized && canReplay(req) {
+			// The credentials were rejected; renew them and try once more.
+			refreshed = true
+			discardResponse(resp)
+			if err := r.Refresh(req); err != nil {
+				return nil, err
+			}
+			if req, err = c.reauthenticate(req); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if !c.Retry.shouldRetry(req, resp, err, attempt) {
+			if err != nil {
+				return nil, err
+			}
+			return CheckResponse(resp)
+		}
+
+		delay := c.Retry.delay(resp, attempt, c.RateLimiter.Now())
+		discardResponse(resp)
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+		attempt++
+	}
+}
+
+// reauthenticate returns a copy of req with a fresh body and newly applied credentials.
+func (c *Client) reauthenticate(req *http.Request) (*http.Request, error) {
+	r, err := rewindRequest(req)
 	if err != nil {
 		return nil, err
 	}
-
-	return CheckResponse(resp)
+	if err := c.Auth.Authenticate(r); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
 func (c *Client) Get(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
@@ -226,30 +346,6 @@ func (c *Client) Delete(ctx context.Context, spath string, form url.Values) (*ht
 	return c.Do(ctx, http.MethodDelete, spath, WithHeader(header), WithBody(strings.NewReader(form.Encode())))
 }
 
-func (c *Client) Upload(ctx context.Context, spath, fileName string, r io.Reader) (*http.Response, error) {
-	if fileName == "" {
-		return nil, NewInternalClientError("fileName is required")
-	}
-	var buf bytes.Buffer
-	mw := c.Wrapper.NewMultipartWriter(&buf)
-
-	fw, err := mw.CreateFormFile("file", fileName)
-	if err != nil {
-		return nil, err
-	}
-	if err = c.Wrapper.Copy(fw, r); err != nil {
-		return nil, err
-	}
-	if err := mw.Close(); err != nil {
-		return nil, err
-	}
-
-	header := http.Header{}
-	header.Set("Content-Type", mw.FormDataContentType())
-
-	return c.Do(ctx, http.MethodPost, spath, WithHeader(header), WithBody(&buf))
-}
-
 func (c *Client) Download(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
 	return c.Do(ctx, http.MethodGet, spath, WithQuery(query))
 }
@@ -275,7 +371,7 @@ func CheckResponse(r *http.Response) (*http.Response, error) {
 		}
 	}()
 
-	e := &APIResponseError{StatusCode: sc}
+	e := &APIResponseError{StatusCode: sc, RateLimit: ParseRateLimit(r.Header)}
 
 	if r.Body != nil {
 		if err := json.NewDecoder(r.Body).Decode(e); err == nil {
@@ -294,15 +390,13 @@ func DecodeResponse(resp *http.Response, v any) error {
 }
 
 // DownloadResponse extracts FileData from a binary HTTP response.
-// It parses the filename from Content-Disposition and the media type from Content-Type.
+// It parses the filename from Content-Disposition, the media type from
+// Content-Type and the size from Content-Length.
 // The caller is responsible for closing FileData.Body.
 func DownloadResponse(resp *http.Response) (*model.FileData, error) {
 	filename := ""
 	if cd := resp.Header.Get("Content-Disposition"); cd != "" {
-		_, params, err := mime.ParseMediaType(cd)
-		if err == nil {
-			filename = params["filename"]
-		}
+		filename = dispositionFilename(cd)
 	}
 
 	contentType := ""
@@ -313,9 +407,79 @@ func DownloadResponse(resp *http.Response) (*model.FileData, error) {
 		}
 	}
 
+	var size int64
+	if resp.ContentLength > 0 {
+		size = resp.ContentLength
+	}
+
 	return &model.FileData{
 		Body:        resp.Body,
 		Filename:    filename,
 		ContentType: contentType,
+		Size:        size,
 	}, nil
 }
+
+// dispositionFilename returns the filename in a Content-Disposition header
+// value. An RFC 5987 "filename*" parameter, which Backlog uses for non-ASCII
+// names, takes precedence over "filename". It falls back to scanning the
+// parameters by hand when the header is not strictly well-formed, as happens
+// with unquoted names containing spaces.
+func dispositionFilename(cd string) string {
+	if _, params, err := mime.ParseMediaType(cd); err == nil {
+		return params["filename"]
+	}
+
+	var filename string
+	for _, param := range strings.Split(cd, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "filename*":
+			if v, ok := decodeExtValue(value); ok {
+				return v
+			}
+		case "filename":
+			if filename == "" {
+				filename = strings.Trim(value, `"`)
+			}
+		}
+	}
+	return filename
+}
+
+// decodeExtValue decodes an RFC 5987 ext-value such as UTF-8”%E3%81%82.txt.
+// Only the UTF-8 and ISO-8859-1 charsets are supported.
+func decodeExtValue(v string) (string, bool) {
+	charset, rest, ok := strings.Cut(strings.Trim(v, `"`), "'")
+	if !ok {
+		return "", false
+	}
+	_, encoded, ok := strings.Cut(rest, "'")
+	if !ok {
+		return "", false
+	}
+	decoded, err := url.PathUnescape(encoded)
+	if err != nil {
+		return "", false
+	}
+
+	switch strings.ToLower(charset) {
+	case "utf-8":
+		if !utf8.ValidString(decoded) {
+			return "", false
+		}
+		return decoded, true
+	case "iso-8859-1":
+		runes := make([]rune, len(decoded))
+		for i := range len(decoded) {
+			runes[i] = rune(decoded[i])
+		}
+		return string(runes), true
+	default:
+		return "", false
+	}
+}
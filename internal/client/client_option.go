@@ -2,6 +2,7 @@ package client
 
 import (
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 )
@@ -11,7 +12,16 @@ type ClientOption struct {
 }
 
 type clientConfig struct {
-	Doer Doer
+	Doer           Doer
+	RateLimitWait  bool
+	Retry          *RetryPolicy
+	APIKey         bool
+	TokenSource    TokenSource
+	Middleware     []Middleware
+	Logger         *slog.Logger
+	LogBodyLevel   slog.Level
+	Cache          *Cache
+	UploadProgress ProgressFunc
 }
 
 func WithDoer(doer Doer) *ClientOption {
@@ -22,14 +32,88 @@ func WithDoer(doer Doer) *ClientOption {
 	}
 }
 
+func WithRateLimitWait() *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.RateLimitWait = true
+		},
+	}
+}
+
+func WithRetry(policy *RetryPolicy) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.Retry = policy
+		},
+	}
+}
+
+func WithAPIKey() *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.APIKey = true
+		},
+	}
+}
+
+func WithTokenSource(ts TokenSource) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.TokenSource = ts
+		},
+	}
+}
+
+func WithMiddleware(mws ...Middleware) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.Middleware = append(config.Middleware, mws...)
+		},
+	}
+}
+
+func WithLogger(logger *slog.Logger) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.Logger = logger
+		},
+	}
+}
+
+func WithLogBodyLevel(level slog.Level) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.LogBodyLevel = level
+		},
+	}
+}
+
+func WithCache(cache *Cache) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.Cache = cache
+		},
+	}
+}
+
+func WithUploadProgress(fn ProgressFunc) *ClientOption {
+	return &ClientOption{
+		set: func(config *clientConfig) {
+			config.UploadProgress = fn
+		},
+	}
+}
+
 type HttpRequestOption struct {
 	set func(config *httpRequestConfig)
 }
 
 type httpRequestConfig struct {
-	Header http.Header
-	Body   io.Reader
-	Query  url.Values
+	Header        http.Header
+	Body          io.Reader
+	GetBody       func() (io.ReadCloser, error)
+	ContentLength int64
+	Query         url.Values
 }
 
 func WithHeader(header http.Header) *HttpRequestOption {
@@ -48,6 +132,15 @@ func WithBody(body io.Reader) *HttpRequestOption {
 	}
 }
 
+func WithGetBody(getBody func() (io.ReadCloser, error), contentLength int64) *HttpRequestOption {
+	return &HttpRequestOption{
+		set: func(config *httpRequestConfig) {
+			config.GetBody = getBody
+			config.ContentLength = contentLength
+		},
+	}
+}
+
 func WithQuery(query url.Values) *HttpRequestOption {
 	return &HttpRequestOption{
 		set: func(config *httpRequestConfig) {
@@ -792,6 +792,32 @@ func TestDownloadResponse(t *testing.T) {
 			wantFilename:    "doc.txt",
 			wantContentType: "text/plain",
 		},
+		"rfc5987-filename": {
+			header: http.Header{
+				"Content-Disposition": []string{`attachment; filename="???.txt"; filename*=UTF-8''%E4%BB%95%E6%A7%98.txt`},
+				"Content-Type":        []string{"text/plain"},
+			},
+			wantFilename:    "仕様.txt",
+			wantContentType: "text/plain",
+		},
+		"rfc5987-filename-in-malformed-header": {
+			header: http.Header{
+				"Content-Disposition": []string{`attachment; filename=my file.txt; filename*=UTF-8''my%20file%20%E2%91%A0.txt`},
+			},
+			wantFilename: "my file ①.txt",
+		},
+		"rfc5987-iso-8859-1-filename": {
+			header: http.Header{
+				"Content-Disposition": []string{`attachment; filename=a b; filename*=iso-8859-1'en'caf%E9.txt`},
+			},
+			wantFilename: "café.txt",
+		},
+		"unquoted-filename-in-malformed-header": {
+			header: http.Header{
+				"Content-Disposition": []string{`attachment; filename=my file.txt`},
+			},
+			wantFilename: "my file.txt",
+		},
 		"missing-headers": {
 			header: http.Header{},
 		},
@@ -816,8 +842,9 @@ func TestDownloadResponse(t *testing.T) {
 			t.Parallel()
 
 			resp := &http.Response{
-				Header: tc.header,
-				Body:   io.NopCloser(bytes.NewReader([]byte("data"))),
+				Header:        tc.header,
+				Body:          io.NopCloser(bytes.NewReader([]byte("data"))),
+				ContentLength: 4,
 			}
 
 			got, err := client.DownloadResponse(resp)
@@ -826,6 +853,7 @@ func TestDownloadResponse(t *testing.T) {
 			require.NotNil(t, got)
 			assert.Equal(t, tc.wantFilename, got.Filename)
 			assert.Equal(t, tc.wantContentType, got.ContentType)
+			assert.Equal(t, int64(4), got.Size)
 			require.NotNil(t, got.Body)
 
 			data, err := io.ReadAll(got.Body)
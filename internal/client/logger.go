@@ -0,0 +1,204 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// redactedParams lists the query and form parameters whose values must never be logged.
+var redactedParams = []string{apiKeyParam, "password"}
+
+// Logger writes a structured record for every HTTP exchange with the Backlog API.
+//
+// Each record carries the operation name, method, path, query and form
+// parameter names, status, latency and the Backlog error codes of error
+// responses. Request and response bodies are logged only when BodyLevel is
+// enabled on the underlying handler, with secrets redacted.
+type Logger struct {
+	Logger *slog.Logger
+	// BodyLevel is the level at which headers and bodies are logged.
+	BodyLevel slog.Level
+}
+
+// NewLogger returns a Logger writing to l, or nil if l is nil.
+func NewLogger(l *slog.Logger, bodyLevel slog.Level) *Logger {
+	if l == nil {
+		return nil
+	}
+	return &Logger{Logger: l, BodyLevel: bodyLevel}
+}
+
+// logExchange records a single attempt of req. It reads and restores resp.Body
+// when the error codes or the body are needed. It is a no-op on a nil Logger.
+func (l *Logger) logExchange(req *http.Request, resp *http.Response, err error, attempt int, latency time.Duration) {
+	if l == nil {
+		return
+	}
+
+	ctx := req.Context()
+	level := slog.LevelInfo
+	if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	withBody := l.Logger.Enabled(ctx, l.BodyLevel)
+	if !l.Logger.Enabled(ctx, level) && !withBody {
+		return
+	}
+
+	attrs := make([]slog.Attr, 0, 10)
+	if op := Operation(ctx); op != "" {
+		attrs = append(attrs, slog.String("operation", op))
+	}
+	attrs = append(attrs,
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	)
+	if keys := paramKeys(req.URL.Query()); len(keys) > 0 {
+		attrs = append(attrs, slog.Any("query", keys))
+	}
+	form := requestForm(req)
+	if keys := paramKeys(form); len(keys) > 0 {
+		attrs = append(attrs, slog.Any("form", keys))
+	}
+	if attempt > 1 {
+		attrs = append(attrs, slog.Int("attempt", attempt))
+	}
+
+	var respBody []byte
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest || (withBody && isJSON(resp.Header)) {
+			respBody = peekBody(resp)
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			if codes := errorCodes(respBody); len(codes) > 0 {
+				attrs = append(attrs, slog.Any("error_codes", codes))
+			}
+		}
+	}
+	attrs = append(attrs, slog.Duration("latency", latency))
+
+	l.Logger.LogAttrs(ctx, level, "backlog: api request", attrs...)
+
+	if !withBody {
+		return
+	}
+	bodyAttrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Any("header", redactHeader(req.Header)),
+	}
+	if form != nil {
+		bodyAttrs = append(bodyAttrs, slog.String("request_body", redactValues(form).Encode()))
+	}
+	if respBody != nil {
+		bodyAttrs = append(bodyAttrs, slog.String("response_body", string(respBody)))
+	}
+	l.Logger.LogAttrs(ctx, l.BodyLevel, "backlog: api request body", bodyAttrs...)
+}
+
+// requestForm returns the url-encoded form sent with req, read from a copy of
+// the body so that req itself is left untouched.
+func requestForm(req *http.Request) url.Values {
+	if req.GetBody == nil || !hasMediaType(req.Header, "application/x-www-form-urlencoded") {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+	form, err := url.ParseQuery(string(b))
+	if err != nil {
+		return nil
+	}
+	return form
+}
+
+// peekBody reads resp.Body and replaces it with an equivalent unread body.
+func peekBody(resp *http.Response) []byte {
+	if resp.Body == nil {
+		return nil
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(b), errReader{err}))
+	return b
+}
+
+// errReader replays a read error that occurred while peeking a body.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	return 0, io.EOF
+}
+
+func errorCodes(body []byte) []int {
+	var e APIResponseError
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil
+	}
+	codes := make([]int, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		if err != nil {
+			codes = append(codes, err.Code)
+		}
+	}
+	return codes
+}
+
+// paramKeys returns the sorted parameter names of v.
+func paramKeys(v url.Values) []string {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func redactValues(v url.Values) url.Values {
+	out := make(url.Values, len(v))
+	for k, vs := range v {
+		if slices.Contains(redactedParams, k) {
+			out[k] = []string{redactedValue}
+			continue
+		}
+		out[k] = vs
+	}
+	return out
+}
+
+func redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	if out.Get("Authorization") != "" {
+		out.Set("Authorization", redactedValue)
+	}
+	return out
+}
+
+func isJSON(h http.Header) bool {
+	return hasMediaType(h, "application/json")
+}
+
+func hasMediaType(h http.Header, want string) bool {
+	mt, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	return err == nil && mt == want
+}
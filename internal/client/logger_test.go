@@ -0,0 +1,179 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+// newLoggedClient returns a client that logs JSON records into the returned buffer.
+func newLoggedClient(t *testing.T, level slog.Level, doFunc func(*http.Request) (*http.Response, error), opts ...*client.ClientOption) (*client.Client, *bytes.Buffer) {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: level}))
+	opts = append([]*client.ClientOption{
+		client.WithDoer(&mock.Doer{T: t, DoFunc: doFunc}),
+		client.WithLogger(logger),
+	}, opts...)
+
+	c, err := client.NewClient("https://example.com", "secret-token", opts...)
+	require.NoError(t, err)
+	return c, buf
+}
+
+func decodeRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var r map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &r))
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestNewClient_logger(t *testing.T) {
+	c, err := client.NewClient("https://example.com", "token")
+	require.NoError(t, err)
+	assert.Nil(t, c.Logger)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err = client.NewClient("https://example.com", "token", client.WithLogger(logger))
+	require.NoError(t, err)
+	assert.Equal(t, &client.Logger{Logger: logger, BodyLevel: slog.LevelDebug}, c.Logger)
+
+	c, err = client.NewClient("https://example.com", "token", client.WithLogger(logger), client.WithLogBodyLevel(slog.LevelInfo))
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelInfo, c.Logger.BodyLevel)
+}
+
+func TestLogger_success(t *testing.T) {
+	c, buf := newLoggedClient(t, slog.LevelInfo, func(_ *http.Request) (*http.Response, error) {
+		return mock.NewResponse(`{}`), nil
+	})
+
+	ctx := client.WithOperation(context.Background(), "Issue.List")
+	_, err := c.Get(ctx, "issues", url.Values{"projectId[]": {"1"}, "count": {"10"}})
+	require.NoError(t, err)
+
+	records := decodeRecords(t, buf)
+	require.Len(t, records, 1)
+	r := records[0]
+	assert.Equal(t, "INFO", r["level"])
+	assert.Equal(t, "Issue.List", r["operation"])
+	assert.Equal(t, "GET", r["method"])
+	assert.Equal(t, "/api/v2/issues", r["path"])
+	assert.Equal(t, []any{"count", "projectId[]"}, r["query"])
+	assert.Equal(t, float64(http.StatusOK), r["status"])
+	assert.Contains(t, r, "latency")
+	assert.NotContains(t, buf.String(), "secret-token")
+}
+
+func TestLogger_errorResponse(t *testing.T) {
+	c, buf := newLoggedClient(t, slog.LevelInfo, func(_ *http.Request) (*http.Response, error) {
+		return mock.NewNotFoundResponse(), nil
+	})
+
+	_, err := c.Post(context.Background(), "users", url.Values{"userId": {"u"}, "password": {"p@ssw0rd"}})
+
+	// The error body is still decoded after being logged.
+	var apiErr *client.APIResponseError
+	require.ErrorAs(t, err, &apiErr)
+	require.Len(t, apiErr.Errors, 1)
+	assert.Equal(t, 6, apiErr.Errors[0].Code)
+
+	records := decodeRecords(t, buf)
+	require.Len(t, records, 1)
+	r := records[0]
+	assert.Equal(t, "WARN", r["level"])
+	assert.Equal(t, []any{"password", "userId"}, r["form"])
+	assert.Equal(t, float64(http.StatusNotFound), r["status"])
+	assert.Equal(t, []any{float64(6)}, r["error_codes"])
+	assert.NotContains(t, buf.String(), "p@ssw0rd")
+}
+
+func TestLogger_transportError(t *testing.T) {
+	c, buf := newLoggedClient(t, slog.LevelInfo, func(req *http.Request) (*http.Response, error) {
+		return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("connection refused")}
+	}, client.WithAPIKey())
+
+	_, err := c.Get(context.Background(), "space", nil)
+	require.Error(t, err)
+
+	records := decodeRecords(t, buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Contains(t, records[0]["error"], "connection refused")
+	assert.NotContains(t, buf.String(), "secret-token")
+}
+
+func TestLogger_bodies(t *testing.T) {
+	c, buf := newLoggedClient(t, slog.LevelDebug, func(_ *http.Request) (*http.Response, error) {
+		resp := mock.NewResponse(`{"id":1}`)
+		resp.Header = http.Header{"Content-Type": {"application/json; charset=utf-8"}}
+		return resp, nil
+	}, client.WithAPIKey())
+
+	resp, err := c.Post(context.Background(), "users", url.Values{"userId": {"u"}, "password": {"p@ssw0rd"}})
+	require.NoError(t, err)
+
+	// The response body is left unread for the caller.
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1}`, string(body))
+
+	records := decodeRecords(t, buf)
+	require.Len(t, records, 2)
+	r := records[1]
+	assert.Equal(t, "DEBUG", r["level"])
+	assert.Equal(t, "password=REDACTED&userId=u", r["request_body"])
+	assert.Equal(t, `{"id":1}`, r["response_body"])
+	assert.Equal(t, []any{"apiKey"}, records[0]["query"])
+	assert.NotContains(t, buf.String(), "p@ssw0rd")
+	assert.NotContains(t, buf.String(), "secret-token")
+}
+
+func TestLogger_bodiesAuthorization(t *testing.T) {
+	c, buf := newLoggedClient(t, slog.LevelDebug, func(_ *http.Request) (*http.Response, error) {
+		return mock.NewResponse(`{}`), nil
+	})
+
+	_, err := c.Get(context.Background(), "space", nil)
+	require.NoError(t, err)
+
+	records := decodeRecords(t, buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, map[string]any{"Authorization": []any{"REDACTED"}}, records[1]["header"])
+	assert.NotContains(t, buf.String(), "secret-token")
+}
+
+func TestLogger_bodiesDisabled(t *testing.T) {
+	c, buf := newLoggedClient(t, slog.LevelInfo, func(_ *http.Request) (*http.Response, error) {
+		return mock.NewResponse(`{}`), nil
+	})
+
+	_, err := c.Post(context.Background(), "users", url.Values{"userId": {"u"}})
+	require.NoError(t, err)
+
+	records := decodeRecords(t, buf)
+	require.Len(t, records, 1)
+	assert.NotContains(t, records[0], "request_body")
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Handler performs an API request on behalf of the named service operation
+// and returns the checked response.
+type Handler func(op string, req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler to add cross-cutting behavior.
+type Middleware func(next Handler) Handler
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the service-level operation
+// name (e.g. "Issue.List"). If ctx already carries an operation name, ctx is
+// returned unchanged so that the outermost service method wins when one
+// service delegates to another.
+func WithOperation(ctx context.Context, op string) context.Context {
+	if Operation(ctx) != "" {
+		return ctx
+	}
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+// Operation returns the operation name carried by ctx, or "" if none.
+func Operation(ctx context.Context) string {
+	op, _ := ctx.Value(operationKey{}).(string)
+	return op
+}
+
+// chain wraps h with mws so that mws[0] is the outermost middleware.
+func chain(h Handler, mws []Middleware) Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		if mws[i] != nil {
+			h = mws[i](h)
+		}
+	}
+	return h
+}
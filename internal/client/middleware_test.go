@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestWithOperation(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, client.Operation(ctx))
+
+	ctx = client.WithOperation(ctx, "Issue.Star.Add")
+	assert.Equal(t, "Issue.Star.Add", client.Operation(ctx))
+
+	// The outermost operation name is kept.
+	ctx = client.WithOperation(ctx, "Star.Add")
+	assert.Equal(t, "Issue.Star.Add", client.Operation(ctx))
+}
+
+func TestClient_Do_middleware(t *testing.T) {
+	var calls []string
+	record := func(name string) client.Middleware {
+		return func(next client.Handler) client.Handler {
+			return func(op string, req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+":before:"+op)
+				resp, err := next(op, req)
+				calls = append(calls, name+":after")
+				return resp, err
+			}
+		}
+	}
+
+	c, err := client.NewClient("https://example.com", "token",
+		client.WithDoer(&mock.Doer{T: t, DoFunc: func(_ *http.Request) (*http.Response, error) {
+			calls = append(calls, "doer")
+			return mock.NewResponse(`{}`), nil
+		}}),
+		client.WithMiddleware(record("first"), nil),
+		client.WithMiddleware(record("second")),
+	)
+	require.NoError(t, err)
+
+	ctx := client.WithOperation(context.Background(), "Space.Info")
+	_, err = c.Get(ctx, "space", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"first:before:Space.Info",
+		"second:before:Space.Info",
+		"doer",
+		"second:after",
+		"first:after",
+	}, calls)
+}
+
+func TestClient_Do_middlewareSeesCheckedResponse(t *testing.T) {
+	var gotErr error
+	c, err := client.NewClient("https://example.com", "token",
+		client.WithDoer(&mock.Doer{T: t, DoFunc: func(_ *http.Request) (*http.Response, error) {
+			return mock.NewNotFoundResponse(), nil
+		}}),
+		client.WithMiddleware(func(next client.Handler) client.Handler {
+			return func(op string, req *http.Request) (*http.Response, error) {
+				resp, err := next(op, req)
+				gotErr = err
+				return resp, err
+			}
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "space", nil)
+	require.Error(t, err)
+
+	var apiErr *client.APIResponseError
+	require.ErrorAs(t, gotErr, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestClient_Do_middlewareShortCircuit(t *testing.T) {
+	want := errors.New("blocked")
+	c, err := client.NewClient("https://example.com", "token",
+		client.WithDoer(&mock.Doer{T: t, DoFunc: mock.NewUnexpectedDoFunc(t)}),
+		client.WithMiddleware(func(_ client.Handler) client.Handler {
+			return func(_ string, _ *http.Request) (*http.Response, error) {
+				return nil, want
+			}
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "space", nil)
+	assert.ErrorIs(t, err, want)
+}
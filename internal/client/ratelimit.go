@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limit response headers returned by the Backlog API.
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RateLimit represents the rate limit state reported by the Backlog API
+// in the X-RateLimit-* response headers.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// ParseRateLimit extracts a RateLimit from the given response headers.
+// It returns nil when the headers are missing or malformed.
+func ParseRateLimit(h http.Header) *RateLimit {
+	limit, err := strconv.Atoi(h.Get(headerRateLimitLimit))
+	if err != nil {
+		return nil
+	}
+	remaining, err := strconv.Atoi(h.Get(headerRateLimitRemaining))
+	if err != nil {
+		return nil
+	}
+	reset, err := strconv.ParseInt(h.Get(headerRateLimitReset), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}
+}
+
+// RateLimiter keeps the most recent RateLimit observed by a Client.
+// It is safe for concurrent use.
+//
+// When Wait is true, Reserve counts each request against the remaining budget
+// and blocks once it is exhausted until the reset time has passed. Requests
+// are counted separately from the observed RateLimit, which always holds the
+// values reported by the server.
+type RateLimiter struct {
+	Wait bool
+	Now  func() time.Time
+
+	mu   sync.Mutex
+	last *RateLimit
+	// reserved is the number of requests reserved since last was observed,
+	// or since its reset time passed if rolled is set.
+	reserved int
+	// rolled reports whether reserved counts requests of the window after
+	// last, whose budget is assumed to be last.Limit.
+	rolled bool
+	// updated is closed when a new RateLimit is observed.
+	updated chan struct{}
+}
+
+// NewRateLimiter returns a RateLimiter using the wall clock.
+func NewRateLimiter(wait bool) *RateLimiter {
+	return &RateLimiter{
+		Wait: wait,
+		Now:  time.Now,
+	}
+}
+
+// Snapshot returns a copy of the most recent RateLimit, or nil if none has
+// been observed yet.
+func (l *RateLimiter) Snapshot() *RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.last == nil {
+		return nil
+	}
+	rl := *l.last
+	return &rl
+}
+
+// Remaining returns the number of requests left in the current window, less
+// the requests reserved since it was observed, or -1 if no rate limit has
+// been observed yet or the window has already been reset.
+func (l *RateLimiter) Remaining() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.last == nil || !l.last.Reset.After(l.Now()) {
+		return -1
+	}
+	return max(l.last.Remaining-l.reserved, 0)
+}
+
+// Update records the rate limit reported in the given response headers.
+// Headers without rate limit information are ignored.
+func (l *RateLimiter) Update(h http.Header) {
+	rl := ParseRateLimit(h)
+	if rl == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.last = rl
+	l.reserved = 0
+	l.rolled = false
+	if l.updated != nil {
+		close(l.updated)
+		l.updated = nil
+	}
+}
+
+// Reserve consumes one request from the remaining budget.
+// It is a no-op unless Wait is enabled. When the budget is exhausted, it blocks
+// until the reset time or until ctx is done, in which case the context error
+// is returned.
+//
+// Once the reset time has passed and no response has reported the new window
+// yet, requests are counted against Limit. Callers blocked on the old window
+// are then let through one by one against that budget, and any beyond it
+// wait for a response to report the new window.
+func (l *RateLimiter) Reserve(ctx context.Context) error {
+	if !l.Wait {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		rl := l.last
+		if rl == nil {
+			l.mu.Unlock()
+			return nil
+		}
+
+		budget := rl.Remaining
+		d := rl.Reset.Sub(l.Now())
+		if d <= 0 {
+			if !l.rolled {
+				l.rolled = true
+				l.reserved = 0
+			}
+			budget = rl.Limit
+		}
+		if l.reserved < budget {
+			l.reserved++
+			l.mu.Unlock()
+			return nil
+		}
+		if l.updated == nil {
+			l.updated = make(chan struct{})
+		}
+		updated := l.updated
+		l.mu.Unlock()
+
+		if err := waitUpdate(ctx, updated, d); err != nil {
+			return err
+		}
+	}
+}
+
+// waitUpdate blocks until updated is closed, d has elapsed or ctx is done,
+// in which case the context error is returned. A non-positive d waits for
+// updated or ctx only.
+func waitUpdate(ctx context.Context, updated <-chan struct{}, d time.Duration) error {
+	var timeout <-chan time.Time
+	if d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-updated:
+		return nil
+	case <-timeout:
+		return nil
+	}
+}
@@ -0,0 +1,280 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func newRateLimitHeader(limit, remaining, reset string) http.Header {
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", limit)
+	h.Set("X-RateLimit-Remaining", remaining)
+	h.Set("X-RateLimit-Reset", reset)
+	return h
+}
+
+func TestParseRateLimit(t *testing.T) {
+	cases := map[string]struct {
+		header http.Header
+		want   *client.RateLimit
+	}{
+		"valid": {
+			header: newRateLimitHeader("150", "149", "1700000000"),
+			want:   &client.RateLimit{Limit: 150, Remaining: 149, Reset: time.Unix(1700000000, 0)},
+		},
+		"nil-header": {
+			header: nil,
+		},
+		"missing-headers": {
+			header: http.Header{},
+		},
+		"invalid-limit": {
+			header: newRateLimitHeader("x", "149", "1700000000"),
+		},
+		"invalid-remaining": {
+			header: newRateLimitHeader("150", "x", "1700000000"),
+		},
+		"invalid-reset": {
+			header: newRateLimitHeader("150", "149", "x"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := client.ParseRateLimit(tc.header)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRateLimiter_Update(t *testing.T) {
+	l := client.NewRateLimiter(false)
+	assert.Nil(t, l.Snapshot())
+
+	l.Update(newRateLimitHeader("150", "10", "1700000000"))
+	got := l.Snapshot()
+	require.NotNil(t, got)
+	assert.Equal(t, 150, got.Limit)
+	assert.Equal(t, 10, got.Remaining)
+
+	// Headers without rate limit information keep the previous snapshot.
+	l.Update(http.Header{})
+	assert.Equal(t, got, l.Snapshot())
+
+	// Snapshot returns a copy.
+	got.Remaining = 0
+	assert.Equal(t, 10, l.Snapshot().Remaining)
+}
+
+func TestRateLimiter_Remaining(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	l := client.NewRateLimiter(false)
+	l.Now = func() time.Time { return now }
+	assert.Equal(t, -1, l.Remaining(), "unknown before any response")
+
+	l.Update(newRateLimitHeader("150", "10", "1700000001"))
+	assert.Equal(t, 10, l.Remaining())
+
+	l.Now = func() time.Time { return now.Add(time.Second) }
+	assert.Equal(t, -1, l.Remaining(), "unknown once the window has been reset")
+}
+
+// clockFrom returns a clock that starts at start and advances in real time.
+func clockFrom(start time.Time) func() time.Time {
+	base := time.Now()
+	return func() time.Time { return start.Add(time.Since(base)) }
+}
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := now.Add(time.Second)
+
+	t.Run("wait-disabled", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(false)
+		l.Now = func() time.Time { return now }
+		l.Update(newRateLimitHeader("150", "0", "1700000001"))
+
+		require.NoError(t, l.Reserve(context.Background()))
+		assert.Equal(t, 0, l.Snapshot().Remaining)
+	})
+
+	t.Run("no-snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		assert.NoError(t, l.Reserve(context.Background()))
+	})
+
+	t.Run("remaining-budget", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		l.Now = func() time.Time { return now }
+		l.Update(newRateLimitHeader("150", "2", "1700000001"))
+
+		require.NoError(t, l.Reserve(context.Background()))
+		assert.Equal(t, 1, l.Remaining())
+		assert.Equal(t, 2, l.Snapshot().Remaining, "the snapshot keeps the reported value")
+
+		l.Update(newRateLimitHeader("150", "5", "1700000001"))
+		assert.Equal(t, 5, l.Remaining())
+	})
+
+	t.Run("reset-passed", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		l.Now = func() time.Time { return reset.Add(time.Second) }
+		l.Update(newRateLimitHeader("150", "0", "1700000001"))
+
+		require.NoError(t, l.Reserve(context.Background()))
+		require.NotNil(t, l.Snapshot(), "the last snapshot is kept after the reset")
+		assert.Equal(t, 0, l.Snapshot().Remaining)
+	})
+
+	t.Run("exhausted-waits-until-reset", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		l.Now = clockFrom(reset.Add(-20 * time.Millisecond))
+		l.Update(newRateLimitHeader("150", "0", "1700000001"))
+
+		start := time.Now()
+		require.NoError(t, l.Reserve(context.Background()))
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+		assert.NotNil(t, l.Snapshot(), "the last snapshot is kept after the reset")
+	})
+
+	t.Run("exhausted-concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		l.Now = clockFrom(reset.Add(-20 * time.Millisecond))
+		l.Update(newRateLimitHeader("150", "0", "1700000001"))
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, l.Reserve(context.Background()))
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("exhausted-released-against-limit", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		l.Now = clockFrom(reset.Add(-20 * time.Millisecond))
+		l.Update(newRateLimitHeader("2", "0", "1700000001"))
+
+		var passed atomic.Int32
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, l.Reserve(context.Background()))
+				passed.Add(1)
+			}()
+		}
+
+		// Only Limit callers pass once the reset time has passed; the others
+		// wait for a response to report the new window.
+		assert.Eventually(t, func() bool { return passed.Load() == 2 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, int32(2), passed.Load())
+
+		l.Update(newRateLimitHeader("150", "150", "1700000061"))
+		wg.Wait()
+		assert.Equal(t, int32(5), passed.Load())
+	})
+
+	t.Run("context-canceled", func(t *testing.T) {
+		t.Parallel()
+
+		l := client.NewRateLimiter(true)
+		l.Now = func() time.Time { return now }
+		l.Update(newRateLimitHeader("150", "0", "1700000001"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.ErrorIs(t, l.Reserve(ctx), context.Canceled)
+	})
+}
+
+func TestClient_Do_rateLimit(t *testing.T) {
+	header := newRateLimitHeader("150", "0", "1700000000")
+
+	t.Run("success-updates-snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		c := mock.NewClient(t, func(_ *http.Request) (*http.Response, error) {
+			resp := mock.NewResponse(`{}`)
+			resp.Header = header
+			return resp, nil
+		})
+
+		_, err := c.Do(context.Background(), http.MethodGet, "test")
+		require.NoError(t, err)
+
+		got := c.RateLimiter.Snapshot()
+		require.NotNil(t, got)
+		assert.Equal(t, 150, got.Limit)
+		assert.Equal(t, 0, got.Remaining)
+	})
+
+	t.Run("error-response-carries-rate-limit", func(t *testing.T) {
+		t.Parallel()
+
+		c := mock.NewClient(t, func(_ *http.Request) (*http.Response, error) {
+			resp := mock.NewErrorResponse(http.StatusTooManyRequests, `{"errors":[]}`)
+			resp.Header = header
+			return resp, nil
+		})
+
+		_, err := c.Do(context.Background(), http.MethodGet, "test")
+		require.Error(t, err)
+
+		var apiErr *client.APIResponseError
+		require.ErrorAs(t, err, &apiErr)
+		require.NotNil(t, apiErr.RateLimit)
+		assert.Equal(t, 150, apiErr.RateLimit.Limit)
+	})
+
+	t.Run("wait-aborted-by-context", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := client.NewClient("https://example.com", "token",
+			client.WithRateLimitWait(),
+			client.WithDoer(&mock.Doer{T: t, DoFunc: mock.NewUnexpectedDoFunc(t)}),
+		)
+		require.NoError(t, err)
+		c.RateLimiter.Now = func() time.Time { return time.Unix(1699999000, 0) }
+		c.RateLimiter.Update(header)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = c.Do(ctx, http.MethodGet, "test")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
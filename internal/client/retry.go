@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// RetryPolicy configures automatic retries of transient failures in Client.Do.
+//
+// A request is retried when the Doer returns an error or when the response
+// status is 429, 502, 503 or 504. Only GET, HEAD and OPTIONS requests are
+// retried unless RetryAllMethods is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+	// BaseDelay is the backoff delay before the first retry. It doubles after
+	// each attempt. Zero means 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay. Zero means 30s.
+	MaxDelay time.Duration
+	// RetryAllMethods enables retries of non-idempotent methods
+	// (POST, PATCH, PUT and DELETE).
+	RetryAllMethods bool
+	// Jitter returns a randomized delay in [0, d]. If nil, full jitter is used.
+	Jitter func(d time.Duration) time.Duration
+}
+
+// Backoff returns the delay to wait before the given retry attempt (1-based),
+// using exponential backoff with jitter.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+
+	d := maxDelay
+	if shift := attempt - 1; shift < 32 {
+		if exp := base << shift; exp > 0 && exp < maxDelay {
+			d = exp
+		}
+	}
+
+	if p.Jitter != nil {
+		return p.Jitter(d)
+	}
+	return rand.N(d + 1)
+}
+
+// shouldRetry reports whether the result of the given attempt may be retried.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if !p.RetryAllMethods && !isIdempotent(req.Method) {
+		return false
+	}
+	if !canReplay(req) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return isRetryableStatus(resp.StatusCode)
+}
+
+// delay returns how long to wait before the next attempt.
+// Retry-After takes precedence; a 429 without it waits for the rate limit reset.
+func (p *RetryPolicy) delay(resp *http.Response, attempt int, now time.Time) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return d
+		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if rl := ParseRateLimit(resp.Header); rl != nil {
+				if d := rl.Reset.Sub(now); d > 0 {
+					return d
+				}
+			}
+		}
+	}
+	return p.Backoff(attempt)
+}
+
+// canReplay reports whether the body of req can be sent again.
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, "":
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// rewindRequest returns a copy of req with a fresh body for the next attempt.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+// discardResponse drains and closes the body so the connection can be reused.
+func discardResponse(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
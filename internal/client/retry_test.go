@@ -0,0 +1,285 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+// newRetryClient returns a client that retries according to policy and whose
+// Doer answers with the responses returned by doFunc.
+func newRetryClient(t *testing.T, policy *client.RetryPolicy, doFunc func(*http.Request) (*http.Response, error)) *client.Client {
+	t.Helper()
+
+	c, err := client.NewClient("https://example.com", "token",
+		client.WithRetry(policy),
+		client.WithDoer(&mock.Doer{T: t, DoFunc: doFunc}),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func noJitter(d time.Duration) time.Duration { return d }
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := &client.RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+		Jitter:    noJitter,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.Backoff(2))
+	assert.Equal(t, 400*time.Millisecond, p.Backoff(3))
+	assert.Equal(t, 800*time.Millisecond, p.Backoff(4))
+	assert.Equal(t, time.Second, p.Backoff(5))
+	assert.Equal(t, time.Second, p.Backoff(100))
+
+	t.Run("defaults", func(t *testing.T) {
+		p := &client.RetryPolicy{Jitter: noJitter}
+		assert.Equal(t, 500*time.Millisecond, p.Backoff(1))
+		assert.Equal(t, 30*time.Second, p.Backoff(100))
+	})
+
+	t.Run("full-jitter", func(t *testing.T) {
+		p := &client.RetryPolicy{BaseDelay: 100 * time.Millisecond}
+		for range 20 {
+			d := p.Backoff(1)
+			assert.GreaterOrEqual(t, d, time.Duration(0))
+			assert.LessOrEqual(t, d, 100*time.Millisecond)
+		}
+	})
+}
+
+func TestClient_Do_retry(t *testing.T) {
+	policy := &client.RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		Jitter:      noJitter,
+	}
+
+	cases := map[string]struct {
+		policy    *client.RetryPolicy
+		call      func(c *client.Client) (*http.Response, error)
+		responses []func() (*http.Response, error)
+
+		wantCalls  int
+		wantErr    bool
+		wantStatus int
+	}{
+		"get-succeeds-after-503": {
+			policy: policy,
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil },
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusBadGateway, `{}`), nil },
+				func() (*http.Response, error) { return mock.NewResponse(`{}`), nil },
+			},
+			wantCalls: 3,
+		},
+		"get-succeeds-after-network-error": {
+			policy: policy,
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return nil, errors.New("connection reset") },
+				func() (*http.Response, error) { return mock.NewResponse(`{}`), nil },
+			},
+			wantCalls: 2,
+		},
+		"get-exhausts-attempts": {
+			policy: policy,
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusTooManyRequests, `{}`), nil },
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusTooManyRequests, `{}`), nil },
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusTooManyRequests, `{}`), nil },
+			},
+			wantCalls:  3,
+			wantErr:    true,
+			wantStatus: http.StatusTooManyRequests,
+		},
+		"get-non-retryable-status": {
+			policy: policy,
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewNotFoundResponse(), nil },
+			},
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusNotFound,
+		},
+		"post-not-retried-by-default": {
+			policy: policy,
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Post(context.Background(), "test", url.Values{"k": {"v"}})
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil },
+			},
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		"post-retried-when-enabled": {
+			policy: &client.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, RetryAllMethods: true, Jitter: noJitter},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Post(context.Background(), "test", url.Values{"k": {"v"}})
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil },
+				func() (*http.Response, error) { return mock.NewResponse(`{}`), nil },
+			},
+			wantCalls: 2,
+		},
+		"upload-retried-when-enabled": {
+			policy: &client.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, RetryAllMethods: true, Jitter: noJitter},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Upload(context.Background(), "test", "file.txt", bytes.NewReader([]byte("data")))
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil },
+				func() (*http.Response, error) { return mock.NewResponse(`{}`), nil },
+			},
+			wantCalls: 2,
+		},
+		"upload-non-seekable-not-retried": {
+			policy: &client.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, RetryAllMethods: true, Jitter: noJitter},
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Upload(context.Background(), "test", "file.txt", bytes.NewBufferString("data"))
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil },
+			},
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		"no-policy": {
+			call: func(c *client.Client) (*http.Response, error) {
+				return c.Get(context.Background(), "test", nil)
+			},
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil },
+			},
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			var bodies []string
+			c := newRetryClient(t, tc.policy, func(req *http.Request) (*http.Response, error) {
+				if req.Body != nil {
+					b, _ := io.ReadAll(req.Body)
+					bodies = append(bodies, string(b))
+				}
+				n := atomic.AddInt32(&calls, 1)
+				require.LessOrEqual(t, int(n), len(tc.responses), "unexpected extra attempt")
+				return tc.responses[n-1]()
+			})
+
+			resp, err := tc.call(c)
+			assert.Equal(t, tc.wantCalls, int(calls))
+
+			// Replayed attempts must send the same body.
+			for _, b := range bodies {
+				assert.Equal(t, bodies[0], b)
+			}
+
+			if tc.wantErr {
+				require.Error(t, err)
+				var apiErr *client.APIResponseError
+				if tc.wantStatus != 0 {
+					require.ErrorAs(t, err, &apiErr)
+					assert.Equal(t, tc.wantStatus, apiErr.StatusCode)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestClient_Do_retryAfter(t *testing.T) {
+	cases := map[string]struct {
+		retryAfter string
+		wantMin    time.Duration
+	}{
+		"seconds": {
+			retryAfter: "1",
+			wantMin:    time.Second,
+		},
+		"http-date-in-past": {
+			retryAfter: "Mon, 02 Jan 2006 15:04:05 GMT",
+		},
+		"invalid-falls-back-to-backoff": {
+			retryAfter: "soon",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			c := newRetryClient(t, &client.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, Jitter: noJitter},
+				func(_ *http.Request) (*http.Response, error) {
+					if atomic.AddInt32(&calls, 1) == 1 {
+						resp := mock.NewErrorResponse(http.StatusTooManyRequests, `{}`)
+						resp.Header = http.Header{"Retry-After": {tc.retryAfter}}
+						return resp, nil
+					}
+					return mock.NewResponse(`{}`), nil
+				})
+
+			start := time.Now()
+			_, err := c.Get(context.Background(), "test", nil)
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), calls)
+			assert.GreaterOrEqual(t, time.Since(start), tc.wantMin)
+		})
+	}
+}
+
+func TestClient_Do_retryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls int32
+	c := newRetryClient(t, &client.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour, Jitter: noJitter},
+		func(_ *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			time.AfterFunc(10*time.Millisecond, cancel)
+			return mock.NewErrorResponse(http.StatusServiceUnavailable, `{}`), nil
+		})
+
+	_, err := c.Get(ctx, "test", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), calls)
+}
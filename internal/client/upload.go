@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// ProgressFunc is called while an upload is sent. fileName is the name of the
+// uploaded file, sent is the number of file bytes sent so far and total is the
+// file size, or -1 if it is unknown. When a request is retried, sent starts
+// again from zero.
+type ProgressFunc func(fileName string, sent, total int64)
+
+// Upload sends r as the "file" part of a multipart/form-data POST request.
+//
+// The file is streamed rather than buffered in memory. If r implements
+// io.Seeker, the request has a known Content-Length and can be replayed for
+// retries by seeking back to the current offset. Otherwise the body is
+// produced through an io.Pipe, sent with chunked encoding, and cannot be
+// retried.
+func (c *Client) Upload(ctx context.Context, spath, fileName string, r io.Reader) (*http.Response, error) {
+	if fileName == "" {
+		return nil, NewInternalClientError("fileName is required")
+	}
+
+	// The multipart framing before and after the file content is small and
+	// rendered up front; only the file itself is streamed.
+	var head, tail bytes.Buffer
+	sw := &switchWriter{w: &head}
+	mw := c.Wrapper.NewMultipartWriter(sw)
+	if _, err := mw.CreateFormFile("file", fileName); err != nil {
+		return nil, err
+	}
+	sw.w = &tail
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", mw.FormDataContentType())
+	progress := c.uploadProgress(fileName)
+
+	if rs, ok := r.(io.ReadSeeker); ok {
+		if start, size, err := seekableSize(rs); err == nil {
+			getBody := func() (io.ReadCloser, error) {
+				if _, err := rs.Seek(start, io.SeekStart); err != nil {
+					return nil, err
+				}
+				return io.NopCloser(io.MultiReader(
+					bytes.NewReader(head.Bytes()),
+					newProgressReader(rs, size, progress),
+					bytes.NewReader(tail.Bytes()),
+				)), nil
+			}
+			length := int64(head.Len()) + size + int64(tail.Len())
+			return c.Do(ctx, http.MethodPost, spath, WithHeader(header), WithGetBody(getBody, length))
+		}
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := c.Wrapper.Copy(pw, newProgressReader(r, -1, progress))
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	body := io.MultiReader(bytes.NewReader(head.Bytes()), pr, bytes.NewReader(tail.Bytes()))
+	resp, err := c.Do(ctx, http.MethodPost, spath, WithHeader(header), WithBody(body))
+
+	// Unblock the copy if the body was not fully read, then surface a copy
+	// failure that the Doer did not report.
+	pr.Close()
+	if copyErr := <-done; copyErr != nil && !errors.Is(copyErr, io.ErrClosedPipe) && err == nil {
+		discardResponse(resp)
+		return nil, copyErr
+	}
+	return resp, err
+}
+
+// uploadProgress returns the function reporting the progress of uploading
+// fileName to UploadProgress, or nil if UploadProgress is not set.
+func (c *Client) uploadProgress(fileName string) func(sent, total int64) {
+	if c.UploadProgress == nil {
+		return nil
+	}
+	return func(sent, total int64) {
+		c.UploadProgress(fileName, sent, total)
+	}
+}
+
+// seekableSize returns the current offset of rs and the number of bytes from
+// there to the end, restoring the offset.
+func seekableSize(rs io.Seeker) (start, size int64, err error) {
+	start, err = rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := rs.Seek(start, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+	return start, end - start, nil
+}
+
+// switchWriter forwards writes to w, which can be swapped between writes.
+type switchWriter struct {
+	w io.Writer
+}
+
+func (s *switchWriter) Write(p []byte) (int, error) {
+	return s.w.Write(p)
+}
+
+// progressReader reports the number of bytes read from r.
+type progressReader struct {
+	r     io.Reader
+	total int64
+	sent  int64
+	fn    func(sent, total int64)
+}
+
+func newProgressReader(r io.Reader, total int64, fn func(sent, total int64)) io.Reader {
+	if fn == nil {
+		return r
+	}
+	return &progressReader{r: r, total: total, fn: fn}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.fn(p.sent, p.total)
+	}
+	return n, err
+}
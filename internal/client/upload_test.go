@@ -0,0 +1,151 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+// readUploadedFile parses the multipart body of req and returns the file part.
+func readUploadedFile(t *testing.T, req *http.Request) (string, string) {
+	t.Helper()
+
+	ct := req.Header.Get("Content-Type")
+	require.True(t, strings.HasPrefix(ct, "multipart/form-data; boundary="))
+	mr := multipart.NewReader(req.Body, strings.TrimPrefix(ct, "multipart/form-data; boundary="))
+
+	part, err := mr.NextPart()
+	require.NoError(t, err)
+	data, err := io.ReadAll(part)
+	require.NoError(t, err)
+
+	_, err = mr.NextPart()
+	assert.Equal(t, io.EOF, err)
+	return part.FileName(), string(data)
+}
+
+func TestClient_Upload_streaming(t *testing.T) {
+	cases := map[string]struct {
+		reader            func() io.Reader
+		wantData          string
+		wantContentLength bool
+		wantReplayable    bool
+	}{
+		"seekable": {
+			reader:            func() io.Reader { return strings.NewReader("file data") },
+			wantData:          "file data",
+			wantContentLength: true,
+			wantReplayable:    true,
+		},
+		"seekable-from-offset": {
+			reader: func() io.Reader {
+				r := strings.NewReader("skip:file data")
+				_, _ = r.Seek(5, io.SeekStart)
+				return r
+			},
+			wantData:          "file data",
+			wantContentLength: true,
+			wantReplayable:    true,
+		},
+		"non-seekable": {
+			reader:   func() io.Reader { return bytes.NewBufferString("file data") },
+			wantData: "file data",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := mock.NewClient(t, func(req *http.Request) (*http.Response, error) {
+				if tc.wantContentLength {
+					b, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.Equal(t, int64(len(b)), req.ContentLength)
+					req.Body = io.NopCloser(bytes.NewReader(b))
+				} else {
+					assert.Zero(t, req.ContentLength)
+				}
+				assert.Equal(t, tc.wantReplayable, req.GetBody != nil)
+
+				fileName, data := readUploadedFile(t, req)
+				assert.Equal(t, "file.txt", fileName)
+				assert.Equal(t, tc.wantData, data)
+				return mock.NewResponse(`{}`), nil
+			})
+
+			resp, err := c.Upload(context.Background(), "space/attachment", "file.txt", tc.reader())
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
+
+func TestClient_Upload_progress(t *testing.T) {
+	cases := map[string]struct {
+		reader    io.Reader
+		wantTotal int64
+	}{
+		"seekable":     {reader: strings.NewReader("0123456789"), wantTotal: 10},
+		"non-seekable": {reader: bytes.NewBufferString("0123456789"), wantTotal: -1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var name string
+			var sent, total int64
+			c := mock.NewClient(t, func(req *http.Request) (*http.Response, error) {
+				_, _ = io.Copy(io.Discard, req.Body)
+				return mock.NewResponse(`{}`), nil
+			})
+			c.UploadProgress = func(fileName string, s, tt int64) {
+				mu.Lock()
+				defer mu.Unlock()
+				name, sent, total = fileName, s, tt
+			}
+
+			_, err := c.Upload(context.Background(), "space/attachment", "file.txt", tc.reader)
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, "file.txt", name)
+			assert.Equal(t, int64(10), sent)
+			assert.Equal(t, tc.wantTotal, total)
+		})
+	}
+}
+
+func TestClient_Upload_copyError(t *testing.T) {
+	readErr := errors.New("read error")
+	c := mock.NewClient(t, func(req *http.Request) (*http.Response, error) {
+		if _, err := io.Copy(io.Discard, req.Body); err != nil {
+			return nil, err
+		}
+		return mock.NewResponse(`{}`), nil
+	})
+
+	_, err := c.Upload(context.Background(), "space/attachment", "file.txt", io.MultiReader(
+		strings.NewReader("partial"),
+		&errorReader{err: readErr},
+	))
+	assert.ErrorIs(t, err, readErr)
+}
+
+type errorReader struct{ err error }
+
+func (r *errorReader) Read([]byte) (int, error) { return 0, r.err }
@@ -2,6 +2,7 @@ package issue
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -37,6 +38,25 @@ func (s *CommentService) List(ctx context.Context, issueIDOrKey string, opts ...
 	return s.base.FetchList(ctx, spath, query)
 }
 
+// All returns an iterator that lazily fetches all comments on an issue with
+// cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-comment-list
+func (s *CommentService) All(ctx context.Context, perPage int, issueIDOrKey string, opts ...*option.APIParamOption) (iter.Seq2[*model.Comment, error], error) {
+	query := url.Values{}
+
+	var ves validation.Errors
+	if ve := validate.ValidateIssueIDOrKey(issueIDOrKey); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, s.base.ApplyAllOptions(query, perPage, opts...)); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("issues", issueIDOrKey, "comments")
+	return s.base.FetchAll(ctx, spath, perPage, query), nil
+}
+
 // Add adds a comment to an issue.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-comment
@@ -475,3 +475,62 @@ func TestCommentService_Notify(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentService_All(t *testing.T) {
+	ctx := context.Background()
+	o := &option.OptionService{}
+
+	t.Run("multi-page", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			calls++
+			assert.Equal(t, "issues/PRJ-1/comments", spath)
+			assert.Equal(t, "2", query.Get("count"))
+			switch calls {
+			case 1:
+				assert.Empty(t, query.Get("maxId"))
+				return mock.NewResponse(`[{"id": 3}, {"id": 2}]`), nil
+			case 2:
+				assert.Equal(t, "2", query.Get("maxId"))
+				return mock.NewResponse(`[{"id": 1}]`), nil
+			default:
+				t.Errorf("unexpected request #%d", calls)
+				return nil, nil
+			}
+		}
+
+		s := issue.NewCommentService(method)
+		seq, err := s.All(ctx, 2, "PRJ-1")
+		require.NoError(t, err)
+
+		var got []int
+		for v, err := range seq {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{3, 2, 1}, got)
+	})
+
+	t.Run("error-invalid-argument", func(t *testing.T) {
+		t.Parallel()
+
+		s := issue.NewCommentService(mock.NewMethod(t))
+		_, err := s.All(ctx, 0, "")
+		var ves validation.Errors
+		require.ErrorAs(t, err, &ves)
+		assert.Len(t, ves, 2)
+	})
+
+	t.Run("error-cursor-passed-to-all", func(t *testing.T) {
+		t.Parallel()
+
+		s := issue.NewCommentService(mock.NewMethod(t))
+		_, err := s.All(ctx, 10, "PRJ-1", o.WithMinID(5))
+		var target *option.InvalidOptionKeyError
+		assert.ErrorAs(t, err, &target)
+	})
+}
@@ -17,6 +17,9 @@ import (
 	"github.com/nattokin/go-backlog/internal/validation"
 )
 
+// MaxPrefetch is the maximum number of pages AllPrefetch fetches in parallel.
+const MaxPrefetch = 10
+
 var countValidTypes = []option.APIParamOptionType{
 	option.ParamProjectIDs,
 	option.ParamIssueTypeIDs,
@@ -131,6 +134,50 @@ func (s *Service) All(ctx context.Context, perPage int, opts ...*option.APIParam
 	}), nil
 }
 
+// AllPrefetch returns an iterator like All that fetches up to prefetch pages
+// ahead in parallel. The number of pages is determined with Count before the
+// first page is requested.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
+func (s *Service) AllPrefetch(ctx context.Context, perPage, prefetch int, opts ...*option.APIParamOption) (iter.Seq2[*model.Issue, error], error) {
+	o := &option.OptionService{}
+	countOpt := o.WithCount(perPage)
+
+	var ves validation.Errors
+	if ve := countOpt.Check(); ve != nil {
+		ves = append(ves, ve)
+	}
+	if ve := validate.ValidateIntRange("prefetch", prefetch, 1, MaxPrefetch); ve != nil {
+		ves = append(ves, ve)
+	}
+	if len(ves) > 0 {
+		return nil, ves
+	}
+
+	baseQuery := url.Values{}
+	if err := option.ApplyOptions(baseQuery, filterValidTypes, opts...); err != nil {
+		return nil, err
+	}
+
+	// The count endpoint accepts the filters but not the sort parameters.
+	countQuery := maps.Clone(baseQuery)
+	countQuery.Del(option.ParamSort.Value())
+	countQuery.Del(option.ParamOrder.Value())
+
+	countOpt.Set(baseQuery)
+
+	return pagination.Prefetch(ctx, perPage, prefetch, s.method.RateLimitRemaining,
+		func(ctx context.Context) (int, error) {
+			return s.count(ctx, countQuery)
+		},
+		func(ctx context.Context, offset int) ([]*model.Issue, error) {
+			q := maps.Clone(baseQuery)
+			q.Set(option.ParamOffset.Value(), strconv.Itoa(offset))
+			return s.list(ctx, q)
+		},
+	), nil
+}
+
 // Count returns the total count of issues matching the given filters.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-issue
@@ -140,6 +187,10 @@ func (s *Service) Count(ctx context.Context, opts ...*option.APIParamOption) (in
 		return 0, err
 	}
 
+	return s.count(ctx, query)
+}
+
+func (s *Service) count(ctx context.Context, query url.Values) (int, error) {
 	resp, err := s.method.Get(ctx, "issues/count", query)
 	if err != nil {
 		return 0, err
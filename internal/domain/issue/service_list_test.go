@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"sync/atomic"
 	"testing"
 
@@ -515,3 +516,138 @@ func TestService_All(t *testing.T) {
 		assert.ErrorAs(t, err, &target)
 	})
 }
+
+func TestService_AllPrefetch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var offsets []string
+		o := &option.OptionService{}
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			assert.Equal(t, "bug", query.Get("keyword"))
+			if spath == "issues/count" {
+				assert.False(t, query.Has("sort"), "count must not receive sort")
+				assert.False(t, query.Has("order"), "count must not receive order")
+				assert.False(t, query.Has("count"))
+				return mock.NewResponse(`{"count":3}`), nil
+			}
+
+			assert.Equal(t, "issues", spath)
+			assert.Equal(t, "created", query.Get("sort"))
+			assert.Equal(t, "asc", query.Get("order"))
+			assert.Equal(t, "2", query.Get("count"))
+			mu.Lock()
+			offsets = append(offsets, query.Get("offset"))
+			mu.Unlock()
+
+			body := fixture.Issue.ListJSON
+			if query.Get("offset") == "2" {
+				body = issueLastPageJSON
+			}
+			return mock.NewResponse(body), nil
+		}
+
+		s := issue.NewService(method)
+		seq, err := s.AllPrefetch(ctx, 2, 2,
+			o.WithKeyword("bug"),
+			o.WithIssueSort("created"),
+			o.WithOrder("asc"),
+		)
+		require.NoError(t, err)
+		var got []int
+		for iss, err := range seq {
+			require.NoError(t, err)
+			got = append(got, iss.ID)
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, got)
+		assert.ElementsMatch(t, []string{"0", "2"}, offsets)
+	})
+
+	t.Run("rate-limit-aware", func(t *testing.T) {
+		t.Parallel()
+
+		var inFlight, peak atomic.Int32
+		method := mock.NewMethod(t)
+		method.RateLimitRemaining = func() int { return 1 }
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			if spath == "issues/count" {
+				return mock.NewResponse(`{"count":6}`), nil
+			}
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+			}
+
+			body := fixture.Issue.ListJSON
+			if query.Get("offset") == "4" {
+				body = issueLastPageJSON
+			}
+			return mock.NewResponse(body), nil
+		}
+
+		s := issue.NewService(method)
+		seq, err := s.AllPrefetch(ctx, 2, 5)
+		require.NoError(t, err)
+		for _, err := range seq {
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(1), peak.Load())
+	})
+
+	t.Run("error-count", func(t *testing.T) {
+		t.Parallel()
+
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, _ url.Values) (*http.Response, error) {
+			assert.Equal(t, "issues/count", spath)
+			return nil, &client.APIResponseError{}
+		}
+
+		s := issue.NewService(method)
+		seq, err := s.AllPrefetch(ctx, 10, 2)
+		require.NoError(t, err)
+		for iss, err := range seq {
+			assert.Nil(t, iss)
+			var target *client.APIResponseError
+			assert.ErrorAs(t, err, &target)
+		}
+	})
+
+	t.Run("error-invalid-count", func(t *testing.T) {
+		t.Parallel()
+
+		s := issue.NewService(mock.NewMethod(t))
+		_, err := s.AllPrefetch(ctx, 0, 2)
+		require.Error(t, err)
+		var ves validation.Errors
+		assert.ErrorAs(t, err, &ves)
+	})
+
+	t.Run("error-invalid-prefetch", func(t *testing.T) {
+		t.Parallel()
+
+		s := issue.NewService(mock.NewMethod(t))
+		for _, prefetch := range []int{0, issue.MaxPrefetch + 1} {
+			_, err := s.AllPrefetch(ctx, 10, prefetch)
+			require.Error(t, err)
+			var ves validation.Errors
+			assert.ErrorAs(t, err, &ves)
+		}
+	})
+
+	t.Run("error-offset-passed", func(t *testing.T) {
+		t.Parallel()
+
+		o := &option.OptionService{}
+		s := issue.NewService(mock.NewMethod(t))
+		_, err := s.AllPrefetch(ctx, 10, 2, o.WithOffset(5))
+		var target *option.InvalidOptionKeyError
+		assert.ErrorAs(t, err, &target)
+	})
+}
@@ -0,0 +1,148 @@
+// Package notification implements the Backlog Notification API service.
+package notification
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/model"
+	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/pagination"
+	"github.com/nattokin/go-backlog/internal/validate"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+var listValidTypes = []option.APIParamOptionType{
+	option.ParamMinID,
+	option.ParamMaxID,
+	option.ParamCount,
+	option.ParamOrder,
+	option.ParamSenderID,
+}
+
+// allValidTypes are the options accepted by All, which manages count, minId
+// and maxId itself.
+var allValidTypes = []option.APIParamOptionType{
+	option.ParamOrder,
+	option.ParamSenderID,
+}
+
+var countValidTypes = []option.APIParamOptionType{
+	option.ParamAlreadyRead,
+	option.ParamResourceAlreadyRead,
+}
+
+// Service handles notification-related Backlog API calls.
+type Service struct {
+	method *client.Method
+}
+
+// List returns notifications of the authenticated user.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-notification
+func (s *Service) List(ctx context.Context, opts ...*option.APIParamOption) ([]*model.Notification, error) {
+	query := url.Values{}
+	if err := option.ApplyOptions(query, listValidTypes, opts...); err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, query)
+}
+
+// All returns an iterator that lazily fetches all notifications of the
+// authenticated user with cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-notification
+func (s *Service) All(ctx context.Context, perPage int, opts ...*option.APIParamOption) (iter.Seq2[*model.Notification, error], error) {
+	query := url.Values{}
+	countOpt := (&option.OptionService{}).WithCount(perPage)
+
+	var ves validation.Errors
+	if ve := countOpt.Check(); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, option.ApplyOptions(query, allValidTypes, opts...)); err != nil {
+		return nil, err
+	}
+	countOpt.Set(query)
+
+	return pagination.Cursor(ctx, perPage, query, func(v *model.Notification) int { return v.ID }, s.list), nil
+}
+
+// Count returns the number of notifications of the authenticated user.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-notification
+func (s *Service) Count(ctx context.Context, opts ...*option.APIParamOption) (int, error) {
+	query := url.Values{}
+	if err := option.ApplyOptions(query, countValidTypes, opts...); err != nil {
+		return 0, err
+	}
+
+	resp, err := s.method.Get(ctx, path.Join("notifications", "count"), query)
+	if err != nil {
+		return 0, err
+	}
+
+	v := map[string]int{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return 0, err
+	}
+
+	return v["count"], nil
+}
+
+// ResetUnreadCount resets the unread notification count of the authenticated
+// user and returns the count after the reset.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/reset-unread-notification-count
+func (s *Service) ResetUnreadCount(ctx context.Context) (int, error) {
+	resp, err := s.method.Post(ctx, path.Join("notifications", "markAsRead"), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	v := map[string]int{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return 0, err
+	}
+
+	return v["count"], nil
+}
+
+// MarkAsRead marks a notification as read.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/read-notification
+func (s *Service) MarkAsRead(ctx context.Context, notificationID int) error {
+	if err := validate.ValidateNotificationID(notificationID); err != nil {
+		return err
+	}
+
+	spath := path.Join("notifications", strconv.Itoa(notificationID), "markAsRead")
+	if _, err := s.method.Post(ctx, spath, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) list(ctx context.Context, query url.Values) ([]*model.Notification, error) {
+	resp, err := s.method.Get(ctx, "notifications", query)
+	if err != nil {
+		return nil, err
+	}
+
+	v := []*model.Notification{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func NewService(method *client.Method) *Service {
+	return &Service{method: method}
+}
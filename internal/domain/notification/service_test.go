@@ -0,0 +1,389 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/domain/notification"
+	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+func TestService_List(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		opts      []*option.APIParamOption
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr   bool
+	}{
+		"success-no-options": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "notifications", spath)
+				assert.Empty(t, query)
+				return mock.NewResponse(fixture.Notification.ListJSON), nil
+			},
+		},
+		"success-with-options": {
+			opts: []*option.APIParamOption{
+				o.WithMinID(10),
+				o.WithMaxID(30),
+				o.WithCount(50),
+				o.WithOrder("asc"),
+				o.WithSenderID(2),
+			},
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "10", query.Get("minId"))
+				assert.Equal(t, "30", query.Get("maxId"))
+				assert.Equal(t, "50", query.Get("count"))
+				assert.Equal(t, "asc", query.Get("order"))
+				assert.Equal(t, "2", query.Get("senderId"))
+				return mock.NewResponse(fixture.Notification.ListJSON), nil
+			},
+		},
+		"error-invalid-count": {
+			opts:    []*option.APIParamOption{o.WithCount(101)},
+			wantErr: true,
+		},
+		"error-invalid-senderID": {
+			opts:    []*option.APIParamOption{o.WithSenderID(0)},
+			wantErr: true,
+		},
+		"error-invalid-option-key": {
+			opts:    []*option.APIParamOption{o.WithOffset(1)},
+			wantErr: true,
+		},
+		"error-client-network": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := notification.NewService(method)
+			got, err := s.List(context.Background(), tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			assert.Equal(t, 22, got[0].ID)
+			assert.Equal(t, 2, got[0].Reason)
+			assert.Equal(t, "TEST-1", got[0].Issue.IssueKey)
+			assert.Equal(t, "dev", got[0].Sender.UserID)
+			assert.Equal(t, 3, got[1].PullRequest.ID)
+		})
+	}
+}
+
+func TestService_All(t *testing.T) {
+	ctx := context.Background()
+	o := &option.OptionService{}
+
+	t.Run("multi-page-desc", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			calls++
+			assert.Equal(t, "notifications", spath)
+			assert.Equal(t, "2", query.Get("count"))
+			assert.Equal(t, "2", query.Get("senderId"))
+			assert.Empty(t, query.Get("minId"))
+			switch calls {
+			case 1:
+				assert.Empty(t, query.Get("maxId"))
+				return mock.NewResponse(`[{"id": 3}, {"id": 2}]`), nil
+			case 2:
+				assert.Equal(t, "2", query.Get("maxId"))
+				return mock.NewResponse(`[{"id": 1}]`), nil
+			default:
+				t.Errorf("unexpected request #%d", calls)
+				return nil, nil
+			}
+		}
+
+		s := notification.NewService(method)
+		seq, err := s.All(ctx, 2, o.WithSenderID(2))
+		require.NoError(t, err)
+
+		var got []int
+		for v, err := range seq {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{3, 2, 1}, got)
+	})
+
+	t.Run("multi-page-asc", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			calls++
+			assert.Equal(t, "notifications", spath)
+			assert.Equal(t, "2", query.Get("count"))
+			assert.Equal(t, "asc", query.Get("order"))
+			assert.Empty(t, query.Get("maxId"))
+			switch calls {
+			case 1:
+				assert.Empty(t, query.Get("minId"))
+				return mock.NewResponse(`[{"id": 1}, {"id": 2}]`), nil
+			case 2:
+				assert.Equal(t, "2", query.Get("minId"))
+				return mock.NewResponse(`[{"id": 3}, {"id": 4}]`), nil
+			case 3:
+				assert.Equal(t, "4", query.Get("minId"))
+				return mock.NewResponse(`[]`), nil
+			default:
+				t.Errorf("unexpected request #%d", calls)
+				return nil, nil
+			}
+		}
+
+		s := notification.NewService(method)
+		seq, err := s.All(ctx, 2, o.WithOrder("asc"))
+		require.NoError(t, err)
+
+		var got []int
+		for v, err := range seq {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 4}, got)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("stops-on-error", func(t *testing.T) {
+		t.Parallel()
+
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			return nil, errors.New("network error")
+		}
+
+		s := notification.NewService(method)
+		seq, err := s.All(ctx, 2)
+		require.NoError(t, err)
+
+		count := 0
+		for v, err := range seq {
+			count++
+			assert.Nil(t, v)
+			assert.Error(t, err)
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("error-invalid-argument", func(t *testing.T) {
+		t.Parallel()
+
+		s := notification.NewService(mock.NewMethod(t))
+		_, err := s.All(ctx, 0, o.WithSenderID(0))
+		var ves validation.Errors
+		require.ErrorAs(t, err, &ves)
+		assert.Len(t, ves, 2)
+	})
+
+	for name, opt := range map[string]*option.APIParamOption{
+		"count":  o.WithCount(5),
+		"min-id": o.WithMinID(5),
+		"max-id": o.WithMaxID(5),
+	} {
+		t.Run("error-"+name+"-passed-to-all", func(t *testing.T) {
+			t.Parallel()
+
+			s := notification.NewService(mock.NewMethod(t))
+			_, err := s.All(ctx, 10, opt)
+			var target *option.InvalidOptionKeyError
+			assert.ErrorAs(t, err, &target)
+		})
+	}
+}
+
+func TestService_Count(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		opts      []*option.APIParamOption
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr   bool
+		want      int
+	}{
+		"success": {
+			opts: []*option.APIParamOption{o.WithAlreadyRead(false), o.WithResourceAlreadyRead(true)},
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "notifications/count", spath)
+				assert.Equal(t, "false", query.Get("alreadyRead"))
+				assert.Equal(t, "true", query.Get("resourceAlreadyRead"))
+				return mock.NewResponse(fixture.Notification.CountJSON), nil
+			},
+			want: 10,
+		},
+		"error-invalid-option-key": {
+			opts:    []*option.APIParamOption{o.WithCount(10)},
+			wantErr: true,
+		},
+		"error-client-network": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := notification.NewService(method)
+			got, err := s.Count(context.Background(), tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestService_ResetUnreadCount(t *testing.T) {
+	cases := map[string]struct {
+		mockPostFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr    bool
+	}{
+		"success": {
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "notifications/markAsRead", spath)
+				return mock.NewResponse(fixture.Notification.MarkReadJSON), nil
+			},
+		},
+		"error-client-network": {
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPostFn != nil {
+				method.Post = tc.mockPostFn
+			}
+
+			s := notification.NewService(method)
+			got, err := s.ResetUnreadCount(context.Background())
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 0, got)
+		})
+	}
+}
+
+func TestService_MarkAsRead(t *testing.T) {
+	cases := map[string]struct {
+		notificationID int
+		mockPostFn     func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr        bool
+	}{
+		"success": {
+			notificationID: 22,
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "notifications/22/markAsRead", spath)
+				return nil, nil
+			},
+		},
+		"error-invalid-notificationID": {
+			notificationID: 0,
+			wantErr:        true,
+		},
+		"error-client-network": {
+			notificationID: 22,
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPostFn != nil {
+				method.Post = tc.mockPostFn
+			}
+
+			s := notification.NewService(method)
+			err := s.MarkAsRead(context.Background(), tc.notificationID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 
@@ -36,6 +37,25 @@ func (s *ActivityService) List(ctx context.Context, projectIDOrKey string, opts
 	return s.base.Fetch(ctx, spath, query)
 }
 
+// All returns an iterator that lazily fetches all activities in the project
+// with cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-recent-updates
+func (s *ActivityService) All(ctx context.Context, perPage int, projectIDOrKey string, opts ...*option.APIParamOption) (iter.Seq2[*model.Activity, error], error) {
+	query := url.Values{}
+
+	var ves validation.Errors
+	if ve := validate.ValidateProjectIDOrKey(projectIDOrKey); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, s.base.ApplyAllOptions(query, perPage, opts...)); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("projects", projectIDOrKey, "activities")
+	return s.base.FetchAll(ctx, spath, perPage, query), nil
+}
+
 func NewActivityService(method *client.Method) *ActivityService {
 	return &ActivityService{
 		base:   activity.NewService(method),
@@ -0,0 +1,108 @@
+package project
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/model"
+	"github.com/nattokin/go-backlog/internal/validate"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+// TeamService handles project team-related Backlog API calls.
+type TeamService struct {
+	method *client.Method
+}
+
+// List returns a list of teams in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-team-list
+func (s *TeamService) List(ctx context.Context, projectIDOrKey string) ([]*model.Team, error) {
+	if err := validate.ValidateProjectIDOrKey(projectIDOrKey); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("projects", projectIDOrKey, "teams")
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := []*model.Team{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Add adds a team to the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project-team
+func (s *TeamService) Add(ctx context.Context, projectIDOrKey string, teamID int) (*model.Team, error) {
+	var ves validation.Errors
+	if ve := validate.ValidateProjectIDOrKey(projectIDOrKey); ve != nil {
+		ves = append(ves, ve)
+	}
+	if ve := validate.ValidateTeamID(teamID); ve != nil {
+		ves = append(ves, ve)
+	}
+	if len(ves) > 0 {
+		return nil, ves
+	}
+
+	form := url.Values{}
+	form.Set("teamId", strconv.Itoa(teamID))
+
+	spath := path.Join("projects", projectIDOrKey, "teams")
+	resp, err := s.method.Post(ctx, spath, form)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Team{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Delete removes a team from the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project-team
+func (s *TeamService) Delete(ctx context.Context, projectIDOrKey string, teamID int) (*model.Team, error) {
+	var ves validation.Errors
+	if ve := validate.ValidateProjectIDOrKey(projectIDOrKey); ve != nil {
+		ves = append(ves, ve)
+	}
+	if ve := validate.ValidateTeamID(teamID); ve != nil {
+		ves = append(ves, ve)
+	}
+	if len(ves) > 0 {
+		return nil, ves
+	}
+
+	form := url.Values{}
+	form.Set("teamId", strconv.Itoa(teamID))
+
+	spath := path.Join("projects", projectIDOrKey, "teams")
+	resp, err := s.method.Delete(ctx, spath, form)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Team{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func NewTeamService(method *client.Method) *TeamService {
+	return &TeamService{method: method}
+}
@@ -0,0 +1,244 @@
+package project_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/domain/project"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+func TestProjectTeamService_List(t *testing.T) {
+	cases := map[string]struct {
+		projectKey string
+
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+
+		wantErrType            error
+		wantValidationErrCount int
+	}{
+		"success": {
+			projectKey: "TEST",
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "projects/TEST/teams", spath)
+				return mock.NewResponse(fixture.Team.ListJSON), nil
+			},
+		},
+
+		"error-validation-projectKey-empty": {
+			projectKey:             "",
+			wantValidationErrCount: 1,
+		},
+
+		"error-response-invalid-json": {
+			projectKey: "TEST",
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErrType: &json.SyntaxError{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+			s := project.NewTeamService(method)
+			teams, err := s.List(context.Background(), tc.projectKey)
+
+			if tc.wantValidationErrCount > 0 {
+				assert.Error(t, err)
+				assert.Nil(t, teams)
+				var ve *validation.Error
+				assert.ErrorAs(t, err, &ve)
+				return
+			}
+
+			if tc.wantErrType != nil {
+				assert.Error(t, err)
+				assert.ErrorAs(t, err, &tc.wantErrType)
+				assert.Nil(t, teams)
+				return
+			}
+
+			assert.NoError(t, err)
+			require.Len(t, teams, 2)
+			assert.Equal(t, 1, teams[0].ID)
+			assert.Equal(t, "developers", teams[0].Name)
+		})
+	}
+}
+
+func TestProjectTeamService_Add(t *testing.T) {
+	cases := map[string]struct {
+		projectKey string
+		teamID     int
+
+		mockPostFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+
+		wantErrType            error
+		wantValidationErrCount int
+	}{
+		"success": {
+			projectKey: "TEST",
+			teamID:     1,
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "projects/TEST/teams", spath)
+				assert.Equal(t, "1", form.Get("teamId"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+
+		"error-validation-projectKey-empty": {
+			projectKey:             "",
+			teamID:                 1,
+			wantValidationErrCount: 1,
+		},
+		"error-validation-teamID-zero": {
+			projectKey:             "TEST",
+			teamID:                 0,
+			wantValidationErrCount: 1,
+		},
+		"error-validation-all": {
+			projectKey:             "",
+			teamID:                 0,
+			wantValidationErrCount: 2,
+		},
+
+		"error-response-invalid-json": {
+			projectKey: "TEST",
+			teamID:     1,
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErrType: &json.SyntaxError{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPostFn != nil {
+				method.Post = tc.mockPostFn
+			}
+			s := project.NewTeamService(method)
+			got, err := s.Add(context.Background(), tc.projectKey, tc.teamID)
+
+			if tc.wantValidationErrCount > 0 {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				var ves validation.Errors
+				if assert.ErrorAs(t, err, &ves) {
+					assert.Len(t, ves, tc.wantValidationErrCount)
+				}
+				return
+			}
+
+			if tc.wantErrType != nil {
+				assert.Error(t, err)
+				assert.ErrorAs(t, err, &tc.wantErrType)
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, 1, got.ID)
+		})
+	}
+}
+
+func TestProjectTeamService_Delete(t *testing.T) {
+	cases := map[string]struct {
+		projectKey string
+		teamID     int
+
+		mockDeleteFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+
+		wantErrType            error
+		wantValidationErrCount int
+	}{
+		"success": {
+			projectKey: "TEST",
+			teamID:     1,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "projects/TEST/teams", spath)
+				assert.Equal(t, "1", form.Get("teamId"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+
+		"error-validation-projectKey-empty": {
+			projectKey:             "",
+			teamID:                 1,
+			wantValidationErrCount: 1,
+		},
+		"error-validation-teamID-zero": {
+			projectKey:             "TEST",
+			teamID:                 0,
+			wantValidationErrCount: 1,
+		},
+		"error-validation-all": {
+			projectKey:             "",
+			teamID:                 0,
+			wantValidationErrCount: 2,
+		},
+
+		"error-response-invalid-json": {
+			projectKey: "TEST",
+			teamID:     1,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErrType: &json.SyntaxError{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockDeleteFn != nil {
+				method.Delete = tc.mockDeleteFn
+			}
+			s := project.NewTeamService(method)
+			got, err := s.Delete(context.Background(), tc.projectKey, tc.teamID)
+
+			if tc.wantValidationErrCount > 0 {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				var ves validation.Errors
+				if assert.ErrorAs(t, err, &ves) {
+					assert.Len(t, ves, tc.wantValidationErrCount)
+				}
+				return
+			}
+
+			if tc.wantErrType != nil {
+				assert.Error(t, err)
+				assert.ErrorAs(t, err, &tc.wantErrType)
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, 1, got.ID)
+		})
+	}
+}
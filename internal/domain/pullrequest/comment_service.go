@@ -2,6 +2,7 @@ package pullrequest
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -43,6 +44,31 @@ func (s *CommentService) List(ctx context.Context, projectIDOrKey string, repoID
 	return s.base.FetchList(ctx, spath, query)
 }
 
+// All returns an iterator that lazily fetches all comments on a pull request
+// with cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-comment
+func (s *CommentService) All(ctx context.Context, perPage int, projectIDOrKey string, repoIDOrName string, prNumber int, opts ...*option.APIParamOption) (iter.Seq2[*model.Comment, error], error) {
+	query := url.Values{}
+
+	var ves validation.Errors
+	if ve := validate.ValidateProjectIDOrKey(projectIDOrKey); ve != nil {
+		ves = append(ves, ve)
+	}
+	if ve := validate.ValidateRepositoryIDOrName(repoIDOrName); ve != nil {
+		ves = append(ves, ve)
+	}
+	if ve := validate.ValidatePRNumber(prNumber); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, s.base.ApplyAllOptions(query, perPage, opts...)); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("projects", projectIDOrKey, "git", "repositories", repoIDOrName, "pullRequests", strconv.Itoa(prNumber), "comments")
+	return s.base.FetchAll(ctx, spath, perPage, query), nil
+}
+
 // Add adds a comment to a pull request.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-pull-request-comment
@@ -2,6 +2,7 @@ package space
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -31,6 +32,18 @@ func (s *ActivityService) List(ctx context.Context, opts ...*option.APIParamOpti
 	return s.base.Fetch(ctx, "space/activities", query)
 }
 
+// All returns an iterator that lazily fetches all activities in the space with
+// cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates
+func (s *ActivityService) All(ctx context.Context, perPage int, opts ...*option.APIParamOption) (iter.Seq2[*model.Activity, error], error) {
+	query := url.Values{}
+	if err := s.base.ApplyAllOptions(query, perPage, opts...); err != nil {
+		return nil, err
+	}
+	return s.base.FetchAll(ctx, "space/activities", perPage, query), nil
+}
+
 // One returns a single activity by its ID.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-activity
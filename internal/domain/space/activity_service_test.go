@@ -14,6 +14,7 @@ import (
 	"github.com/nattokin/go-backlog/internal/option"
 	"github.com/nattokin/go-backlog/internal/testutil/fixture"
 	"github.com/nattokin/go-backlog/internal/testutil/mock"
+	"github.com/nattokin/go-backlog/internal/validation"
 )
 
 func TestActivityService_List(t *testing.T) {
@@ -260,3 +261,138 @@ func TestActivityService_One(t *testing.T) {
 		})
 	}
 }
+
+func TestActivityService_All(t *testing.T) {
+	ctx := context.Background()
+	o := &option.OptionService{}
+
+	t.Run("descending", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, spath string, query url.Values) (*http.Response, error) {
+			calls++
+			assert.Equal(t, "space/activities", spath)
+			assert.Equal(t, "2", query.Get("count"))
+			assert.Equal(t, []string{"1"}, query["activityTypeId[]"])
+			assert.Empty(t, query.Get("minId"))
+			switch calls {
+			case 1:
+				assert.Empty(t, query.Get("maxId"))
+				return mock.NewResponse(`[{"id": 30}, {"id": 20}]`), nil
+			case 2:
+				assert.Equal(t, "20", query.Get("maxId"))
+				return mock.NewResponse(`[{"id": 10}]`), nil
+			default:
+				t.Errorf("unexpected request #%d", calls)
+				return nil, nil
+			}
+		}
+
+		s := space.NewActivityService(method)
+		seq, err := s.All(ctx, 2, o.WithActivityTypeIDs([]int{1}))
+		require.NoError(t, err)
+
+		var got []int
+		for v, err := range seq {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{30, 20, 10}, got)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("ascending", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, _ string, query url.Values) (*http.Response, error) {
+			calls++
+			assert.Equal(t, "asc", query.Get("order"))
+			assert.Empty(t, query.Get("maxId"))
+			switch calls {
+			case 1:
+				assert.Empty(t, query.Get("minId"))
+				return mock.NewResponse(`[{"id": 10}, {"id": 20}]`), nil
+			case 2:
+				assert.Equal(t, "20", query.Get("minId"))
+				return mock.NewResponse(`[]`), nil
+			default:
+				t.Errorf("unexpected request #%d", calls)
+				return nil, nil
+			}
+		}
+
+		s := space.NewActivityService(method)
+		seq, err := s.All(ctx, 2, o.WithOrder("asc"))
+		require.NoError(t, err)
+
+		var got []int
+		for v, err := range seq {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{10, 20}, got)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, _ string, _ url.Values) (*http.Response, error) {
+			calls++
+			return mock.NewResponse(`[{"id": 30}, {"id": 20}]`), nil
+		}
+
+		s := space.NewActivityService(method)
+		seq, err := s.All(ctx, 2)
+		require.NoError(t, err)
+		for _, err := range seq {
+			require.NoError(t, err)
+			break
+		}
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		method := mock.NewMethod(t)
+		method.Get = func(_ context.Context, _ string, _ url.Values) (*http.Response, error) {
+			return nil, errors.New("network error")
+		}
+
+		s := space.NewActivityService(method)
+		seq, err := s.All(ctx, 10)
+		require.NoError(t, err)
+		for v, err := range seq {
+			assert.Nil(t, v)
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("error-invalid-count", func(t *testing.T) {
+		t.Parallel()
+
+		s := space.NewActivityService(mock.NewMethod(t))
+		_, err := s.All(ctx, 101)
+		var ves validation.Errors
+		assert.ErrorAs(t, err, &ves)
+	})
+
+	t.Run("error-cursor-passed-to-all", func(t *testing.T) {
+		t.Parallel()
+
+		s := space.NewActivityService(mock.NewMethod(t))
+		_, err := s.All(ctx, 10, o.WithMaxID(5))
+		var target *option.InvalidOptionKeyError
+		assert.ErrorAs(t, err, &target)
+	})
+}
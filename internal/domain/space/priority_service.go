@@ -0,0 +1,34 @@
+package space
+
+import (
+	"context"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// PriorityService handles priority-related Backlog API calls for a space.
+type PriorityService struct {
+	method *client.Method
+}
+
+// List returns the list of issue priorities in the space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-priority-list
+func (s *PriorityService) List(ctx context.Context) ([]*model.Priority, error) {
+	resp, err := s.method.Get(ctx, "priorities", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := []*model.Priority{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func NewPriorityService(method *client.Method) *PriorityService {
+	return &PriorityService{method: method}
+}
@@ -0,0 +1,34 @@
+package space
+
+import (
+	"context"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// ResolutionService handles resolution-related Backlog API calls for a space.
+type ResolutionService struct {
+	method *client.Method
+}
+
+// List returns the list of issue resolutions in the space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-resolution-list
+func (s *ResolutionService) List(ctx context.Context) ([]*model.Resolution, error) {
+	resp, err := s.method.Get(ctx, "resolutions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := []*model.Resolution{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func NewResolutionService(method *client.Method) *ResolutionService {
+	return &ResolutionService{method: method}
+}
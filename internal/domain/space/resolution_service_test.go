@@ -0,0 +1,69 @@
+package space_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/domain/space"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestSpaceResolutionService_List(t *testing.T) {
+	cases := map[string]struct {
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+
+		expectError bool
+	}{
+		"success": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "resolutions", spath)
+				assert.Nil(t, query)
+				return mock.NewResponse(fixture.Resolution.ListJSON), nil
+			},
+		},
+
+		"error-client-failure": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("error")
+			},
+			expectError: true,
+		},
+
+		"error-invalid-json": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			method.Get = tc.mockGetFn
+			s := space.NewResolutionService(method)
+
+			got, err := s.List(context.Background())
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, 5)
+			assert.Equal(t, 0, got[0].ID)
+			assert.Equal(t, "Fixed", got[0].Name)
+		})
+	}
+}
@@ -92,6 +92,53 @@ func (s *Service) UpdateNotification(ctx context.Context, content string) (*mode
 	return &v, nil
 }
 
+// Licence returns the licence information of your space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-licence
+func (s *Service) Licence(ctx context.Context) (*model.Licence, error) {
+	resp, err := s.method.Get(ctx, "space/licence", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Licence{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Logo returns the logo image of your space.
+// The caller is responsible for closing FileData.Body after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-logo
+func (s *Service) Logo(ctx context.Context) (*model.FileData, error) {
+	resp, err := s.method.Download(ctx, "space/image", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DownloadResponse(resp)
+}
+
+// RateLimit returns the API rate limit status of the authenticated user.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-rate-limit
+func (s *Service) RateLimit(ctx context.Context) (*model.RateLimitStatus, error) {
+	resp, err := s.method.Get(ctx, "rateLimit", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.RateLimitStatus{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
 func NewService(method *client.Method) *Service {
 	return &Service{
 		method: method,
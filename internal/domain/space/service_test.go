@@ -260,3 +260,172 @@ func TestService_UpdateNotification(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Licence(t *testing.T) {
+	cases := map[string]struct {
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+
+		wantErrType      error
+		wantLicenceType  int
+		wantStorageLimit int64
+	}{
+		"success": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "space/licence", spath)
+				return mock.NewResponse(fixture.Space.LicenceJSON), nil
+			},
+			wantLicenceType:  46,
+			wantStorageLimit: 1073741824,
+		},
+		"error-client-network": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErrType: errors.New(""),
+		},
+		"error-response-invalid-json": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErrType: &json.SyntaxError{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+			s := space.NewService(method)
+			got, err := s.Licence(context.Background())
+
+			if tc.wantErrType != nil {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				assert.ErrorAs(t, err, &tc.wantErrType)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.True(t, got.Active)
+			assert.Equal(t, tc.wantLicenceType, got.LicenceTypeID)
+			assert.Equal(t, tc.wantStorageLimit, got.StorageLimit)
+		})
+	}
+}
+
+func TestService_Logo(t *testing.T) {
+	cases := map[string]struct {
+		mockDownloadFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+
+		wantErrType     error
+		wantFilename    string
+		wantContentType string
+	}{
+		"success": {
+			mockDownloadFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "space/image", spath)
+				return mock.NewBinaryResponse("logo.png", "image/png", []byte("PNG")), nil
+			},
+			wantFilename:    "logo.png",
+			wantContentType: "image/png",
+		},
+		"error-client-network": {
+			mockDownloadFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErrType: errors.New(""),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockDownloadFn != nil {
+				method.Download = tc.mockDownloadFn
+			}
+			s := space.NewService(method)
+			got, err := s.Logo(context.Background())
+
+			if tc.wantErrType != nil {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				assert.ErrorAs(t, err, &tc.wantErrType)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, tc.wantFilename, got.Filename)
+			assert.Equal(t, tc.wantContentType, got.ContentType)
+			require.NotNil(t, got.Body)
+			got.Body.Close()
+		})
+	}
+}
+
+func TestService_RateLimit(t *testing.T) {
+	cases := map[string]struct {
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+
+		wantErrType error
+	}{
+		"success": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "rateLimit", spath)
+				return mock.NewResponse(fixture.Space.RateLimitJSON), nil
+			},
+		},
+		"error-client-network": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErrType: errors.New(""),
+		},
+		"error-response-invalid-json": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErrType: &json.SyntaxError{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+			s := space.NewService(method)
+			got, err := s.RateLimit(context.Background())
+
+			if tc.wantErrType != nil {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				assert.ErrorAs(t, err, &tc.wantErrType)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.NotNil(t, got.RateLimit.Read)
+			assert.Equal(t, 600, got.RateLimit.Read.Limit)
+			assert.Equal(t, 598, got.RateLimit.Read.Remaining)
+			assert.Equal(t, int64(1700000060), got.RateLimit.Read.Reset)
+			require.NotNil(t, got.RateLimit.Update)
+			assert.Equal(t, 150, got.RateLimit.Update.Limit)
+			require.NotNil(t, got.RateLimit.Search)
+			assert.Equal(t, 149, got.RateLimit.Search.Remaining)
+			require.NotNil(t, got.RateLimit.Icon)
+			assert.Equal(t, 60, got.RateLimit.Icon.Limit)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Package team implements the Backlog Team API service.
+package team
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/model"
+	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/validate"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+var listValidTypes = []option.APIParamOptionType{
+	option.ParamOrder,
+	option.ParamOffset,
+	option.ParamCount,
+}
+
+var createValidTypes = []option.APIParamOptionType{
+	option.ParamName,
+	option.ParamMembers,
+}
+
+var updateValidTypes = []option.APIParamOptionType{
+	option.ParamName,
+	option.ParamMembers,
+}
+
+// Service handles team-related Backlog API calls.
+type Service struct {
+	method *client.Method
+}
+
+func decodeTeam(resp *http.Response) (*model.Team, error) {
+	v := model.Team{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// List returns a list of teams.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-teams
+func (s *Service) List(ctx context.Context, opts ...*option.APIParamOption) ([]*model.Team, error) {
+	query := url.Values{}
+	if err := option.ApplyOptions(query, listValidTypes, opts...); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Get(ctx, "teams", query)
+	if err != nil {
+		return nil, err
+	}
+
+	v := []*model.Team{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// One returns a single team by its ID.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-team
+func (s *Service) One(ctx context.Context, teamID int) (*model.Team, error) {
+	if err := validate.ValidateTeamID(teamID); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("teams", strconv.Itoa(teamID))
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTeam(resp)
+}
+
+// Create creates a new team.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-team
+func (s *Service) Create(ctx context.Context, name string, opts ...*option.APIParamOption) (*model.Team, error) {
+	optSvc := &option.OptionService{}
+
+	form := url.Values{}
+	options := append([]*option.APIParamOption{optSvc.WithName(name)}, opts...)
+	if err := option.ApplyOptions(form, createValidTypes, options...); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Post(ctx, "teams", form)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTeam(resp)
+}
+
+// Update updates a team.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-team
+func (s *Service) Update(ctx context.Context, teamID int, opt *option.APIParamOption, opts ...*option.APIParamOption) (*model.Team, error) {
+	form := url.Values{}
+	options := append([]*option.APIParamOption{opt}, opts...)
+
+	var ves validation.Errors
+	if ve := validate.ValidateTeamID(teamID); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, option.ApplyOptions(form, updateValidTypes, options...)); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("teams", strconv.Itoa(teamID))
+	resp, err := s.method.Patch(ctx, spath, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTeam(resp)
+}
+
+// Delete deletes a team.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-team
+func (s *Service) Delete(ctx context.Context, teamID int) (*model.Team, error) {
+	if err := validate.ValidateTeamID(teamID); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("teams", strconv.Itoa(teamID))
+	resp, err := s.method.Delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTeam(resp)
+}
+
+// Icon returns the icon image of a team.
+// The caller is responsible for closing FileData.Body after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-team-icon
+func (s *Service) Icon(ctx context.Context, teamID int) (*model.FileData, error) {
+	if err := validate.ValidateTeamID(teamID); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("teams", strconv.Itoa(teamID), "icon")
+	resp, err := s.method.Download(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DownloadResponse(resp)
+}
+
+func NewService(method *client.Method) *Service {
+	return &Service{method: method}
+}
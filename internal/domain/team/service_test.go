@@ -0,0 +1,437 @@
+package team_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/domain/team"
+	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+func TestService_List(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		opts      []*option.APIParamOption
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr   bool
+		wantLen   int
+	}{
+		"success-no-options": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "teams", spath)
+				assert.Empty(t, query)
+				return mock.NewResponse(fixture.Team.ListJSON), nil
+			},
+			wantLen: 2,
+		},
+		"success-with-options": {
+			opts: []*option.APIParamOption{
+				o.WithOrder("asc"),
+				o.WithCount(20),
+				o.WithOffset(40),
+			},
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "asc", query.Get("order"))
+				assert.Equal(t, "20", query.Get("count"))
+				assert.Equal(t, "40", query.Get("offset"))
+				return mock.NewResponse(fixture.Team.ListJSON), nil
+			},
+			wantLen: 2,
+		},
+		"error-invalid-option-key": {
+			opts:    []*option.APIParamOption{o.WithName("developers")},
+			wantErr: true,
+		},
+		"error-client-network": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := team.NewService(method)
+			got, err := s.List(context.Background(), tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, tc.wantLen)
+			assert.Equal(t, "developers", got[0].Name)
+			require.Len(t, got[0].Members, 1)
+			assert.Equal(t, "admin", got[0].Members[0].UserID)
+		})
+	}
+}
+
+func TestService_One(t *testing.T) {
+	cases := map[string]struct {
+		teamID    int
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr   bool
+	}{
+		"success": {
+			teamID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "teams/1", spath)
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+		"error-invalid-teamID": {
+			teamID:  0,
+			wantErr: true,
+		},
+		"error-client-network": {
+			teamID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			teamID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := team.NewService(method)
+			got, err := s.One(context.Background(), tc.teamID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+			assert.Len(t, got.Members, 2)
+		})
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		name       string
+		opts       []*option.APIParamOption
+		mockPostFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr    bool
+	}{
+		"success": {
+			name: "developers",
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "teams", spath)
+				assert.Equal(t, "developers", form.Get("name"))
+				assert.False(t, form.Has("members[]"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+		"success-with-members": {
+			name: "developers",
+			opts: []*option.APIParamOption{o.WithMembers([]int{1, 2})},
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, []string{"1", "2"}, form["members[]"])
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+		"error-empty-name": {
+			name:    "",
+			wantErr: true,
+		},
+		"error-invalid-members": {
+			name:    "developers",
+			opts:    []*option.APIParamOption{o.WithMembers([]int{0})},
+			wantErr: true,
+		},
+		"error-invalid-option-key": {
+			name:    "developers",
+			opts:    []*option.APIParamOption{o.WithCount(1)},
+			wantErr: true,
+		},
+		"error-client-network": {
+			name: "developers",
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			name: "developers",
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPostFn != nil {
+				method.Post = tc.mockPostFn
+			}
+
+			s := team.NewService(method)
+			got, err := s.Create(context.Background(), tc.name, tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "developers", got.Name)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		teamID      int
+		opt         *option.APIParamOption
+		opts        []*option.APIParamOption
+		mockPatchFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+
+		wantErr                bool
+		wantValidationErrCount int
+	}{
+		"success-name": {
+			teamID: 1,
+			opt:    o.WithName("developers"),
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "teams/1", spath)
+				assert.Equal(t, "developers", form.Get("name"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+		"success-members": {
+			teamID: 1,
+			opt:    o.WithMembers([]int{1, 2}),
+			opts:   []*option.APIParamOption{o.WithName("developers")},
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, []string{"1", "2"}, form["members[]"])
+				assert.Equal(t, "developers", form.Get("name"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+		"error-validation-teamID": {
+			teamID:                 0,
+			opt:                    o.WithName("developers"),
+			wantValidationErrCount: 1,
+		},
+		"error-validation-all": {
+			teamID:                 0,
+			opt:                    o.WithName(""),
+			wantValidationErrCount: 2,
+		},
+		"error-nil-option": {
+			teamID:  1,
+			opt:     nil,
+			wantErr: true,
+		},
+		"error-invalid-option-key": {
+			teamID:  1,
+			opt:     o.WithCount(1),
+			wantErr: true,
+		},
+		"error-client-network": {
+			teamID: 1,
+			opt:    o.WithName("developers"),
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			teamID: 1,
+			opt:    o.WithName("developers"),
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPatchFn != nil {
+				method.Patch = tc.mockPatchFn
+			}
+
+			s := team.NewService(method)
+			got, err := s.Update(context.Background(), tc.teamID, tc.opt, tc.opts...)
+
+			if tc.wantValidationErrCount > 0 {
+				assert.Nil(t, got)
+				var ves validation.Errors
+				if assert.ErrorAs(t, err, &ves) {
+					assert.Len(t, ves, tc.wantValidationErrCount)
+				}
+				return
+			}
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	cases := map[string]struct {
+		teamID       int
+		mockDeleteFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr      bool
+	}{
+		"success": {
+			teamID: 1,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "teams/1", spath)
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+		},
+		"error-invalid-teamID": {
+			teamID:  -1,
+			wantErr: true,
+		},
+		"error-client-network": {
+			teamID: 1,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			teamID: 1,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockDeleteFn != nil {
+				method.Delete = tc.mockDeleteFn
+			}
+
+			s := team.NewService(method)
+			got, err := s.Delete(context.Background(), tc.teamID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+		})
+	}
+}
+
+func TestService_Icon(t *testing.T) {
+	cases := map[string]struct {
+		teamID         int
+		mockDownloadFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr        bool
+	}{
+		"success": {
+			teamID: 1,
+			mockDownloadFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "teams/1/icon", spath)
+				return mock.NewBinaryResponse("team.png", "image/png", []byte("PNG")), nil
+			},
+		},
+		"error-invalid-teamID": {
+			teamID:  0,
+			wantErr: true,
+		},
+		"error-client-network": {
+			teamID: 1,
+			mockDownloadFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockDownloadFn != nil {
+				method.Download = tc.mockDownloadFn
+			}
+
+			s := team.NewService(method)
+			got, err := s.Icon(context.Background(), tc.teamID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "team.png", got.Filename)
+			assert.Equal(t, "image/png", got.ContentType)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -38,6 +39,25 @@ func (s *ActivityService) List(ctx context.Context, userID int, opts ...*option.
 	return s.base.Fetch(ctx, spath, query)
 }
 
+// All returns an iterator that lazily fetches all activities of the user with
+// cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-recent-updates
+func (s *ActivityService) All(ctx context.Context, perPage int, userID int, opts ...*option.APIParamOption) (iter.Seq2[*model.Activity, error], error) {
+	query := url.Values{}
+
+	var ves validation.Errors
+	if ve := validate.ValidateUserID(userID); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, s.base.ApplyAllOptions(query, perPage, opts...)); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("users", strconv.Itoa(userID), "activities")
+	return s.base.FetchAll(ctx, spath, perPage, query), nil
+}
+
 func NewActivityService(method *client.Method) *ActivityService {
 	return &ActivityService{
 		base:   activity.NewService(method),
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/model"
 	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/pagination"
 	"github.com/nattokin/go-backlog/internal/validate"
 	"github.com/nattokin/go-backlog/internal/validation"
 )
@@ -33,18 +35,32 @@ func (s *StarService) List(ctx context.Context, userID int, opts ...*option.APIP
 		return nil, err
 	}
 
-	spath := path.Join("users", strconv.Itoa(userID), "stars")
-	resp, err := s.method.Get(ctx, spath, query)
-	if err != nil {
-		return nil, err
-	}
+	return s.list(ctx, userID, query)
+}
 
-	v := []*model.Star{}
-	if err := client.DecodeResponse(resp, &v); err != nil {
+// All returns an iterator that lazily fetches all stars received by the user
+// with cursor-based pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-received-star-list
+func (s *StarService) All(ctx context.Context, perPage int, userID int, opts ...*option.APIParamOption) (iter.Seq2[*model.Star, error], error) {
+	query := url.Values{}
+	countOpt := (&option.OptionService{}).WithCount(perPage)
+
+	var ves validation.Errors
+	if ve := validate.ValidateUserID(userID); ve != nil {
+		ves = append(ves, ve)
+	}
+	if ve := countOpt.Check(); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, option.ApplyOptions(query, []option.APIParamOptionType{option.ParamOrder}, opts...)); err != nil {
 		return nil, err
 	}
+	countOpt.Set(query)
 
-	return v, nil
+	return pagination.Cursor(ctx, perPage, query, func(v *model.Star) int { return v.ID }, func(ctx context.Context, q url.Values) ([]*model.Star, error) {
+		return s.list(ctx, userID, q)
+	}), nil
 }
 
 // Count returns the number of stars received by the user.
@@ -75,6 +91,21 @@ func (s *StarService) Count(ctx context.Context, userID int) (int, error) {
 	return v.Count, nil
 }
 
+func (s *StarService) list(ctx context.Context, userID int, query url.Values) ([]*model.Star, error) {
+	spath := path.Join("users", strconv.Itoa(userID), "stars")
+	resp, err := s.method.Get(ctx, spath, query)
+	if err != nil {
+		return nil, err
+	}
+
+	v := []*model.Star{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 func NewStarService(method *client.Method) *StarService {
 	return &StarService{method: method}
 }
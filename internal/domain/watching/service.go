@@ -0,0 +1,246 @@
+// Package watching implements the Backlog Watching API service.
+package watching
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/model"
+	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/pagination"
+	"github.com/nattokin/go-backlog/internal/validate"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+var filterValidTypes = []option.APIParamOptionType{
+	option.ParamOrder,
+	option.ParamSort,
+	option.ParamResourceAlreadyRead,
+	option.ParamIssueIDs,
+}
+
+var listValidTypes = append(filterValidTypes,
+	option.ParamCount,
+	option.ParamOffset,
+)
+
+var countValidTypes = []option.APIParamOptionType{
+	option.ParamResourceAlreadyRead,
+	option.ParamAlreadyRead,
+}
+
+var addValidTypes = []option.APIParamOptionType{
+	option.ParamNote,
+}
+
+// Service handles watching-related Backlog API calls.
+type Service struct {
+	method *client.Method
+}
+
+func (s *Service) list(ctx context.Context, userID int, query url.Values) ([]*model.Watching, error) {
+	spath := path.Join("users", strconv.Itoa(userID), "watchings")
+	resp, err := s.method.Get(ctx, spath, query)
+	if err != nil {
+		return nil, err
+	}
+	v := []*model.Watching{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// List returns the watching list of a user.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-watching-list
+func (s *Service) List(ctx context.Context, userID int, opts ...*option.APIParamOption) ([]*model.Watching, error) {
+	if err := validate.ValidateUserID(userID); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if err := option.ApplyOptions(query, listValidTypes, opts...); err != nil {
+		return nil, err
+	}
+	return s.list(ctx, userID, query)
+}
+
+// All returns an iterator that lazily fetches the whole watching list of a user
+// with automatic pagination.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-watching-list
+func (s *Service) All(ctx context.Context, perPage int, userID int, opts ...*option.APIParamOption) (iter.Seq2[*model.Watching, error], error) {
+	var ves validation.Errors
+	if ve := validate.ValidateUserID(userID); ve != nil {
+		ves = append(ves, ve)
+	}
+	o := &option.OptionService{}
+	countOpt := o.WithCount(perPage)
+	if ve := countOpt.Check(); ve != nil {
+		ves = append(ves, ve)
+	}
+	if len(ves) > 0 {
+		return nil, ves
+	}
+
+	baseQuery := url.Values{}
+	countOpt.Set(baseQuery)
+	if err := option.ApplyOptions(baseQuery, filterValidTypes, opts...); err != nil {
+		return nil, err
+	}
+
+	return pagination.All(ctx, perPage, func(ctx context.Context, offset int) ([]*model.Watching, error) {
+		q := maps.Clone(baseQuery)
+		q.Set(option.ParamOffset.Value(), strconv.Itoa(offset))
+		return s.list(ctx, userID, q)
+	}), nil
+}
+
+// Count returns the number of watchings of a user.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-watching
+func (s *Service) Count(ctx context.Context, userID int, opts ...*option.APIParamOption) (int, error) {
+	if err := validate.ValidateUserID(userID); err != nil {
+		return 0, err
+	}
+
+	query := url.Values{}
+	if err := option.ApplyOptions(query, countValidTypes, opts...); err != nil {
+		return 0, err
+	}
+
+	spath := path.Join("users", strconv.Itoa(userID), "watchings", "count")
+	resp, err := s.method.Get(ctx, spath, query)
+	if err != nil {
+		return 0, err
+	}
+
+	v := map[string]int{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return 0, err
+	}
+
+	return v["count"], nil
+}
+
+// One returns a single watching by its ID.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-watching
+func (s *Service) One(ctx context.Context, watchingID int) (*model.Watching, error) {
+	if err := validate.ValidateWatchingID(watchingID); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("watchings", strconv.Itoa(watchingID))
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Watching{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Add adds an issue to the watching list of the authenticated user.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-watching
+func (s *Service) Add(ctx context.Context, issueIDOrKey string, opts ...*option.APIParamOption) (*model.Watching, error) {
+	if err := validate.ValidateIssueIDOrKey(issueIDOrKey); err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("issueIdOrKey", issueIDOrKey)
+	if err := option.ApplyOptions(form, addValidTypes, opts...); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Post(ctx, "watchings", form)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Watching{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Update updates the note of a watching.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-watching
+func (s *Service) Update(ctx context.Context, watchingID int, note string) (*model.Watching, error) {
+	if err := validate.ValidateWatchingID(watchingID); err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set(option.ParamNote.Value(), note)
+
+	spath := path.Join("watchings", strconv.Itoa(watchingID))
+	resp, err := s.method.Patch(ctx, spath, form)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Watching{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Delete deletes a watching.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-watching
+func (s *Service) Delete(ctx context.Context, watchingID int) (*model.Watching, error) {
+	if err := validate.ValidateWatchingID(watchingID); err != nil {
+		return nil, err
+	}
+
+	spath := path.Join("watchings", strconv.Itoa(watchingID))
+	resp, err := s.method.Delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := model.Watching{}
+	if err := client.DecodeResponse(resp, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// MarkAsRead marks a watching as read.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/mark-watching-as-read
+func (s *Service) MarkAsRead(ctx context.Context, watchingID int) error {
+	if err := validate.ValidateWatchingID(watchingID); err != nil {
+		return err
+	}
+
+	spath := path.Join("watchings", strconv.Itoa(watchingID), "markAsRead")
+	if _, err := s.method.Post(ctx, spath, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewService(method *client.Method) *Service {
+	return &Service{method: method}
+}
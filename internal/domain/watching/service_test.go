@@ -0,0 +1,559 @@
+package watching_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/domain/watching"
+	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestService_List(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		userID    int
+		opts      []*option.APIParamOption
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr   bool
+		wantLen   int
+	}{
+		"success-no-options": {
+			userID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "users/1/watchings", spath)
+				assert.Empty(t, query)
+				return mock.NewResponse(fixture.Watching.ListJSON), nil
+			},
+			wantLen: 2,
+		},
+		"success-with-filters": {
+			userID: 1,
+			opts: []*option.APIParamOption{
+				o.WithOrder("asc"),
+				o.WithWatchingSort("issueUpdated"),
+				o.WithCount(20),
+				o.WithOffset(40),
+				o.WithResourceAlreadyRead(false),
+				o.WithIssueIDs([]int{1, 2}),
+			},
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "asc", query.Get("order"))
+				assert.Equal(t, "issueUpdated", query.Get("sort"))
+				assert.Equal(t, "20", query.Get("count"))
+				assert.Equal(t, "40", query.Get("offset"))
+				assert.Equal(t, "false", query.Get("resourceAlreadyRead"))
+				assert.Equal(t, []string{"1", "2"}, query["issueId[]"])
+				return mock.NewResponse(fixture.Watching.ListJSON), nil
+			},
+			wantLen: 2,
+		},
+		"error-invalid-userID": {
+			userID:  0,
+			wantErr: true,
+		},
+		"error-invalid-option-key": {
+			userID:  1,
+			opts:    []*option.APIParamOption{o.WithAlreadyRead(true)},
+			wantErr: true,
+		},
+		"error-invalid-sort": {
+			userID:  1,
+			opts:    []*option.APIParamOption{o.WithWatchingSort("summary")},
+			wantErr: true,
+		},
+		"error-client-network": {
+			userID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			userID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := watching.NewService(method)
+			got, err := s.List(context.Background(), tc.userID, tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, got, tc.wantLen)
+		})
+	}
+}
+
+func TestService_All(t *testing.T) {
+	o := &option.OptionService{}
+
+	t.Run("multiple-pages", func(t *testing.T) {
+		t.Parallel()
+
+		var offsets []string
+		method := mock.NewMethod(t)
+		method.Get = func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+			assert.Equal(t, "users/1/watchings", spath)
+			assert.Equal(t, "2", query.Get("count"))
+			assert.Equal(t, "true", query.Get("resourceAlreadyRead"))
+			offsets = append(offsets, query.Get("offset"))
+			if query.Get("offset") == "0" {
+				return mock.NewResponse(fixture.Watching.ListJSON), nil
+			}
+			return mock.NewResponse(`[{"id":3}]`), nil
+		}
+
+		s := watching.NewService(method)
+		seq, err := s.All(context.Background(), 2, 1, o.WithResourceAlreadyRead(true))
+		require.NoError(t, err)
+
+		var ids []int
+		for v, err := range seq {
+			require.NoError(t, err)
+			ids = append(ids, v.ID)
+		}
+		assert.Equal(t, []int{1, 2, 3}, ids)
+		assert.Equal(t, []string{"0", "2"}, offsets)
+	})
+
+	t.Run("stops-on-error", func(t *testing.T) {
+		t.Parallel()
+
+		method := mock.NewMethod(t)
+		method.Get = func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+			return nil, errors.New("network error")
+		}
+
+		s := watching.NewService(method)
+		seq, err := s.All(context.Background(), 2, 1)
+		require.NoError(t, err)
+
+		count := 0
+		for v, err := range seq {
+			count++
+			assert.Nil(t, v)
+			assert.Error(t, err)
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	cases := map[string]struct {
+		userID  int
+		perPage int
+		opts    []*option.APIParamOption
+	}{
+		"error-invalid-userID":  {userID: 0, perPage: 10},
+		"error-invalid-perPage": {userID: 1, perPage: 0},
+		"error-offset-option":   {userID: 1, perPage: 10, opts: []*option.APIParamOption{o.WithOffset(1)}},
+		"error-count-option":    {userID: 1, perPage: 10, opts: []*option.APIParamOption{o.WithCount(1)}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := watching.NewService(mock.NewMethod(t))
+			seq, err := s.All(context.Background(), tc.perPage, tc.userID, tc.opts...)
+			assert.Error(t, err)
+			assert.Nil(t, seq)
+		})
+	}
+}
+
+func TestService_Count(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		userID    int
+		opts      []*option.APIParamOption
+		mockGetFn func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr   bool
+		want      int
+	}{
+		"success": {
+			userID: 1,
+			opts:   []*option.APIParamOption{o.WithAlreadyRead(false), o.WithResourceAlreadyRead(true)},
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "users/1/watchings/count", spath)
+				assert.Equal(t, "false", query.Get("alreadyRead"))
+				assert.Equal(t, "true", query.Get("resourceAlreadyRead"))
+				return mock.NewResponse(fixture.Watching.CountJSON), nil
+			},
+			want: 138,
+		},
+		"error-invalid-userID": {
+			userID:  0,
+			wantErr: true,
+		},
+		"error-invalid-option-key": {
+			userID:  1,
+			opts:    []*option.APIParamOption{o.WithCount(10)},
+			wantErr: true,
+		},
+		"error-client-network": {
+			userID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			userID: 1,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := watching.NewService(method)
+			got, err := s.Count(context.Background(), tc.userID, tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestService_One(t *testing.T) {
+	cases := map[string]struct {
+		watchingID int
+		mockGetFn  func(ctx context.Context, spath string, query url.Values) (*http.Response, error)
+		wantErr    bool
+	}{
+		"success": {
+			watchingID: 2,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				assert.Equal(t, "watchings/2", spath)
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+		},
+		"error-invalid-watchingID": {
+			watchingID: 0,
+			wantErr:    true,
+		},
+		"error-client-network": {
+			watchingID: 2,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			watchingID: 2,
+			mockGetFn: func(ctx context.Context, spath string, query url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockGetFn != nil {
+				method.Get = tc.mockGetFn
+			}
+
+			s := watching.NewService(method)
+			got, err := s.One(context.Background(), tc.watchingID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, got.ID)
+			assert.True(t, got.ResourceAlreadyRead)
+			assert.Equal(t, "TEST-2", got.Issue.IssueKey)
+		})
+	}
+}
+
+func TestService_Add(t *testing.T) {
+	o := &option.OptionService{}
+
+	cases := map[string]struct {
+		issueIDOrKey string
+		opts         []*option.APIParamOption
+		mockPostFn   func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr      bool
+	}{
+		"success": {
+			issueIDOrKey: "TEST-2",
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "watchings", spath)
+				assert.Equal(t, "TEST-2", form.Get("issueIdOrKey"))
+				assert.False(t, form.Has("note"))
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+		},
+		"success-with-note": {
+			issueIDOrKey: "TEST-2",
+			opts:         []*option.APIParamOption{o.WithNote("check later")},
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "check later", form.Get("note"))
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+		},
+		"error-empty-issueIDOrKey": {
+			issueIDOrKey: "",
+			wantErr:      true,
+		},
+		"error-invalid-option-key": {
+			issueIDOrKey: "TEST-2",
+			opts:         []*option.APIParamOption{o.WithCount(1)},
+			wantErr:      true,
+		},
+		"error-client-network": {
+			issueIDOrKey: "TEST-2",
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			issueIDOrKey: "TEST-2",
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPostFn != nil {
+				method.Post = tc.mockPostFn
+			}
+
+			s := watching.NewService(method)
+			got, err := s.Add(context.Background(), tc.issueIDOrKey, tc.opts...)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, got.ID)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	cases := map[string]struct {
+		watchingID  int
+		note        string
+		mockPatchFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr     bool
+	}{
+		"success": {
+			watchingID: 2,
+			note:       "check later",
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "watchings/2", spath)
+				assert.Equal(t, "check later", form.Get("note"))
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+		},
+		"success-clear-note": {
+			watchingID: 2,
+			note:       "",
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.True(t, form.Has("note"))
+				assert.Equal(t, "", form.Get("note"))
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+		},
+		"error-invalid-watchingID": {
+			watchingID: 0,
+			wantErr:    true,
+		},
+		"error-client-network": {
+			watchingID: 2,
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			watchingID: 2,
+			mockPatchFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPatchFn != nil {
+				method.Patch = tc.mockPatchFn
+			}
+
+			s := watching.NewService(method)
+			got, err := s.Update(context.Background(), tc.watchingID, tc.note)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, got.ID)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	cases := map[string]struct {
+		watchingID   int
+		mockDeleteFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr      bool
+	}{
+		"success": {
+			watchingID: 2,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "watchings/2", spath)
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+		},
+		"error-invalid-watchingID": {
+			watchingID: -1,
+			wantErr:    true,
+		},
+		"error-client-network": {
+			watchingID: 2,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+		"error-json-decode": {
+			watchingID: 2,
+			mockDeleteFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return mock.NewResponse(fixture.InvalidJSON), nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockDeleteFn != nil {
+				method.Delete = tc.mockDeleteFn
+			}
+
+			s := watching.NewService(method)
+			got, err := s.Delete(context.Background(), tc.watchingID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, got.ID)
+		})
+	}
+}
+
+func TestService_MarkAsRead(t *testing.T) {
+	cases := map[string]struct {
+		watchingID int
+		mockPostFn func(ctx context.Context, spath string, form url.Values) (*http.Response, error)
+		wantErr    bool
+	}{
+		"success": {
+			watchingID: 2,
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				assert.Equal(t, "watchings/2/markAsRead", spath)
+				return nil, nil
+			},
+		},
+		"error-invalid-watchingID": {
+			watchingID: 0,
+			wantErr:    true,
+		},
+		"error-client-network": {
+			watchingID: 2,
+			mockPostFn: func(ctx context.Context, spath string, form url.Values) (*http.Response, error) {
+				return nil, errors.New("network error")
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := mock.NewMethod(t)
+			if tc.mockPostFn != nil {
+				method.Post = tc.mockPostFn
+			}
+
+			s := watching.NewService(method)
+			err := s.MarkAsRead(context.Background(), tc.watchingID)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
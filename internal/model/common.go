@@ -69,6 +69,7 @@ type FileData struct {
 	Body        io.ReadCloser
 	Filename    string
 	ContentType string
+	Size        int64
 }
 
 // Licence represents the licence settings of a Backlog space.
@@ -157,12 +158,13 @@ type Team struct {
 
 // Watching represents an entry in a user's watching list.
 type Watching struct {
-	ID                 int       `json:"id,omitempty"`
-	AlreadyRead        bool      `json:"alreadyRead,omitempty"`
-	Note               string    `json:"note,omitempty"`
-	Type               string    `json:"type,omitempty"`
-	Issue              *Issue    `json:"issue,omitempty"`
-	LastContentUpdated time.Time `json:"lastContentUpdated,omitempty"`
-	Created            time.Time `json:"created,omitempty"`
-	Updated            time.Time `json:"updated,omitempty"`
+	ID                  int       `json:"id,omitempty"`
+	AlreadyRead         bool      `json:"alreadyRead,omitempty"`
+	ResourceAlreadyRead bool      `json:"resourceAlreadyRead,omitempty"`
+	Note                string    `json:"note,omitempty"`
+	Type                string    `json:"type,omitempty"`
+	Issue               *Issue    `json:"issue,omitempty"`
+	LastContentUpdated  time.Time `json:"lastContentUpdated,omitempty"`
+	Created             time.Time `json:"created,omitempty"`
+	Updated             time.Time `json:"updated,omitempty"`
 }
@@ -1,36 +1,49 @@
 package model
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Issue represents a Backlog issue.
 type Issue struct {
-	ID             int            `json:"id,omitempty"`
-	ProjectID      int            `json:"projectId,omitempty"`
-	IssueKey       string         `json:"issueKey,omitempty"`
-	KeyID          int            `json:"keyId,omitempty"`
-	IssueType      *IssueType     `json:"issueType,omitempty"`
-	Summary        string         `json:"summary,omitempty"`
-	Description    string         `json:"description,omitempty"`
-	Resolutions    []*Resolution  `json:"resolutions,omitempty"`
-	Priority       *Priority      `json:"priority,omitempty"`
-	Status         *Status        `json:"status,omitempty"`
-	Assignee       *User          `json:"assignee,omitempty"`
-	Category       []*Category    `json:"category,omitempty"`
-	Versions       []*Version     `json:"versions,omitempty"`
-	Milestone      []*Version     `json:"milestone,omitempty"`
-	StartDate      string         `json:"startDate,omitempty"`
-	DueDate        string         `json:"dueDate,omitempty"`
-	EstimatedHours float64        `json:"estimatedHours,omitempty"`
-	ActualHours    float64        `json:"actualHours,omitempty"`
-	ParentIssueID  int            `json:"parentIssueId,omitempty"`
-	CreatedUser    *User          `json:"createdUser,omitempty"`
-	Created        time.Time      `json:"created,omitempty"`
-	UpdatedUser    *User          `json:"updatedUser,omitempty"`
-	Updated        time.Time      `json:"updated,omitempty"`
-	CustomFields   []*CustomField `json:"customFields,omitempty"`
-	Attachments    []*Attachment  `json:"attachments,omitempty"`
-	SharedFiles    []*SharedFile  `json:"sharedFiles,omitempty"`
-	Stars          []*Star        `json:"stars,omitempty"`
+	ID             int                 `json:"id,omitempty"`
+	ProjectID      int                 `json:"projectId,omitempty"`
+	IssueKey       string              `json:"issueKey,omitempty"`
+	KeyID          int                 `json:"keyId,omitempty"`
+	IssueType      *IssueType          `json:"issueType,omitempty"`
+	Summary        string              `json:"summary,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	Resolutions    []*Resolution       `json:"resolutions,omitempty"`
+	Priority       *Priority           `json:"priority,omitempty"`
+	Status         *Status             `json:"status,omitempty"`
+	Assignee       *User               `json:"assignee,omitempty"`
+	Category       []*Category         `json:"category,omitempty"`
+	Versions       []*Version          `json:"versions,omitempty"`
+	Milestone      []*Version          `json:"milestone,omitempty"`
+	StartDate      string              `json:"startDate,omitempty"`
+	DueDate        string              `json:"dueDate,omitempty"`
+	EstimatedHours float64             `json:"estimatedHours,omitempty"`
+	ActualHours    float64             `json:"actualHours,omitempty"`
+	ParentIssueID  int                 `json:"parentIssueId,omitempty"`
+	CreatedUser    *User               `json:"createdUser,omitempty"`
+	Created        time.Time           `json:"created,omitempty"`
+	UpdatedUser    *User               `json:"updatedUser,omitempty"`
+	Updated        time.Time           `json:"updated,omitempty"`
+	CustomFields   []*IssueCustomField `json:"customFields,omitempty"`
+	Attachments    []*Attachment       `json:"attachments,omitempty"`
+	SharedFiles    []*SharedFile       `json:"sharedFiles,omitempty"`
+	Stars          []*Star             `json:"stars,omitempty"`
+}
+
+// IssueCustomField represents the value of a custom field set on an issue.
+// Value is kept as raw JSON because its shape depends on FieldTypeID.
+type IssueCustomField struct {
+	ID          int             `json:"id,omitempty"`
+	FieldTypeID int             `json:"fieldTypeId,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Value       json.RawMessage `json:"value,omitempty"`
+	OtherValue  string          `json:"otherValue,omitempty"`
 }
 
 // IssueType represents the type of an issue.
@@ -27,3 +27,24 @@ type SpaceNotification struct {
 	Content string    `json:"content,omitempty"`
 	Updated time.Time `json:"updated,omitempty"`
 }
+
+// RateLimitStatus represents the response of the rate limit endpoint.
+type RateLimitStatus struct {
+	RateLimit RateLimitCategories `json:"rateLimit"`
+}
+
+// RateLimitCategories holds the rate limit of each API category.
+type RateLimitCategories struct {
+	Read   *RateLimitCategory `json:"read,omitempty"`
+	Update *RateLimitCategory `json:"update,omitempty"`
+	Search *RateLimitCategory `json:"search,omitempty"`
+	Icon   *RateLimitCategory `json:"icon,omitempty"`
+}
+
+// RateLimitCategory represents the rate limit of a single API category.
+// Reset is a Unix time in seconds.
+type RateLimitCategory struct {
+	Limit     int   `json:"limit"`
+	Remaining int   `json:"remaining"`
+	Reset     int64 `json:"reset"`
+}
@@ -18,6 +18,7 @@ const (
 	ParamAllEvent                          APIParamOptionType = "allEvent"
 	ParamAllowAddItem                      APIParamOptionType = "allowAddItem"
 	ParamAllowInput                        APIParamOptionType = "allowInput"
+	ParamAlreadyRead                       APIParamOptionType = "alreadyRead"
 	ParamApplicableIssueTypeIDs            APIParamOptionType = "applicableIssueTypes[]"
 	ParamArchived                          APIParamOptionType = "archived"
 	ParamAssigneeID                        APIParamOptionType = "assigneeId"
@@ -61,10 +62,12 @@ const (
 	ParamMailNotify                        APIParamOptionType = "mailNotify"
 	ParamMax                               APIParamOptionType = "max"
 	ParamMaxID                             APIParamOptionType = "maxId"
+	ParamMembers                           APIParamOptionType = "members[]"
 	ParamMilestoneIDs                      APIParamOptionType = "milestoneId[]"
 	ParamMin                               APIParamOptionType = "min"
 	ParamMinID                             APIParamOptionType = "minId"
 	ParamName                              APIParamOptionType = "name"
+	ParamNote                              APIParamOptionType = "note"
 	ParamNotifiedUserIDs                   APIParamOptionType = "notifiedUserId[]"
 	ParamOffset                            APIParamOptionType = "offset"
 	ParamOrder                             APIParamOptionType = "order"
@@ -82,8 +85,10 @@ const (
 	ParamRequired                          APIParamOptionType = "required"
 	ParamResolutionID                      APIParamOptionType = "resolutionId"
 	ParamResolutionIDs                     APIParamOptionType = "resolutionId[]"
+	ParamResourceAlreadyRead               APIParamOptionType = "resourceAlreadyRead"
 	ParamRoleType                          APIParamOptionType = "roleType"
 	ParamSendMail                          APIParamOptionType = "sendMail"
+	ParamSenderID                          APIParamOptionType = "senderId"
 	ParamSharedFile                        APIParamOptionType = "sharedFile"
 	ParamSort                              APIParamOptionType = "sort"
 	ParamStartDate                         APIParamOptionType = "startDate"
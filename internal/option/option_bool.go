@@ -21,6 +21,10 @@ func (s *OptionService) WithAllowInput(allowInput bool) *APIParamOption {
 	return boolOption(ParamAllowInput, allowInput)
 }
 
+func (s *OptionService) WithAlreadyRead(enabled bool) *APIParamOption {
+	return boolOption(ParamAlreadyRead, enabled)
+}
+
 func (s *OptionService) WithArchived(enabled bool) *APIParamOption {
 	return boolOption(ParamArchived, enabled)
 }
@@ -53,6 +57,10 @@ func (s *OptionService) WithRequired(required bool) *APIParamOption {
 	return boolOption(ParamRequired, required)
 }
 
+func (s *OptionService) WithResourceAlreadyRead(enabled bool) *APIParamOption {
+	return boolOption(ParamResourceAlreadyRead, enabled)
+}
+
 func (s *OptionService) WithSendMail(enabled bool) *APIParamOption {
 	return boolOption(ParamSendMail, enabled)
 }
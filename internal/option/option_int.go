@@ -100,8 +100,15 @@ func (s *OptionService) WithPullRequestID(id int) *APIParamOption {
 	return positiveIntOption(ParamPullRequestID, id)
 }
 
+// WithResolutionID sets `resolutionId`. 0 is valid (the built-in "Fixed" resolution).
 func (s *OptionService) WithResolutionID(id int) *APIParamOption {
-	return positiveIntOption(ParamResolutionID, id)
+	return &APIParamOption{
+		Type: ParamResolutionID,
+		CheckFunc: func() *validation.Error {
+			return validate.ValidateNonNegativeInt(ParamResolutionID.Value(), id)
+		},
+		SetFunc: setIntFunc(ParamResolutionID, id),
+	}
 }
 
 // WithRoleType sets `roleType`. Valid range: 1–6.
@@ -109,6 +116,10 @@ func (s *OptionService) WithRoleType(roleType int) *APIParamOption {
 	return intRangeOption(ParamRoleType, int(roleType), 1, 6)
 }
 
+func (s *OptionService) WithSenderID(id int) *APIParamOption {
+	return positiveIntOption(ParamSenderID, id)
+}
+
 func (s *OptionService) WithStatusID(id int) *APIParamOption {
 	return positiveIntOption(ParamStatusID, id)
 }
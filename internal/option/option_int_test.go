@@ -281,8 +281,13 @@ func TestOptionService_int(t *testing.T) {
 			key:       option.ParamResolutionID.Value(),
 			wantValue: 1,
 		},
-		"WithResolutionID-invalid-0": {
-			option:  o.WithResolutionID(0),
+		"WithResolutionID-valid-0": {
+			option:    o.WithResolutionID(0),
+			key:       option.ParamResolutionID.Value(),
+			wantValue: 0,
+		},
+		"WithResolutionID-invalid-negative": {
+			option:  o.WithResolutionID(-1),
 			wantErr: true,
 		},
 		"WithRoleType-valid-1": {
@@ -312,6 +317,15 @@ func TestOptionService_int(t *testing.T) {
 			option:  o.WithStatusID(0),
 			wantErr: true,
 		},
+		"WithSenderID-valid-1": {
+			option:    o.WithSenderID(1),
+			key:       option.ParamSenderID.Value(),
+			wantValue: 1,
+		},
+		"WithSenderID-invalid-0": {
+			option:  o.WithSenderID(0),
+			wantErr: true,
+		},
 		"WithUserID-valid-1": {
 			option:    o.WithUserID(1),
 			key:       option.ParamUserID.Value(),
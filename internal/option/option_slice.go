@@ -48,6 +48,11 @@ func (s *OptionService) WithItems(items []string) *APIParamOption {
 	}
 }
 
+// WithMembers sets `members[]` to the user IDs of team members.
+func (s *OptionService) WithMembers(userIDs []int) *APIParamOption {
+	return positiveIntSliceOption(ParamMembers, "members", userIDs)
+}
+
 func (s *OptionService) WithProjectIDs(ids []int) *APIParamOption {
 	return positiveIntSliceOption(ParamProjectIDs, "projectId", ids)
 }
@@ -92,8 +97,15 @@ func (s *OptionService) WithCreatedUserIDs(ids []int) *APIParamOption {
 	return positiveIntSliceOption(ParamCreatedUserIDs, "createdUserId", ids)
 }
 
+// WithResolutionIDs sets `resolutionId[]`. 0 is valid (the built-in "Fixed" resolution).
 func (s *OptionService) WithResolutionIDs(ids []int) *APIParamOption {
-	return positiveIntSliceOption(ParamResolutionIDs, "resolutionId", ids)
+	return &APIParamOption{
+		Type: ParamResolutionIDs,
+		CheckFunc: func() *validation.Error {
+			return validate.ValidateNonNegativeInts("resolutionId", ids)
+		},
+		SetFunc: addIntFunc(ParamResolutionIDs, ids),
+	}
 }
 
 func (s *OptionService) WithIDs(ids []int) *APIParamOption {
@@ -117,6 +117,15 @@ func TestOptionService_slice(t *testing.T) {
 			option:  o.WithIssueIDs([]int{0}),
 			wantErr: true,
 		},
+		"WithMembers-valid": {
+			option:   o.WithMembers([]int{1, 2}),
+			key:      option.ParamMembers.Value(),
+			wantVals: []string{"1", "2"},
+		},
+		"WithMembers-invalid-0": {
+			option:  o.WithMembers([]int{0}),
+			wantErr: true,
+		},
 		"WithNotifiedUserIDs-valid": {
 			option:   o.WithNotifiedUserIDs([]int{1}),
 			key:      option.ParamNotifiedUserIDs.Value(),
@@ -167,8 +176,13 @@ func TestOptionService_slice(t *testing.T) {
 			key:      option.ParamResolutionIDs.Value(),
 			wantVals: []string{"1"},
 		},
-		"WithResolutionIDs-invalid-0": {
-			option:  o.WithResolutionIDs([]int{0}),
+		"WithResolutionIDs-valid-0": {
+			option:   o.WithResolutionIDs([]int{0, 1}),
+			key:      option.ParamResolutionIDs.Value(),
+			wantVals: []string{"0", "1"},
+		},
+		"WithResolutionIDs-invalid-negative": {
+			option:  o.WithResolutionIDs([]int{-1}),
 			wantErr: true,
 		},
 		"WithIDs-valid": {
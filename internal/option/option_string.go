@@ -76,6 +76,13 @@ func (s *OptionService) WithName(name string) *APIParamOption {
 	return nonEmptyStringOption(ParamName, name)
 }
 
+func (s *OptionService) WithNote(note string) *APIParamOption {
+	return &APIParamOption{
+		Type:    ParamNote,
+		SetFunc: setStringFunc(ParamNote, note),
+	}
+}
+
 func (s *OptionService) WithOrder(order string) *APIParamOption {
 	return &APIParamOption{
 		Type: ParamOrder,
@@ -124,6 +131,16 @@ func (s *OptionService) WithTextFormattingRule(format string) *APIParamOption {
 	}
 }
 
+func (s *OptionService) WithWatchingSort(sort string) *APIParamOption {
+	return &APIParamOption{
+		Type: ParamSort,
+		CheckFunc: func() *validation.Error {
+			return validate.ValidateWatchingSort(ParamSort.Value(), sort)
+		},
+		SetFunc: setStringFunc(ParamSort, sort),
+	}
+}
+
 func (s *OptionService) WithUnit(unit string) *APIParamOption {
 	return &APIParamOption{
 		Type:    ParamUnit,
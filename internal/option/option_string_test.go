@@ -199,6 +199,16 @@ func TestOptionService_string(t *testing.T) {
 			key:       option.ParamSummary.Value(),
 			wantValue: "summary",
 		},
+		"WithNote-empty": {
+			option:    o.WithNote(""),
+			key:       option.ParamNote.Value(),
+			wantValue: "",
+		},
+		"WithNote-non-empty": {
+			option:    o.WithNote("follow up"),
+			key:       option.ParamNote.Value(),
+			wantValue: "follow up",
+		},
 		"WithTemplateDescription-empty": {
 			option:    o.WithTemplateDescription(""),
 			key:       option.ParamTemplateDescription.Value(),
@@ -354,4 +364,36 @@ func TestOptionService_string(t *testing.T) {
 			})
 		}
 	})
+
+	// --- WatchingSort option ------------------------------------------------------
+	t.Run("WithWatchingSort", func(t *testing.T) {
+		cases := map[string]struct {
+			sort    string
+			wantErr bool
+		}{
+			"created":      {sort: "created"},
+			"updated":      {sort: "updated"},
+			"issueUpdated": {sort: "issueUpdated"},
+
+			"empty":      {sort: "", wantErr: true},
+			"issue-sort": {sort: "summary", wantErr: true},
+		}
+
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				opt := o.WithWatchingSort(tc.sort)
+				q := url.Values{}
+				ve := opt.Check()
+				if tc.wantErr {
+					assert.NotNil(t, ve)
+					return
+				}
+				require.Nil(t, ve)
+				_ = opt.Set(q)
+				assert.Equal(t, tc.sort, q.Get(option.ParamSort.Value()))
+			})
+		}
+	})
 }
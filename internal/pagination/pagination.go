@@ -1,10 +1,17 @@
-// Package pagination provides a generic helper for iterating over
-// offset-based paginated Backlog API list endpoints.
+// Package pagination provides generic helpers for iterating over
+// paginated Backlog API list endpoints.
 package pagination
 
 import (
 	"context"
 	"iter"
+	"maps"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nattokin/go-backlog/internal/option"
 )
 
 // All returns an iter.Seq2 that drives offset-based pagination over any list endpoint.
@@ -35,3 +42,185 @@ func All[T any](
 		}
 	}
 }
+
+// Cursor returns an iter.Seq2 that drives ID cursor-based pagination over list
+// endpoints that page by minId and maxId.
+//
+// query is the base query of every request. Its order value selects the
+// traversal direction: "asc" walks IDs upwards by advancing minId, anything
+// else walks them downwards by advancing maxId, matching the API default.
+// Each page is fetched with a copy of query whose cursor parameter is set to
+// the ID of the last item yielded; the first page is fetched with query as is.
+//
+// id must return the ID of an item. Items not past the cursor are skipped, so
+// the endpoint may treat minId and maxId as either inclusive or exclusive.
+// Iteration stops when a page is shorter than perPage or contains no new items.
+func Cursor[T any](
+	ctx context.Context,
+	perPage int,
+	query url.Values,
+	id func(*T) int,
+	fetch func(ctx context.Context, query url.Values) ([]*T, error),
+) iter.Seq2[*T, error] {
+	ascending := query.Get(option.ParamOrder.Value()) == "asc"
+	cursorKey := option.ParamMaxID.Value()
+	if ascending {
+		cursorKey = option.ParamMinID.Value()
+	}
+
+	return func(yield func(*T, error) bool) {
+		cursor := 0
+		for {
+			q := query
+			if cursor != 0 {
+				q = maps.Clone(query)
+				q.Set(cursorKey, strconv.Itoa(cursor))
+			}
+
+			items, err := fetch(ctx, q)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			advanced := false
+			for _, item := range items {
+				v := id(item)
+				if cursor != 0 && (ascending && v <= cursor || !ascending && v >= cursor) {
+					continue
+				}
+				if !yield(item, nil) {
+					return
+				}
+				cursor = v
+				advanced = true
+			}
+			if len(items) < perPage || !advanced {
+				return
+			}
+		}
+	}
+}
+
+// Prefetch returns an iter.Seq2 that drives offset-based pagination like All,
+// but fetches up to concurrency pages ahead in parallel while still yielding
+// items in order.
+//
+// count must return the total number of items, which determines how many
+// pages are fetched in parallel. If the last of those pages is full, the
+// remaining items are fetched sequentially as All does, so a stale count does
+// not truncate the results.
+//
+// budget, if non-nil, must return the number of requests that may still be
+// sent in the current rate limit window, or a negative value if unknown.
+// No more pages than that are requested at the same time, but always at least one.
+//
+// When the consumer stops iterating, in-flight requests are cancelled and the
+// iterator returns once they have finished.
+func Prefetch[T any](
+	ctx context.Context,
+	perPage int,
+	concurrency int,
+	budget func() int,
+	count func(ctx context.Context) (int, error),
+	fetch func(ctx context.Context, offset int) ([]*T, error),
+) iter.Seq2[*T, error] {
+	type page struct {
+		items []*T
+		err   error
+	}
+
+	return func(yield func(*T, error) bool) {
+		total, err := count(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		pages := (total + perPage - 1) / perPage
+
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+
+		results := make([]chan page, pages)
+		for i := range results {
+			results[i] = make(chan page, 1)
+		}
+
+		// slots bounds the number of pages fetched but not yet consumed.
+		slots := make(chan struct{}, concurrency)
+		done := make(chan struct{}, 1)
+		var inFlight atomic.Int32
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range pages {
+				select {
+				case slots <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				for budget != nil {
+					limit := budget()
+					if limit < 0 || int(inFlight.Load()) < max(limit, 1) {
+						break
+					}
+					select {
+					case <-done:
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				inFlight.Add(1)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					items, err := fetch(ctx, i*perPage)
+					inFlight.Add(-1)
+					select {
+					case done <- struct{}{}:
+					default:
+					}
+					results[i] <- page{items: items, err: err}
+				}()
+			}
+		}()
+
+		for i := range pages {
+			var p page
+			select {
+			case p = <-results[i]:
+			case <-ctx.Done():
+				yield(nil, ctx.Err())
+				return
+			}
+			if p.err != nil {
+				yield(nil, p.err)
+				return
+			}
+			for _, item := range p.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			<-slots
+			if len(p.items) < perPage {
+				return
+			}
+		}
+
+		offset := pages * perPage
+		for item, err := range All(ctx, perPage, func(ctx context.Context, o int) ([]*T, error) {
+			return fetch(ctx, offset+o)
+		}) {
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
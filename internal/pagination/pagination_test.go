@@ -3,7 +3,11 @@ package pagination_test
 import (
 	"context"
 	"errors"
+	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,3 +123,358 @@ func TestAll(t *testing.T) {
 		assert.Equal(t, 1, calls, "breaking out of the range loop must stop further fetches")
 	})
 }
+
+type item struct {
+	ID int
+}
+
+func itemID(v *item) int { return v.ID }
+
+func items(ids ...int) []*item {
+	out := make([]*item, len(ids))
+	for i, id := range ids {
+		out[i] = &item{ID: id}
+	}
+	return out
+}
+
+func collectIDs(t *testing.T, seq func(yield func(*item, error) bool)) []int {
+	t.Helper()
+
+	var got []int
+	for v, err := range seq {
+		require.NoError(t, err)
+		got = append(got, v.ID)
+	}
+	return got
+}
+
+func TestCursor(t *testing.T) {
+	t.Run("descending-by-default", func(t *testing.T) {
+		t.Parallel()
+
+		pages := [][]*item{items(9, 8), items(7, 6), items(5)}
+		var maxIDs []string
+
+		fetch := func(ctx context.Context, query url.Values) ([]*item, error) {
+			assert.Empty(t, query.Get("minId"))
+			maxIDs = append(maxIDs, query.Get("maxId"))
+			page := pages[0]
+			pages = pages[1:]
+			return page, nil
+		}
+
+		query := url.Values{"count": {"2"}}
+		got := collectIDs(t, pagination.Cursor(context.Background(), 2, query, itemID, fetch))
+
+		assert.Equal(t, []int{9, 8, 7, 6, 5}, got)
+		assert.Equal(t, []string{"", "8", "6"}, maxIDs, "maxId must move to the last ID seen on each page")
+		assert.Equal(t, url.Values{"count": {"2"}}, query, "the base query must not be modified")
+	})
+
+	t.Run("ascending", func(t *testing.T) {
+		t.Parallel()
+
+		pages := [][]*item{items(1, 2), items(3, 4), items()}
+		var minIDs []string
+
+		fetch := func(ctx context.Context, query url.Values) ([]*item, error) {
+			assert.Empty(t, query.Get("maxId"))
+			assert.Equal(t, "asc", query.Get("order"))
+			minIDs = append(minIDs, query.Get("minId"))
+			page := pages[0]
+			pages = pages[1:]
+			return page, nil
+		}
+
+		query := url.Values{"order": {"asc"}}
+		got := collectIDs(t, pagination.Cursor(context.Background(), 2, query, itemID, fetch))
+
+		assert.Equal(t, []int{1, 2, 3, 4}, got)
+		assert.Equal(t, []string{"", "2", "4"}, minIDs)
+	})
+
+	t.Run("inclusive-cursor", func(t *testing.T) {
+		t.Parallel()
+
+		pages := [][]*item{items(1, 2), items(2, 3), items(3)}
+		calls := 0
+
+		fetch := func(ctx context.Context, query url.Values) ([]*item, error) {
+			calls++
+			page := pages[0]
+			pages = pages[1:]
+			return page, nil
+		}
+
+		query := url.Values{"order": {"asc"}}
+		got := collectIDs(t, pagination.Cursor(context.Background(), 2, query, itemID, fetch))
+
+		assert.Equal(t, []int{1, 2, 3}, got, "items at the cursor must not be yielded twice")
+		assert.Equal(t, 3, calls, "a short page must stop iteration")
+	})
+
+	t.Run("no-new-items", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		fetch := func(ctx context.Context, query url.Values) ([]*item, error) {
+			calls++
+			return items(5, 4), nil
+		}
+
+		got := collectIDs(t, pagination.Cursor(context.Background(), 2, url.Values{}, itemID, fetch))
+
+		assert.Equal(t, []int{5, 4}, got)
+		assert.Equal(t, 2, calls, "a full page without new items must stop iteration")
+	})
+
+	t.Run("fetch-error", func(t *testing.T) {
+		t.Parallel()
+
+		wantErr := errors.New("fetch failed")
+		pages := [][]*item{items(9, 8)}
+		fetch := func(ctx context.Context, query url.Values) ([]*item, error) {
+			if len(pages) == 0 {
+				return nil, wantErr
+			}
+			page := pages[0]
+			pages = pages[1:]
+			return page, nil
+		}
+
+		var got []int
+		var gotErr error
+		for v, err := range pagination.Cursor(context.Background(), 2, url.Values{}, itemID, fetch) {
+			if err != nil {
+				gotErr = err
+				continue
+			}
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{9, 8}, got)
+		assert.Equal(t, wantErr, gotErr)
+	})
+
+	t.Run("early-stop", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		fetch := func(ctx context.Context, query url.Values) ([]*item, error) {
+			calls++
+			return items(9, 8), nil
+		}
+
+		var got []int
+		for v, err := range pagination.Cursor(context.Background(), 2, url.Values{}, itemID, fetch) {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+			break
+		}
+
+		assert.Equal(t, []int{9}, got)
+		assert.Equal(t, 1, calls, "breaking out of the range loop must stop further fetches")
+	})
+}
+
+// pagedSource serves ids 1..total in pages for Prefetch tests and records
+// the concurrency it observes.
+type pagedSource struct {
+	total int
+	delay func(offset int) time.Duration
+
+	mu       sync.Mutex
+	offsets  []int
+	inFlight int
+	peak     int
+}
+
+func (p *pagedSource) fetch(perPage int) func(ctx context.Context, offset int) ([]*item, error) {
+	return func(ctx context.Context, offset int) ([]*item, error) {
+		p.mu.Lock()
+		p.offsets = append(p.offsets, offset)
+		p.inFlight++
+		p.peak = max(p.peak, p.inFlight)
+		p.mu.Unlock()
+
+		defer func() {
+			p.mu.Lock()
+			p.inFlight--
+			p.mu.Unlock()
+		}()
+
+		if p.delay != nil {
+			select {
+			case <-time.After(p.delay(offset)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		var ids []int
+		for id := offset + 1; id <= min(offset+perPage, p.total); id++ {
+			ids = append(ids, id)
+		}
+		return items(ids...), nil
+	}
+}
+
+func staticCount(n int) func(ctx context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) { return n, nil }
+}
+
+func TestPrefetch(t *testing.T) {
+	t.Run("yields-in-order", func(t *testing.T) {
+		t.Parallel()
+
+		// Later pages finish first, so the results must be reordered.
+		src := &pagedSource{total: 9, delay: func(offset int) time.Duration {
+			return time.Duration(9-offset) * time.Millisecond
+		}}
+		seq := pagination.Prefetch(context.Background(), 2, 3, nil, staticCount(9), src.fetch(2))
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, collectIDs(t, seq))
+		assert.ElementsMatch(t, []int{0, 2, 4, 6, 8}, src.offsets)
+		assert.LessOrEqual(t, src.peak, 3)
+	})
+
+	t.Run("fetches-in-parallel", func(t *testing.T) {
+		t.Parallel()
+
+		src := &pagedSource{total: 8, delay: func(int) time.Duration { return 20 * time.Millisecond }}
+		seq := pagination.Prefetch(context.Background(), 2, 4, nil, staticCount(8), src.fetch(2))
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, collectIDs(t, seq))
+		assert.Greater(t, src.peak, 1)
+	})
+
+	t.Run("budget-limits-parallelism", func(t *testing.T) {
+		t.Parallel()
+
+		src := &pagedSource{total: 8, delay: func(int) time.Duration { return 5 * time.Millisecond }}
+		budget := func() int { return 0 }
+		seq := pagination.Prefetch(context.Background(), 2, 4, budget, staticCount(8), src.fetch(2))
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, collectIDs(t, seq))
+		assert.Equal(t, 1, src.peak, "an exhausted budget must still allow one request at a time")
+	})
+
+	t.Run("stale-count", func(t *testing.T) {
+		t.Parallel()
+
+		// More items exist than counted, so fetching continues sequentially.
+		src := &pagedSource{total: 7}
+		seq := pagination.Prefetch(context.Background(), 2, 2, nil, staticCount(3), src.fetch(2))
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, collectIDs(t, seq))
+	})
+
+	t.Run("zero-count", func(t *testing.T) {
+		t.Parallel()
+
+		src := &pagedSource{total: 1}
+		seq := pagination.Prefetch(context.Background(), 2, 2, nil, staticCount(0), src.fetch(2))
+
+		assert.Equal(t, []int{1}, collectIDs(t, seq))
+	})
+
+	t.Run("short-page", func(t *testing.T) {
+		t.Parallel()
+
+		// Fewer items exist than counted.
+		src := &pagedSource{total: 3}
+		seq := pagination.Prefetch(context.Background(), 2, 1, nil, staticCount(6), src.fetch(2))
+
+		assert.Equal(t, []int{1, 2, 3}, collectIDs(t, seq))
+	})
+
+	t.Run("count-error", func(t *testing.T) {
+		t.Parallel()
+
+		wantErr := errors.New("count failed")
+		count := func(ctx context.Context) (int, error) { return 0, wantErr }
+		fetch := func(ctx context.Context, offset int) ([]*item, error) {
+			t.Error("fetch must not be called")
+			return nil, nil
+		}
+
+		var gotErr error
+		for _, err := range pagination.Prefetch(context.Background(), 2, 2, nil, count, fetch) {
+			gotErr = err
+		}
+		assert.Equal(t, wantErr, gotErr)
+	})
+
+	t.Run("fetch-error", func(t *testing.T) {
+		t.Parallel()
+
+		wantErr := errors.New("fetch failed")
+		fetch := func(ctx context.Context, offset int) ([]*item, error) {
+			if offset == 2 {
+				return nil, wantErr
+			}
+			return items(offset+1, offset+2), nil
+		}
+
+		var got []int
+		var gotErr error
+		for v, err := range pagination.Prefetch(context.Background(), 2, 3, nil, staticCount(6), fetch) {
+			if err != nil {
+				gotErr = err
+				continue
+			}
+			got = append(got, v.ID)
+		}
+
+		assert.Equal(t, []int{1, 2}, got)
+		assert.Equal(t, wantErr, gotErr)
+	})
+
+	t.Run("early-stop", func(t *testing.T) {
+		t.Parallel()
+
+		var running atomic.Int32
+		fetch := func(ctx context.Context, offset int) ([]*item, error) {
+			running.Add(1)
+			defer running.Add(-1)
+			if offset == 0 {
+				return items(1, 2), nil
+			}
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+
+		var got []int
+		for v, err := range pagination.Prefetch(context.Background(), 2, 4, nil, staticCount(100), fetch) {
+			require.NoError(t, err)
+			got = append(got, v.ID)
+			break
+		}
+
+		assert.Equal(t, []int{1}, got)
+		assert.Zero(t, running.Load(), "in-flight fetches must be cancelled and awaited when the consumer stops")
+	})
+
+	t.Run("context-cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		fetch := func(ctx context.Context, offset int) ([]*item, error) {
+			if offset == 0 {
+				cancel()
+				return items(1, 2), nil
+			}
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+
+		var gotErr error
+		for _, err := range pagination.Prefetch(ctx, 2, 1, nil, staticCount(4), fetch) {
+			if err != nil {
+				gotErr = err
+			}
+		}
+		assert.ErrorIs(t, gotErr, context.Canceled)
+	})
+}
@@ -3,11 +3,14 @@ package activity
 
 import (
 	"context"
+	"iter"
 	"net/url"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/model"
 	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/pagination"
+	"github.com/nattokin/go-backlog/internal/validation"
 )
 
 // ValidOptionTypes are the option types accepted by activity list endpoints.
@@ -19,6 +22,13 @@ var ValidOptionTypes = []option.APIParamOptionType{
 	option.ParamOrder,
 }
 
+// AllValidOptionTypes are the option types accepted by activity All iterators.
+// minId, maxId and count are managed by the paginator.
+var AllValidOptionTypes = []option.APIParamOptionType{
+	option.ParamActivityTypeIDs,
+	option.ParamOrder,
+}
+
 // Service holds shared HTTP logic for activity-related Backlog API endpoints.
 // It is spath-agnostic: callers supply the full sub-path and are responsible
 // for validation and path construction.
@@ -47,6 +57,32 @@ func (s *Service) Fetch(ctx context.Context, spath string, query url.Values) ([]
 	return v, nil
 }
 
+// ApplyAllOptions validates perPage and opts and applies them to query for All.
+func (s *Service) ApplyAllOptions(query url.Values, perPage int, opts ...*option.APIParamOption) error {
+	countOpt := (&option.OptionService{}).WithCount(perPage)
+
+	var ves validation.Errors
+	if ve := countOpt.Check(); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, option.ApplyOptions(query, AllValidOptionTypes, opts...)); err != nil {
+		return err
+	}
+
+	countOpt.Set(query)
+	return nil
+}
+
+// FetchAll returns an iterator that fetches every activity at spath with
+// cursor-based pagination, starting from the pre-built query.
+func (s *Service) FetchAll(ctx context.Context, spath string, perPage int, query url.Values) iter.Seq2[*model.Activity, error] {
+	return pagination.Cursor(ctx, perPage, query, activityID, func(ctx context.Context, q url.Values) ([]*model.Activity, error) {
+		return s.Fetch(ctx, spath, q)
+	})
+}
+
+func activityID(v *model.Activity) int { return v.ID }
+
 func NewService(method *client.Method) *Service {
 	return &Service{
 		method: method,
@@ -3,11 +3,13 @@ package comment
 
 import (
 	"context"
+	"iter"
 	"net/url"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/model"
 	"github.com/nattokin/go-backlog/internal/option"
+	"github.com/nattokin/go-backlog/internal/pagination"
 	"github.com/nattokin/go-backlog/internal/validation"
 )
 
@@ -19,6 +21,12 @@ var ListValidTypes = []option.APIParamOptionType{
 	option.ParamOrder,
 }
 
+// AllValidTypes are the option types accepted by comment All iterators.
+// minId, maxId and count are managed by the paginator.
+var AllValidTypes = []option.APIParamOptionType{
+	option.ParamOrder,
+}
+
 // AddValidTypes are the option types accepted by comment add endpoints.
 var AddValidTypes = []option.APIParamOptionType{
 	option.ParamContent,
@@ -53,6 +61,32 @@ func (s *Service) FetchList(ctx context.Context, spath string, query url.Values)
 	return v, nil
 }
 
+// ApplyAllOptions validates perPage and opts and applies them to query for All.
+func (s *Service) ApplyAllOptions(query url.Values, perPage int, opts ...*option.APIParamOption) error {
+	countOpt := (&option.OptionService{}).WithCount(perPage)
+
+	var ves validation.Errors
+	if ve := countOpt.Check(); ve != nil {
+		ves = append(ves, ve)
+	}
+	if err := option.MergeValidationErrors(ves, option.ApplyOptions(query, AllValidTypes, opts...)); err != nil {
+		return err
+	}
+
+	countOpt.Set(query)
+	return nil
+}
+
+// FetchAll returns an iterator that fetches every comment at spath with
+// cursor-based pagination, starting from the pre-built query.
+func (s *Service) FetchAll(ctx context.Context, spath string, perPage int, query url.Values) iter.Seq2[*model.Comment, error] {
+	return pagination.Cursor(ctx, perPage, query, commentID, func(ctx context.Context, q url.Values) ([]*model.Comment, error) {
+		return s.FetchList(ctx, spath, q)
+	})
+}
+
+func commentID(v *model.Comment) int { return v.ID }
+
 // ApplyAddOptions validates and applies content + opts to form for Add.
 func (s *Service) ApplyAddOptions(form url.Values, content string, opts ...*option.APIParamOption) error {
 	optSvc := &option.OptionService{}
@@ -3,10 +3,11 @@ package fixture
 import backlog "github.com/nattokin/go-backlog"
 
 type issueFixtures struct {
-	SingleJSON string
-	Single     backlog.Issue
-	ListJSON   string
-	List       []*backlog.Issue
+	SingleJSON       string
+	Single           backlog.Issue
+	ListJSON         string
+	List             []*backlog.Issue
+	CustomFieldsJSON string
 }
 
 // Issue provides test fixtures for Issue-related tests.
@@ -321,4 +322,96 @@ var Issue = issueFixtures{
 			Updated: mustTimestamp("2024-01-15T14:30:00Z"),
 		},
 	},
+	CustomFieldsJSON: `
+{
+    "id": 1,
+    "projectId": 10,
+    "issueKey": "PRJ-1",
+    "keyId": 1,
+    "summary": "First issue",
+    "customFields": [
+        {
+            "id": 1,
+            "fieldTypeId": 1,
+            "name": "Browser",
+            "value": "Firefox"
+        },
+        {
+            "id": 2,
+            "fieldTypeId": 2,
+            "name": "Steps",
+            "value": "1. Open\n2. Click"
+        },
+        {
+            "id": 3,
+            "fieldTypeId": 3,
+            "name": "Points",
+            "value": 2.5
+        },
+        {
+            "id": 4,
+            "fieldTypeId": 4,
+            "name": "Release",
+            "value": "2024-03-01"
+        },
+        {
+            "id": 5,
+            "fieldTypeId": 5,
+            "name": "OS",
+            "value": {
+                "id": 1,
+                "name": "Windows",
+                "displayOrder": 0
+            }
+        },
+        {
+            "id": 6,
+            "fieldTypeId": 6,
+            "name": "Platforms",
+            "value": [
+                {
+                    "id": 1,
+                    "name": "iOS",
+                    "displayOrder": 0
+                },
+                {
+                    "id": 2,
+                    "name": "Android",
+                    "displayOrder": 1
+                }
+            ]
+        },
+        {
+            "id": 7,
+            "fieldTypeId": 7,
+            "name": "Checks",
+            "value": [
+                {
+                    "id": 3,
+                    "name": "Reviewed",
+                    "displayOrder": 0
+                }
+            ],
+            "otherValue": "Tested on staging"
+        },
+        {
+            "id": 8,
+            "fieldTypeId": 8,
+            "name": "Severity",
+            "value": {
+                "id": 2,
+                "name": "Major",
+                "displayOrder": 1
+            },
+            "otherValue": null
+        },
+        {
+            "id": 9,
+            "fieldTypeId": 3,
+            "name": "Cost",
+            "value": null
+        }
+    ]
+}
+`,
 }
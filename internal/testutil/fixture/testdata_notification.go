@@ -0,0 +1,71 @@
+package fixture
+
+type notificationFixtures struct {
+	ListJSON     string
+	CountJSON    string
+	MarkReadJSON string
+}
+
+// Notification provides test fixtures for Notification-related tests.
+var Notification = notificationFixtures{
+	ListJSON: `
+[
+    {
+        "id": 22,
+        "alreadyRead": false,
+        "reason": 2,
+        "resourceAlreadyRead": false,
+        "project": {
+            "id": 1,
+            "projectKey": "TEST",
+            "name": "test"
+        },
+        "issue": {
+            "id": 1,
+            "projectId": 1,
+            "issueKey": "TEST-1",
+            "keyId": 1,
+            "summary": "first issue"
+        },
+        "comment": {
+            "id": 7,
+            "content": "looks good"
+        },
+        "sender": {
+            "id": 2,
+            "userId": "dev",
+            "name": "developer",
+            "roleType": 2
+        },
+        "created": "2024-03-01T09:00:00Z"
+    },
+    {
+        "id": 21,
+        "alreadyRead": true,
+        "reason": 12,
+        "resourceAlreadyRead": true,
+        "project": {
+            "id": 1,
+            "projectKey": "TEST",
+            "name": "test"
+        },
+        "pullRequest": {
+            "id": 3,
+            "projectId": 1,
+            "repositoryId": 5,
+            "number": 1,
+            "summary": "fix typo"
+        },
+        "sender": {
+            "id": 2,
+            "userId": "dev",
+            "name": "developer",
+            "roleType": 2
+        },
+        "created": "2024-02-28T09:00:00Z"
+    }
+]
+`,
+	CountJSON:    `{"count": 10}`,
+	MarkReadJSON: `{"count": 0}`,
+}
@@ -0,0 +1,25 @@
+package fixture
+
+type priorityFixtures struct {
+	ListJSON string
+}
+
+// Priority provides test fixtures for Priority-related tests.
+var Priority = priorityFixtures{
+	ListJSON: `
+[
+    {
+        "id": 2,
+        "name": "High"
+    },
+    {
+        "id": 3,
+        "name": "Normal"
+    },
+    {
+        "id": 4,
+        "name": "Low"
+    }
+]
+`,
+}
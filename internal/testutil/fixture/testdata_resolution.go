@@ -0,0 +1,33 @@
+package fixture
+
+type resolutionFixtures struct {
+	ListJSON string
+}
+
+// Resolution provides test fixtures for Resolution-related tests.
+var Resolution = resolutionFixtures{
+	ListJSON: `
+[
+    {
+        "id": 0,
+        "name": "Fixed"
+    },
+    {
+        "id": 1,
+        "name": "Won't Fix"
+    },
+    {
+        "id": 2,
+        "name": "Invalid"
+    },
+    {
+        "id": 3,
+        "name": "Duplication"
+    },
+    {
+        "id": 4,
+        "name": "Cannot Reproduce"
+    }
+]
+`,
+}
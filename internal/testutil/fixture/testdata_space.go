@@ -11,6 +11,8 @@ type spaceFixtures struct {
 	DiskUsage        *backlog.DiskUsageSpace
 	NotificationJSON string
 	Notification     *backlog.SpaceNotification
+	LicenceJSON      string
+	RateLimitJSON    string
 }
 
 // Space provides test fixtures for Space-related tests.
@@ -91,4 +93,67 @@ var Space = spaceFixtures{
 		Content: "Backlog is a project management tool.",
 		Updated: mustTimestamp("2013-06-18T07:55:37Z"),
 	},
+	LicenceJSON: `
+{
+    "active": true,
+    "attachmentLimit": 1073741824,
+    "attachmentLimitPerFile": 1073741824,
+    "attachmentNumLimit": 0,
+    "attribute": true,
+    "attributeLimit": 0,
+    "burndown": true,
+    "commentLimit": 0,
+    "componentLimit": 0,
+    "fileSharing": true,
+    "gantt": true,
+    "git": true,
+    "issueLimit": 0,
+    "licenceTypeId": 46,
+    "limitDate": "2020-06-20T00:00:00Z",
+    "nulabAccount": true,
+    "parentChildIssue": true,
+    "postIssueByMail": true,
+    "projectGroup": true,
+    "projectLimit": 0,
+    "pullRequestAttachmentLimitPerFile": 1073741824,
+    "pullRequestAttachmentNumLimit": 0,
+    "remoteAddress": true,
+    "remoteAddressLimit": 0,
+    "startedOn": "2020-05-21T00:00:00Z",
+    "storageLimit": 1073741824,
+    "subversion": true,
+    "subversionExternal": false,
+    "userLimit": 0,
+    "versionLimit": 0,
+    "wikiAttachment": true,
+    "wikiAttachmentLimitPerFile": 1073741824,
+    "wikiAttachmentNumLimit": 0
+}
+`,
+	RateLimitJSON: `
+{
+    "rateLimit": {
+        "read": {
+            "limit": 600,
+            "remaining": 598,
+            "reset": 1700000060
+        },
+        "update": {
+            "limit": 150,
+            "remaining": 150,
+            "reset": 1700000060
+        },
+        "search": {
+            "limit": 150,
+            "remaining": 149,
+            "reset": 1700000060
+        },
+        "icon": {
+            "limit": 60,
+            "remaining": 60,
+            "reset": 1700000060
+        }
+    }
+}
+`,
 }
@@ -0,0 +1,98 @@
+package fixture
+
+type teamFixtures struct {
+	ListJSON   string
+	SingleJSON string
+}
+
+// Team provides test fixtures for Team-related tests.
+var Team = teamFixtures{
+	ListJSON: `
+[
+    {
+        "id": 1,
+        "name": "developers",
+        "members": [
+            {
+                "id": 1,
+                "userId": "admin",
+                "name": "admin",
+                "roleType": 1,
+                "lang": "ja",
+                "mailAddress": "eguchi@nulab.example"
+            }
+        ],
+        "displayOrder": null,
+        "createdUser": {
+            "id": 1,
+            "userId": "admin",
+            "name": "admin",
+            "roleType": 1,
+            "lang": "ja",
+            "mailAddress": "eguchi@nulab.example"
+        },
+        "created": "2013-05-30T09:11:36Z",
+        "updatedUser": {
+            "id": 1,
+            "userId": "admin",
+            "name": "admin",
+            "roleType": 1,
+            "lang": "ja",
+            "mailAddress": "eguchi@nulab.example"
+        },
+        "updated": "2013-05-30T09:11:36Z"
+    },
+    {
+        "id": 2,
+        "name": "designers",
+        "members": [],
+        "displayOrder": 1,
+        "created": "2013-06-01T09:11:36Z",
+        "updated": "2013-06-02T09:11:36Z"
+    }
+]
+`,
+	SingleJSON: `
+{
+    "id": 1,
+    "name": "developers",
+    "members": [
+        {
+            "id": 1,
+            "userId": "admin",
+            "name": "admin",
+            "roleType": 1,
+            "lang": "ja",
+            "mailAddress": "eguchi@nulab.example"
+        },
+        {
+            "id": 2,
+            "userId": "dev",
+            "name": "developer",
+            "roleType": 2,
+            "lang": "en",
+            "mailAddress": "dev@nulab.example"
+        }
+    ],
+    "displayOrder": null,
+    "createdUser": {
+        "id": 1,
+        "userId": "admin",
+        "name": "admin",
+        "roleType": 1,
+        "lang": "ja",
+        "mailAddress": "eguchi@nulab.example"
+    },
+    "created": "2013-05-30T09:11:36Z",
+    "updatedUser": {
+        "id": 1,
+        "userId": "admin",
+        "name": "admin",
+        "roleType": 1,
+        "lang": "ja",
+        "mailAddress": "eguchi@nulab.example"
+    },
+    "updated": "2013-05-30T09:11:36Z"
+}
+`,
+}
@@ -0,0 +1,69 @@
+package fixture
+
+type watchingFixtures struct {
+	ListJSON   string
+	SingleJSON string
+	CountJSON  string
+}
+
+// Watching provides test fixtures for Watching-related tests.
+var Watching = watchingFixtures{
+	ListJSON: `
+[
+    {
+        "id": 1,
+        "alreadyRead": false,
+        "resourceAlreadyRead": false,
+        "note": "",
+        "type": "issue",
+        "issue": {
+            "id": 1,
+            "projectId": 1,
+            "issueKey": "TEST-1",
+            "keyId": 1,
+            "summary": "first issue"
+        },
+        "lastContentUpdated": "2024-01-15T10:00:00Z",
+        "created": "2024-01-10T09:00:00Z",
+        "updated": "2024-01-15T10:00:00Z"
+    },
+    {
+        "id": 2,
+        "alreadyRead": true,
+        "resourceAlreadyRead": true,
+        "note": "check later",
+        "type": "issue",
+        "issue": {
+            "id": 2,
+            "projectId": 1,
+            "issueKey": "TEST-2",
+            "keyId": 2,
+            "summary": "second issue"
+        },
+        "lastContentUpdated": "2024-02-20T12:00:00Z",
+        "created": "2024-02-01T09:00:00Z",
+        "updated": "2024-02-20T12:00:00Z"
+    }
+]
+`,
+	SingleJSON: `
+{
+    "id": 2,
+    "alreadyRead": true,
+    "resourceAlreadyRead": true,
+    "note": "check later",
+    "type": "issue",
+    "issue": {
+        "id": 2,
+        "projectId": 1,
+        "issueKey": "TEST-2",
+        "keyId": 2,
+        "summary": "second issue"
+    },
+    "lastContentUpdated": "2024-02-20T12:00:00Z",
+    "created": "2024-02-01T09:00:00Z",
+    "updated": "2024-02-20T12:00:00Z"
+}
+`,
+	CountJSON: `{"count": 138}`,
+}
@@ -21,6 +21,8 @@ var validIssueSorts = []string{
 
 var validTextFormattingRules = []string{"backlog", "markdown"}
 
+var validWatchingSorts = []string{"created", "updated", "issueUpdated"}
+
 // ValidateDateFormat validates that date is formatted as yyyy-MM-dd.
 func ValidateDateFormat(field, date string) *validation.Error {
 	if !datePattern.MatchString(date) {
@@ -83,6 +85,24 @@ func ValidateOrder(field, order string) *validation.Error {
 	return nil
 }
 
+// ValidateNonNegativeInt validates that value is not less than 0.
+func ValidateNonNegativeInt(field string, value int) *validation.Error {
+	if value < 0 {
+		return validation.NewError(field, fmt.Sprintf("invalid %s: must not be less than 0", field))
+	}
+	return nil
+}
+
+// ValidateNonNegativeInts validates that every element of values is not less than 0.
+func ValidateNonNegativeInts(field string, values []int) *validation.Error {
+	for _, v := range values {
+		if v < 0 {
+			return validation.NewError(field, fmt.Sprintf("invalid %s: %d must not be less than 0", field, v))
+		}
+	}
+	return nil
+}
+
 // ValidatePassword validates that password is at least 8 characters long.
 func ValidatePassword(field, password string) *validation.Error {
 	if len(password) < 8 {
@@ -117,6 +137,16 @@ func ValidatePositiveInts(field string, values []int) *validation.Error {
 	return nil
 }
 
+// ValidateWatchingSort validates that sort is one of the Backlog watching-list sort keys.
+func ValidateWatchingSort(field, sort string) *validation.Error {
+	for _, v := range validWatchingSorts {
+		if sort == v {
+			return nil
+		}
+	}
+	return validation.NewError(field, fmt.Sprintf("invalid %s: must be a valid sort value", field))
+}
+
 // ValidateTextFormattingRule validates that format is "backlog" or "markdown".
 func ValidateTextFormattingRule(field, format string) *validation.Error {
 	for _, v := range validTextFormattingRules {
@@ -147,6 +177,10 @@ func ValidateIssueIDOrKey(issueIDOrKey string) *validation.Error {
 	return ValidateIDOrKey("issueIDOrKey", issueIDOrKey)
 }
 
+func ValidateNotificationID(notificationID int) *validation.Error {
+	return ValidatePositiveInt("notificationID", notificationID)
+}
+
 func ValidatePRNumber(prNumber int) *validation.Error {
 	return ValidatePositiveInt("prNumber", prNumber)
 }
@@ -171,10 +205,18 @@ func ValidateStarID(starID int) *validation.Error {
 	return ValidatePositiveInt("starID", starID)
 }
 
+func ValidateTeamID(teamID int) *validation.Error {
+	return ValidatePositiveInt("teamID", teamID)
+}
+
 func ValidateUserID(userID int) *validation.Error {
 	return ValidatePositiveInt("userID", userID)
 }
 
+func ValidateWatchingID(watchingID int) *validation.Error {
+	return ValidatePositiveInt("watchingID", watchingID)
+}
+
 func ValidateVersionID(versionID int) *validation.Error {
 	return ValidatePositiveInt("versionID", versionID)
 }
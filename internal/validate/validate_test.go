@@ -137,6 +137,31 @@ func TestValidateIssueSort(t *testing.T) {
 	}
 }
 
+func TestValidateWatchingSort(t *testing.T) {
+	cases := map[string]struct {
+		sort    string
+		wantErr bool
+	}{
+		"valid-created":      {sort: "created"},
+		"valid-issueUpdated": {sort: "issueUpdated"},
+		"invalid-empty":      {sort: "", wantErr: true},
+		"invalid-issue-sort": {sort: "summary", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ve := validate.ValidateWatchingSort("sort", tc.sort)
+			if tc.wantErr {
+				assert.NotNil(t, ve)
+				return
+			}
+			assert.Nil(t, ve)
+		})
+	}
+}
+
 func TestValidateNonEmptyString(t *testing.T) {
 	cases := map[string]struct {
 		value   string
@@ -236,6 +261,55 @@ func TestValidatePositiveFloat64(t *testing.T) {
 	}
 }
 
+func TestValidateNonNegativeInt(t *testing.T) {
+	cases := map[string]struct {
+		value   int
+		wantErr bool
+	}{
+		"valid-0":     {value: 0},
+		"valid-1":     {value: 1},
+		"invalid-neg": {value: -1, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ve := validate.ValidateNonNegativeInt("resolutionId", tc.value)
+			if tc.wantErr {
+				assert.NotNil(t, ve)
+				assert.Equal(t, "resolutionId", ve.Target())
+				return
+			}
+			assert.Nil(t, ve)
+		})
+	}
+}
+
+func TestValidateNonNegativeInts(t *testing.T) {
+	cases := map[string]struct {
+		values  []int
+		wantErr bool
+	}{
+		"valid-empty":     {values: []int{}},
+		"valid-has-0":     {values: []int{0, 1, 2}},
+		"invalid-has-neg": {values: []int{0, -1}, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ve := validate.ValidateNonNegativeInts("resolutionId", tc.values)
+			if tc.wantErr {
+				assert.NotNil(t, ve)
+				return
+			}
+			assert.Nil(t, ve)
+		})
+	}
+}
+
 func TestValidatePositiveInt(t *testing.T) {
 	cases := map[string]struct {
 		value   int
@@ -39,7 +39,7 @@ type Issue struct {
 	Created        Timestamp
 	UpdatedUser    *User
 	Updated        Timestamp
-	CustomFields   []*CustomField
+	CustomFields   []*IssueCustomField
 	Attachments    []*Attachment
 	SharedFiles    []*SharedFile
 	Stars          []*Star
@@ -118,6 +118,7 @@ type IssueService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
 func (s *IssueService) List(ctx context.Context, opts ...RequestOption) ([]*Issue, error) {
+	ctx = client.WithOperation(ctx, "Issue.List")
 	v, err := s.base.List(ctx, toInnerOptions(opts)...)
 	return issuesFromModel(v), convertError(err)
 }
@@ -162,6 +163,7 @@ func (s *IssueService) List(ctx context.Context, opts ...RequestOption) ([]*Issu
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
 func (s *IssueService) All(ctx context.Context, perPage int, opts ...RequestOption) (iter.Seq2[*Issue, error], error) {
+	ctx = client.WithOperation(ctx, "Issue.All")
 	seq, err := s.base.All(ctx, perPage, toInnerOptions(opts)...)
 	if err != nil {
 		return nil, convertError(err)
@@ -175,6 +177,33 @@ func (s *IssueService) All(ctx context.Context, perPage int, opts ...RequestOpti
 	}, nil
 }
 
+// AllPrefetch is like All but fetches up to prefetch pages ahead in parallel,
+// which speeds up exporting large numbers of issues.
+//
+// The number of pages is determined with Count before the first page is
+// requested. prefetch must be between 1 and 10. Fewer requests are sent in
+// parallel when the rate limit reported by Backlog is close to being
+// exhausted. Issues are still yielded in order, and breaking out of the loop
+// cancels the requests in flight.
+//
+// This method supports the same options as All.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
+func (s *IssueService) AllPrefetch(ctx context.Context, perPage, prefetch int, opts ...RequestOption) (iter.Seq2[*Issue, error], error) {
+	ctx = client.WithOperation(ctx, "Issue.AllPrefetch")
+	seq, err := s.base.AllPrefetch(ctx, perPage, prefetch, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Issue, error) bool) {
+		for v, err := range seq {
+			if !yield(issueFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // Count returns the total count of issues matching the given filters.
 //
 // This method supports the same filter options as All, except WithIssueSort,
@@ -182,6 +211,7 @@ func (s *IssueService) All(ctx context.Context, perPage int, opts ...RequestOpti
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-issue
 func (s *IssueService) Count(ctx context.Context, opts ...RequestOption) (int, error) {
+	ctx = client.WithOperation(ctx, "Issue.Count")
 	count, err := s.base.Count(ctx, toInnerOptions(opts)...)
 	return count, convertError(err)
 }
@@ -190,6 +220,7 @@ func (s *IssueService) Count(ctx context.Context, opts ...RequestOption) (int, e
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue
 func (s *IssueService) One(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+	ctx = client.WithOperation(ctx, "Issue.One")
 	v, err := s.base.One(ctx, issueIDOrKey)
 	return issueFromModel(v), convertError(err)
 }
@@ -216,6 +247,7 @@ func (s *IssueService) One(ctx context.Context, issueIDOrKey string) (*Issue, er
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-issue
 func (s *IssueService) Create(ctx context.Context, projectID int, summary string, issueTypeID int, priorityID int, opts ...RequestOption) (*Issue, error) {
+	ctx = client.WithOperation(ctx, "Issue.Create")
 	v, err := s.base.Create(ctx, projectID, summary, issueTypeID, priorityID, toInnerOptions(opts)...)
 	return issueFromModel(v), convertError(err)
 }
@@ -248,6 +280,7 @@ func (s *IssueService) Create(ctx context.Context, projectID int, summary string
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-issue
 func (s *IssueService) Update(ctx context.Context, issueIDOrKey string, option RequestOption, opts ...RequestOption) (*Issue, error) {
+	ctx = client.WithOperation(ctx, "Issue.Update")
 	v, err := s.base.Update(ctx, issueIDOrKey, toInnerOption(option), toInnerOptions(opts)...)
 	return issueFromModel(v), convertError(err)
 }
@@ -256,6 +289,7 @@ func (s *IssueService) Update(ctx context.Context, issueIDOrKey string, option R
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-issue
 func (s *IssueService) Delete(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+	ctx = client.WithOperation(ctx, "Issue.Delete")
 	v, err := s.base.Delete(ctx, issueIDOrKey)
 	return issueFromModel(v), convertError(err)
 }
@@ -264,6 +298,7 @@ func (s *IssueService) Delete(ctx context.Context, issueIDOrKey string) (*Issue,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-participant-list
 func (s *IssueService) Participants(ctx context.Context, issueIDOrKey string) ([]*User, error) {
+	ctx = client.WithOperation(ctx, "Issue.Participants")
 	v, err := s.base.Participants(ctx, issueIDOrKey)
 	return usersFromModel(v), convertError(err)
 }
@@ -281,6 +316,7 @@ type IssueStarService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-star
 func (s *IssueStarService) Add(ctx context.Context, issueID int) error {
+	ctx = client.WithOperation(ctx, "Issue.Star.Add")
 	return s.star.Add(ctx, s.star.Option.WithIssueID(issueID))
 }
 
@@ -288,6 +324,7 @@ func (s *IssueStarService) Add(ctx context.Context, issueID int) error {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-star
 func (s *IssueStarService) Remove(ctx context.Context, starID int) error {
+	ctx = client.WithOperation(ctx, "Issue.Star.Remove")
 	return s.star.Remove(ctx, starID)
 }
 
@@ -316,6 +353,21 @@ func newIssueStarService(method *client.Method, option *option.OptionService) *I
 //  Helpers
 // ──────────────────────────────────────────────────────────────
 
+func prioritiesFromModel(m []*model.Priority) []*Priority {
+	if m == nil {
+		return nil
+	}
+	result := make([]*Priority, len(m))
+	for i, v := range m {
+		if v == nil {
+			result[i] = nil
+		} else {
+			result[i] = &Priority{ID: v.ID, Name: v.Name}
+		}
+	}
+	return result
+}
+
 func resolutionsFromModel(m []*model.Resolution) []*Resolution {
 	if m == nil {
 		return nil
@@ -412,7 +464,7 @@ func issueFromModel(m *model.Issue) *Issue {
 		Created:        Timestamp{m.Created},
 		UpdatedUser:    userFromModel(m.UpdatedUser),
 		Updated:        Timestamp{m.Updated},
-		CustomFields:   customFieldsFromModel(m.CustomFields),
+		CustomFields:   issueCustomFieldsFromModel(m.CustomFields),
 		Attachments:    attachmentsFromModel(m.Attachments),
 		SharedFiles:    sharedFilesFromModel(m.SharedFiles),
 		Stars:          starsFromModel(m.Stars),
@@ -16,6 +16,7 @@ type IssueAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-issue-attachments
 func (s *IssueAttachmentService) List(ctx context.Context, issueIDOrKey string) ([]*Attachment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Attachment.List")
 	v, err := s.base.List(ctx, issueIDOrKey)
 	return attachmentsFromModel(v), convertError(err)
 }
@@ -24,6 +25,7 @@ func (s *IssueAttachmentService) List(ctx context.Context, issueIDOrKey string)
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-issue-attachment
 func (s *IssueAttachmentService) Remove(ctx context.Context, issueIDOrKey string, attachmentID int) (*Attachment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Attachment.Remove")
 	v, err := s.base.Remove(ctx, issueIDOrKey, attachmentID)
 	return attachmentFromModel(v), convertError(err)
 }
@@ -33,6 +35,7 @@ func (s *IssueAttachmentService) Remove(ctx context.Context, issueIDOrKey string
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-attachment
 func (s *IssueAttachmentService) Download(ctx context.Context, issueIDOrKey string, attachmentID int) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Issue.Attachment.Download")
 	v, err := s.base.Download(ctx, issueIDOrKey, attachmentID)
 	return fileDataFromModel(v), convertError(err)
 }
@@ -0,0 +1,200 @@
+package backlog
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nattokin/go-backlog/internal/validate"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+// MaxBulkConcurrency is the maximum number of issues [IssueService.BulkUpdate]
+// and [IssueService.BulkUpdateEach] update in parallel.
+const MaxBulkConcurrency = 10
+
+// ──────────────────────────────────────────────────────────────
+//  Bulk update models
+// ──────────────────────────────────────────────────────────────
+
+// IssueUpdate describes the update of a single issue in a bulk update.
+type IssueUpdate struct {
+	IssueIDOrKey string
+	// Options are passed to [IssueService.Update] for the issue.
+	// At least one option is required.
+	Options []RequestOption
+}
+
+// BulkUpdateStatus is the outcome of a single update in a bulk update.
+type BulkUpdateStatus int
+
+const (
+	// BulkUpdatePending means the update was not attempted because the
+	// context was done first.
+	BulkUpdatePending BulkUpdateStatus = iota
+	// BulkUpdateSucceeded means the issue was updated.
+	BulkUpdateSucceeded
+	// BulkUpdateFailed means the update returned an error.
+	BulkUpdateFailed
+)
+
+// String returns the name of the status.
+func (s BulkUpdateStatus) String() string {
+	switch s {
+	case BulkUpdatePending:
+		return "pending"
+	case BulkUpdateSucceeded:
+		return "succeeded"
+	case BulkUpdateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// IssueUpdateResult is the result of a single update in a bulk update.
+type IssueUpdateResult struct {
+	Update *IssueUpdate
+	Status BulkUpdateStatus
+	// Issue is the updated issue when Status is BulkUpdateSucceeded.
+	Issue *Issue
+	// Err is the error returned for the update when Status is
+	// BulkUpdateFailed, such as an *APIResponseError or a *ValidationError.
+	Err error
+}
+
+// BulkUpdateReport reports the results of a bulk update.
+type BulkUpdateReport struct {
+	// Results holds one result per update, in the order the updates were given.
+	Results []*IssueUpdateResult
+}
+
+// Succeeded returns the results of the updates that succeeded.
+func (r *BulkUpdateReport) Succeeded() []*IssueUpdateResult {
+	return r.filter(BulkUpdateSucceeded)
+}
+
+// Failed returns the results of the updates that returned an error.
+func (r *BulkUpdateReport) Failed() []*IssueUpdateResult {
+	return r.filter(BulkUpdateFailed)
+}
+
+// Pending returns the results of the updates that were not attempted.
+func (r *BulkUpdateReport) Pending() []*IssueUpdateResult {
+	return r.filter(BulkUpdatePending)
+}
+
+// Remaining returns the updates that failed or were not attempted, in their
+// original order. Pass them to [IssueService.BulkUpdateEach] to resume an
+// interrupted bulk update.
+func (r *BulkUpdateReport) Remaining() []*IssueUpdate {
+	var updates []*IssueUpdate
+	for _, res := range r.Results {
+		if res.Status != BulkUpdateSucceeded {
+			updates = append(updates, res.Update)
+		}
+	}
+	return updates
+}
+
+func (r *BulkUpdateReport) filter(status BulkUpdateStatus) []*IssueUpdateResult {
+	var results []*IssueUpdateResult
+	for _, res := range r.Results {
+		if res.Status == status {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+// ──────────────────────────────────────────────────────────────
+//  IssueService bulk methods
+// ──────────────────────────────────────────────────────────────
+
+// BulkUpdate applies the same update options to each of the given issues,
+// updating up to concurrency issues in parallel (1-10).
+//
+// See [IssueService.BulkUpdateEach] for how results and errors are reported.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-issue
+func (s *IssueService) BulkUpdate(ctx context.Context, concurrency int, issueIDOrKeys []string, option RequestOption, opts ...RequestOption) (*BulkUpdateReport, error) {
+	options := append([]RequestOption{option}, opts...)
+	updates := make([]*IssueUpdate, len(issueIDOrKeys))
+	for i, key := range issueIDOrKeys {
+		updates[i] = &IssueUpdate{IssueIDOrKey: key, Options: options}
+	}
+	return s.BulkUpdateEach(ctx, concurrency, updates)
+}
+
+// BulkUpdateEach applies each update with its own options, updating up to
+// concurrency issues in parallel (1-10).
+//
+// A failed update does not stop the others; its error is recorded in the
+// report. The returned error is non-nil only when concurrency is out of range,
+// in which case no update is attempted, or when ctx is done before every
+// update has been attempted. In the latter case the report is returned as
+// well, and [BulkUpdateReport.Remaining] lists the updates to resume with.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-issue
+func (s *IssueService) BulkUpdateEach(ctx context.Context, concurrency int, updates []*IssueUpdate) (*BulkUpdateReport, error) {
+	if ve := validate.ValidateIntRange("concurrency", concurrency, 1, MaxBulkConcurrency); ve != nil {
+		return nil, convertError(ve)
+	}
+
+	report := &BulkUpdateReport{Results: make([]*IssueUpdateResult, len(updates))}
+	for i, u := range updates {
+		report.Results[i] = &IssueUpdateResult{Update: u, Status: BulkUpdatePending}
+	}
+
+	jobs := make(chan *IssueUpdateResult)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(updates)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for res := range jobs {
+				// Leave the update pending if ctx was done while it was queued.
+				if ctx.Err() != nil {
+					continue
+				}
+				s.bulkUpdateOne(ctx, res)
+			}
+		}()
+	}
+
+dispatch:
+	for _, res := range report.Results {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case jobs <- res:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if len(report.Pending()) > 0 {
+		return report, ctx.Err()
+	}
+	return report, nil
+}
+
+func (s *IssueService) bulkUpdateOne(ctx context.Context, res *IssueUpdateResult) {
+	u := res.Update
+	if u == nil || len(u.Options) == 0 {
+		res.Status = BulkUpdateFailed
+		res.Err = convertError(validation.NewError("options", "invalid options: at least one option is required"))
+		return
+	}
+
+	issue, err := s.Update(ctx, u.IssueIDOrKey, u.Options[0], u.Options[1:]...)
+	if err != nil {
+		res.Status = BulkUpdateFailed
+		res.Err = err
+		return
+	}
+	res.Status = BulkUpdateSucceeded
+	res.Issue = issue
+}
@@ -0,0 +1,182 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestIssueService_BulkUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var paths []string
+		var inFlight, peak atomic.Int32
+		c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{
+			T: t,
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+				}
+				time.Sleep(5 * time.Millisecond)
+
+				assert.Equal(t, http.MethodPatch, req.Method)
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "3", req.PostForm.Get("assigneeId"))
+				assert.Equal(t, []string{"7"}, req.PostForm["milestoneId[]"])
+				mu.Lock()
+				paths = append(paths, req.URL.Path)
+				mu.Unlock()
+				return mock.NewResponse(fixture.Issue.SingleJSON), nil
+			},
+		}))
+		require.NoError(t, err)
+
+		keys := []string{"PRJ-1", "PRJ-2", "PRJ-3", "PRJ-4", "PRJ-5"}
+		report, err := c.Issue.BulkUpdate(ctx, 2, keys,
+			c.Issue.Option.WithAssigneeID(3),
+			c.Issue.Option.WithMilestoneIDs([]int{7}),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, report.Results, 5)
+		for i, res := range report.Results {
+			assert.Equal(t, keys[i], res.Update.IssueIDOrKey)
+			assert.Equal(t, backlog.BulkUpdateSucceeded, res.Status)
+			assert.NotNil(t, res.Issue)
+			assert.NoError(t, res.Err)
+		}
+		assert.Len(t, report.Succeeded(), 5)
+		assert.Empty(t, report.Remaining())
+		assert.Len(t, paths, 5)
+		assert.LessOrEqual(t, peak.Load(), int32(2))
+	})
+
+	t.Run("partial-failure", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{
+			T: t,
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(req.URL.Path, "/PRJ-404") {
+					return mock.NewNotFoundResponse(), nil
+				}
+				assert.True(t, strings.HasSuffix(req.URL.Path, "/PRJ-1"), "unexpected request %s", req.URL.Path)
+				return mock.NewResponse(fixture.Issue.SingleJSON), nil
+			},
+		}))
+		require.NoError(t, err)
+
+		report, err := c.Issue.BulkUpdateEach(ctx, 3, []*backlog.IssueUpdate{
+			{IssueIDOrKey: "PRJ-1", Options: []backlog.RequestOption{c.Issue.Option.WithSummary("ok")}},
+			{IssueIDOrKey: "PRJ-404", Options: []backlog.RequestOption{c.Issue.Option.WithSummary("missing")}},
+			{IssueIDOrKey: "PRJ-2", Options: []backlog.RequestOption{c.Issue.Option.WithSummary("")}},
+			{IssueIDOrKey: "PRJ-3"},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, report.Results, 4)
+		assert.Equal(t, backlog.BulkUpdateSucceeded, report.Results[0].Status)
+
+		var apiErr *backlog.APIResponseError
+		assert.Equal(t, backlog.BulkUpdateFailed, report.Results[1].Status)
+		require.True(t, errors.As(report.Results[1].Err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+
+		var ve *backlog.ValidationError
+		assert.Equal(t, backlog.BulkUpdateFailed, report.Results[2].Status)
+		assert.True(t, errors.As(report.Results[2].Err, &ve))
+		assert.Equal(t, backlog.BulkUpdateFailed, report.Results[3].Status)
+		assert.True(t, errors.As(report.Results[3].Err, &ve))
+
+		assert.Len(t, report.Succeeded(), 1)
+		assert.Len(t, report.Failed(), 3)
+		assert.Empty(t, report.Pending())
+		remaining := report.Remaining()
+		require.Len(t, remaining, 3)
+		assert.Equal(t, "PRJ-404", remaining[0].IssueIDOrKey)
+	})
+
+	t.Run("cancel-and-resume", func(t *testing.T) {
+		t.Parallel()
+
+		cctx, cancel := context.WithCancel(ctx)
+		var calls atomic.Int32
+		c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{
+			T: t,
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				if calls.Add(1) == 1 {
+					cancel()
+				}
+				return mock.NewResponse(fixture.Issue.SingleJSON), nil
+			},
+		}))
+		require.NoError(t, err)
+
+		keys := []string{"PRJ-1", "PRJ-2", "PRJ-3"}
+		opt := c.Issue.Option.WithSummary("triaged")
+		report, err := c.Issue.BulkUpdate(cctx, 1, keys, opt)
+		require.ErrorIs(t, err, context.Canceled)
+		require.NotNil(t, report)
+
+		assert.Equal(t, backlog.BulkUpdateSucceeded, report.Results[0].Status)
+		assert.Len(t, report.Pending(), 2)
+		remaining := report.Remaining()
+		require.Len(t, remaining, 2)
+		assert.Equal(t, "PRJ-2", remaining[0].IssueIDOrKey)
+		assert.Equal(t, "PRJ-3", remaining[1].IssueIDOrKey)
+
+		resumed, err := c.Issue.BulkUpdateEach(ctx, 1, remaining)
+		require.NoError(t, err)
+		assert.Len(t, resumed.Succeeded(), 2)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: mock.NewUnexpectedDoFunc(t)}))
+		require.NoError(t, err)
+
+		report, err := c.Issue.BulkUpdateEach(ctx, 2, nil)
+		require.NoError(t, err)
+		assert.Empty(t, report.Results)
+	})
+
+	t.Run("error-invalid-concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: mock.NewUnexpectedDoFunc(t)}))
+		require.NoError(t, err)
+
+		for _, concurrency := range []int{0, backlog.MaxBulkConcurrency + 1} {
+			report, err := c.Issue.BulkUpdate(ctx, concurrency, []string{"PRJ-1"}, c.Issue.Option.WithSummary("x"))
+			assert.Nil(t, report)
+			var ve *backlog.ValidationError
+			assert.True(t, errors.As(err, &ve))
+		}
+	})
+}
+
+func TestBulkUpdateStatus_String(t *testing.T) {
+	assert.Equal(t, "pending", backlog.BulkUpdatePending.String())
+	assert.Equal(t, "succeeded", backlog.BulkUpdateSucceeded.String())
+	assert.Equal(t, "failed", backlog.BulkUpdateFailed.String())
+	assert.Equal(t, "unknown", backlog.BulkUpdateStatus(99).String())
+}
@@ -2,6 +2,7 @@ package backlog
 
 import (
 	"context"
+	"iter"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/domain/issue"
@@ -25,10 +26,40 @@ type IssueCommentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-comment-list
 func (s *IssueCommentService) List(ctx context.Context, issueIDOrKey string, opts ...RequestOption) ([]*Comment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.List")
 	v, err := s.base.List(ctx, issueIDOrKey, toInnerOptions(opts)...)
 	return commentsFromModel(v), convertError(err)
 }
 
+// All returns an iterator that lazily fetches all comments on an issue with
+// automatic cursor pagination, along with any validation error encountered
+// at call time.
+//
+// perPage controls how many comments are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.Issue.Comment.Option",
+// such as:
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-comment-list
+func (s *IssueCommentService) All(ctx context.Context, perPage int, issueIDOrKey string, opts ...RequestOption) (iter.Seq2[*Comment, error], error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.All")
+	seq, err := s.base.All(ctx, perPage, issueIDOrKey, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Comment, error) bool) {
+		for v, err := range seq {
+			if !yield(commentFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // Add adds a comment to an issue.
 //
 // This method supports options returned by methods in "*Client.Issue.Comment.Option",
@@ -38,6 +69,7 @@ func (s *IssueCommentService) List(ctx context.Context, issueIDOrKey string, opt
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-comment
 func (s *IssueCommentService) Add(ctx context.Context, issueIDOrKey string, content string, opts ...RequestOption) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.Add")
 	v, err := s.base.Add(ctx, issueIDOrKey, content, toInnerOptions(opts)...)
 	return commentFromModel(v), convertError(err)
 }
@@ -46,6 +78,7 @@ func (s *IssueCommentService) Add(ctx context.Context, issueIDOrKey string, cont
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-comment
 func (s *IssueCommentService) Count(ctx context.Context, issueIDOrKey string) (int, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.Count")
 	count, err := s.base.Count(ctx, issueIDOrKey)
 	return count, convertError(err)
 }
@@ -54,6 +87,7 @@ func (s *IssueCommentService) Count(ctx context.Context, issueIDOrKey string) (i
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-comment
 func (s *IssueCommentService) One(ctx context.Context, issueIDOrKey string, commentID int) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.One")
 	v, err := s.base.One(ctx, issueIDOrKey, commentID)
 	return commentFromModel(v), convertError(err)
 }
@@ -62,6 +96,7 @@ func (s *IssueCommentService) One(ctx context.Context, issueIDOrKey string, comm
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-comment
 func (s *IssueCommentService) Delete(ctx context.Context, issueIDOrKey string, commentID int) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.Delete")
 	v, err := s.base.Delete(ctx, issueIDOrKey, commentID)
 	return commentFromModel(v), convertError(err)
 }
@@ -70,6 +105,7 @@ func (s *IssueCommentService) Delete(ctx context.Context, issueIDOrKey string, c
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-comment
 func (s *IssueCommentService) Update(ctx context.Context, issueIDOrKey string, commentID int, content string) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.Update")
 	v, err := s.base.Update(ctx, issueIDOrKey, commentID, content)
 	return commentFromModel(v), convertError(err)
 }
@@ -78,6 +114,7 @@ func (s *IssueCommentService) Update(ctx context.Context, issueIDOrKey string, c
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-comment-notifications
 func (s *IssueCommentService) Notifications(ctx context.Context, issueIDOrKey string, commentID int) ([]*Notification, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.Notifications")
 	v, err := s.base.Notifications(ctx, issueIDOrKey, commentID)
 	return notificationsFromModel(v), convertError(err)
 }
@@ -86,6 +123,7 @@ func (s *IssueCommentService) Notifications(ctx context.Context, issueIDOrKey st
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-comment-notification
 func (s *IssueCommentService) Notify(ctx context.Context, issueIDOrKey string, commentID int, userIDs []int) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "Issue.Comment.Notify")
 	v, err := s.base.Notify(ctx, issueIDOrKey, commentID, userIDs)
 	return commentFromModel(v), convertError(err)
 }
@@ -236,6 +236,39 @@ func TestIssueCommentService(t *testing.T) {
 				assert.True(t, errors.As(err, &target))
 			},
 		},
+		"All": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/issues/PRJ-1/comments", req.URL.Path)
+				assert.Equal(t, "100", req.URL.Query().Get("count"))
+				assert.Equal(t, "asc", req.URL.Query().Get("order"))
+				return mock.NewResponse(fixture.Comment.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Issue.Comment.All(ctx, 100, "PRJ-1", c.Issue.Comment.Option.WithOrder(backlog.OrderAsc))
+				require.NoError(t, err)
+				var got []int
+				for v, err := range seq {
+					require.NoError(t, err)
+					got = append(got, v.ID)
+				}
+				assert.Equal(t, []int{1, 2}, got)
+			},
+		},
+		"All/error-invalid-count": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Issue.Comment.All(ctx, 0, "PRJ-1")
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"All/error-cursor-option": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Issue.Comment.All(ctx, 100, "PRJ-1", c.Issue.Comment.Option.WithMinID(1))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,197 @@
+package backlog
+
+import (
+	"encoding/json"
+
+	"github.com/nattokin/go-backlog/internal/model"
+)
+
+// ──────────────────────────────────────────────────────────────
+//  IssueCustomField model
+// ──────────────────────────────────────────────────────────────
+
+// IssueCustomField represents the value of a custom field set on an [Issue].
+//
+// The shape of the value depends on Type. Read it with the accessor that
+// matches the field type, such as [IssueCustomField.AsNumber] for
+// [CustomFieldTypeNumber]. Accessors report false when the field is of a
+// different type or has no value.
+type IssueCustomField struct {
+	ID   int
+	Type CustomFieldType
+	Name string
+	// OtherValue is the free text entered in the "other" input of a
+	// checkbox or radio field that allows it.
+	OtherValue string
+
+	set    bool
+	text   string
+	number float64
+	date   Date
+	items  []*CustomFieldItem
+}
+
+// IsSet reports whether a value is set for the field.
+func (f *IssueCustomField) IsSet() bool {
+	return f.set
+}
+
+// AsText returns the value of a [CustomFieldTypeText] or
+// [CustomFieldTypeSentence] field.
+func (f *IssueCustomField) AsText() (string, bool) {
+	if !f.set || (f.Type != CustomFieldTypeText && f.Type != CustomFieldTypeSentence) {
+		return "", false
+	}
+	return f.text, true
+}
+
+// AsNumber returns the value of a [CustomFieldTypeNumber] field.
+func (f *IssueCustomField) AsNumber() (float64, bool) {
+	if !f.set || f.Type != CustomFieldTypeNumber {
+		return 0, false
+	}
+	return f.number, true
+}
+
+// AsDate returns the value of a [CustomFieldTypeDate] field.
+func (f *IssueCustomField) AsDate() (Date, bool) {
+	if !f.set || f.Type != CustomFieldTypeDate {
+		return Date{}, false
+	}
+	return f.date, true
+}
+
+// AsItem returns the selected item of a [CustomFieldTypeSingleList] or
+// [CustomFieldTypeRadio] field.
+func (f *IssueCustomField) AsItem() (*CustomFieldItem, bool) {
+	if !f.set || (f.Type != CustomFieldTypeSingleList && f.Type != CustomFieldTypeRadio) {
+		return nil, false
+	}
+	return f.items[0], true
+}
+
+// AsItems returns the selected items of a list type field:
+// [CustomFieldTypeSingleList], [CustomFieldTypeMultipleList],
+// [CustomFieldTypeCheckbox] or [CustomFieldTypeRadio].
+// Single-choice fields return one item.
+func (f *IssueCustomField) AsItems() ([]*CustomFieldItem, bool) {
+	if !f.set {
+		return nil, false
+	}
+	switch f.Type {
+	case CustomFieldTypeSingleList, CustomFieldTypeMultipleList, CustomFieldTypeCheckbox, CustomFieldTypeRadio:
+		return f.items, true
+	}
+	return nil, false
+}
+
+// CustomFieldByID returns the custom field of the issue with the given ID,
+// or nil if the issue has no such field.
+func (i *Issue) CustomFieldByID(id int) *IssueCustomField {
+	for _, f := range i.CustomFields {
+		if f != nil && f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// CustomFieldByName returns the custom field of the issue with the given
+// name, or nil if the issue has no such field.
+func (i *Issue) CustomFieldByName(name string) *IssueCustomField {
+	for _, f := range i.CustomFields {
+		if f != nil && f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Model converters
+// ──────────────────────────────────────────────────────────────
+
+func issueCustomFieldFromModel(m *model.IssueCustomField) *IssueCustomField {
+	if m == nil {
+		return nil
+	}
+	f := &IssueCustomField{
+		ID:         m.ID,
+		Type:       CustomFieldType(m.FieldTypeID),
+		Name:       m.Name,
+		OtherValue: m.OtherValue,
+	}
+	f.decodeValue(m.Value)
+	return f
+}
+
+func issueCustomFieldsFromModel(m []*model.IssueCustomField) []*IssueCustomField {
+	if m == nil {
+		return nil
+	}
+	result := make([]*IssueCustomField, len(m))
+	for i, v := range m {
+		result[i] = issueCustomFieldFromModel(v)
+	}
+	return result
+}
+
+// decodeValue decodes raw according to f.Type.
+// A value that does not have the shape expected for the type is left unset.
+func (f *IssueCustomField) decodeValue(raw json.RawMessage) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return
+	}
+
+	switch f.Type {
+	case CustomFieldTypeText, CustomFieldTypeSentence:
+		if err := json.Unmarshal(raw, &f.text); err != nil {
+			return
+		}
+	case CustomFieldTypeNumber:
+		// Backlog may send numbers either as JSON numbers or as strings.
+		var v json.Number
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return
+		}
+		n, err := v.Float64()
+		if err != nil {
+			return
+		}
+		f.number = n
+	case CustomFieldTypeDate:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil || len(v) < len("2006-01-02") {
+			return
+		}
+		d, err := NewDate(v[:len("2006-01-02")])
+		if err != nil {
+			return
+		}
+		f.date = d
+	case CustomFieldTypeSingleList, CustomFieldTypeMultipleList, CustomFieldTypeCheckbox, CustomFieldTypeRadio:
+		var items []*model.CustomFieldItem
+		if raw[0] == '[' {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return
+			}
+		} else {
+			var item model.CustomFieldItem
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return
+			}
+			items = []*model.CustomFieldItem{&item}
+		}
+		if len(items) == 0 {
+			return
+		}
+		f.items = make([]*CustomFieldItem, len(items))
+		for i, v := range items {
+			f.items[i] = customFieldItemFromModel(v)
+		}
+	default:
+		return
+	}
+
+	f.set = true
+}
@@ -0,0 +1,126 @@
+package backlog_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func newIssueWithCustomFields(t *testing.T) *backlog.Issue {
+	t.Helper()
+
+	c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{
+		T: t,
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mock.NewResponse(fixture.Issue.CustomFieldsJSON), nil
+		},
+	}))
+	require.NoError(t, err)
+
+	issue, err := c.Issue.One(context.Background(), "PRJ-1")
+	require.NoError(t, err)
+	require.Len(t, issue.CustomFields, 9)
+
+	return issue
+}
+
+func TestIssueCustomField(t *testing.T) {
+	issue := newIssueWithCustomFields(t)
+
+	t.Run("text", func(t *testing.T) {
+		f := issue.CustomFieldByName("Browser")
+		require.NotNil(t, f)
+		assert.Equal(t, backlog.CustomFieldTypeText, f.Type)
+		assert.True(t, f.IsSet())
+		got, ok := f.AsText()
+		assert.True(t, ok)
+		assert.Equal(t, "Firefox", got)
+		_, ok = f.AsNumber()
+		assert.False(t, ok)
+	})
+
+	t.Run("sentence", func(t *testing.T) {
+		got, ok := issue.CustomFieldByID(2).AsText()
+		assert.True(t, ok)
+		assert.Equal(t, "1. Open\n2. Click", got)
+	})
+
+	t.Run("number", func(t *testing.T) {
+		f := issue.CustomFieldByName("Points")
+		require.NotNil(t, f)
+		got, ok := f.AsNumber()
+		assert.True(t, ok)
+		assert.Equal(t, 2.5, got)
+		_, ok = f.AsText()
+		assert.False(t, ok)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		got, ok := issue.CustomFieldByID(4).AsDate()
+		assert.True(t, ok)
+		assert.Equal(t, "2024-03-01", got.String())
+	})
+
+	t.Run("single-list", func(t *testing.T) {
+		f := issue.CustomFieldByName("OS")
+		require.NotNil(t, f)
+		item, ok := f.AsItem()
+		assert.True(t, ok)
+		assert.Equal(t, "Windows", item.Name)
+		items, ok := f.AsItems()
+		assert.True(t, ok)
+		require.Len(t, items, 1)
+		assert.Equal(t, 1, items[0].ID)
+	})
+
+	t.Run("multiple-list", func(t *testing.T) {
+		f := issue.CustomFieldByName("Platforms")
+		require.NotNil(t, f)
+		items, ok := f.AsItems()
+		assert.True(t, ok)
+		require.Len(t, items, 2)
+		assert.Equal(t, "iOS", items[0].Name)
+		assert.Equal(t, "Android", items[1].Name)
+		_, ok = f.AsItem()
+		assert.False(t, ok)
+	})
+
+	t.Run("checkbox", func(t *testing.T) {
+		f := issue.CustomFieldByID(7)
+		require.NotNil(t, f)
+		items, ok := f.AsItems()
+		assert.True(t, ok)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Reviewed", items[0].Name)
+		assert.Equal(t, "Tested on staging", f.OtherValue)
+	})
+
+	t.Run("radio", func(t *testing.T) {
+		f := issue.CustomFieldByName("Severity")
+		require.NotNil(t, f)
+		item, ok := f.AsItem()
+		assert.True(t, ok)
+		assert.Equal(t, 2, item.ID)
+		assert.Empty(t, f.OtherValue)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		f := issue.CustomFieldByName("Cost")
+		require.NotNil(t, f)
+		assert.False(t, f.IsSet())
+		_, ok := f.AsNumber()
+		assert.False(t, ok)
+	})
+
+	t.Run("not-found", func(t *testing.T) {
+		assert.Nil(t, issue.CustomFieldByID(100))
+		assert.Nil(t, issue.CustomFieldByName("Unknown"))
+	})
+}
@@ -16,6 +16,7 @@ type IssueSharedFileService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-linked-shared-files
 func (s *IssueSharedFileService) List(ctx context.Context, issueIDOrKey string) ([]*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Issue.SharedFile.List")
 	v, err := s.base.List(ctx, issueIDOrKey)
 	return sharedFilesFromModel(v), convertError(err)
 }
@@ -24,6 +25,7 @@ func (s *IssueSharedFileService) List(ctx context.Context, issueIDOrKey string)
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/link-shared-files-to-issue
 func (s *IssueSharedFileService) Link(ctx context.Context, issueIDOrKey string, fileIDs []int) ([]*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Issue.SharedFile.Link")
 	v, err := s.base.Link(ctx, issueIDOrKey, fileIDs)
 	return sharedFilesFromModel(v), convertError(err)
 }
@@ -32,6 +34,7 @@ func (s *IssueSharedFileService) Link(ctx context.Context, issueIDOrKey string,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-link-to-shared-file-from-issue
 func (s *IssueSharedFileService) Unlink(ctx context.Context, issueIDOrKey string, fileID int) (*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Issue.SharedFile.Unlink")
 	v, err := s.base.Unlink(ctx, issueIDOrKey, fileID)
 	return sharedFileFromModel(v), convertError(err)
 }
@@ -114,6 +114,49 @@ func TestIssueService(t *testing.T) {
 				assert.True(t, errors.As(err, &target))
 			},
 		},
+		"AllPrefetch": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				if req.URL.Path == "/api/v2/issues/count" {
+					assert.Empty(t, req.URL.Query().Get("order"))
+					return mock.NewResponse(`{"count":2}`), nil
+				}
+				assert.Equal(t, "/api/v2/issues", req.URL.Path)
+				assert.Equal(t, "100", req.URL.Query().Get("count"))
+				assert.Equal(t, "0", req.URL.Query().Get("offset"))
+				assert.Equal(t, "asc", req.URL.Query().Get("order"))
+				return mock.NewResponse(fixture.Issue.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Issue.AllPrefetch(ctx, 100, 3, c.Issue.Option.WithOrder(backlog.OrderAsc))
+				require.NoError(t, err)
+				var got []int
+				for iss, err := range seq {
+					require.NoError(t, err)
+					got = append(got, iss.ID)
+				}
+				assert.Equal(t, []int{1, 2}, got)
+			},
+		},
+		"AllPrefetch/error-count": {
+			doFunc: mock.NewUnauthorizedDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Issue.AllPrefetch(ctx, 100, 3)
+				require.NoError(t, err)
+				for iss, err := range seq {
+					assert.Nil(t, iss)
+					var target *backlog.APIResponseError
+					assert.True(t, errors.As(err, &target))
+				}
+			},
+		},
+		"AllPrefetch/error-invalid-prefetch": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Issue.AllPrefetch(ctx, 100, 11)
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
 		"Count": {
 			doFunc: func(req *http.Request) (*http.Response, error) {
 				assert.Equal(t, http.MethodGet, req.Method)
@@ -258,6 +301,21 @@ func TestIssueService(t *testing.T) {
 				assert.Equal(t, 1, got.ID)
 			},
 		},
+		"Update/resolution-fixed": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "4", req.PostForm.Get("statusId"))
+				assert.Equal(t, "0", req.PostForm.Get("resolutionId"))
+				return mock.NewResponse(fixture.Issue.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Issue.Update(ctx, "PRJ-1",
+					c.Issue.Option.WithStatusID(backlog.IssueStatusClosed),
+					c.Issue.Option.WithResolutionID(backlog.ResolutionFixed),
+				)
+				require.NoError(t, err)
+			},
+		},
 		"Update/error": {
 			doFunc: mock.NewNotFoundDoFunc(),
 			call: func(t *testing.T, c *backlog.Client) {
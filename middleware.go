@@ -0,0 +1,54 @@
+package backlog
+
+import (
+	"net/http"
+
+	"github.com/nattokin/go-backlog/internal/client"
+)
+
+// Handler performs a single Backlog API call.
+//
+// op is the name of the service method that issued the request, such as
+// "Issue.List" or "Project.Status.Create". It is empty for requests that are
+// not issued through a service method.
+//
+// A Handler returns either a successful response, whose body has not been
+// read yet, or an error. Error responses from the API are returned as
+// [*APIResponseError]. Responses without content (204 No Content) are
+// returned as (nil, nil).
+type Handler func(op string, req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler to observe or modify requests and responses.
+//
+// A Middleware must call next exactly once to perform the request, unless it
+// short-circuits the call by returning its own response or error. If it reads
+// the response body, it must replace it with an equivalent unread body.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware returns a ClientOption that wraps every API call made by the
+// Client with the given middleware.
+//
+// Middleware are applied in order: the first one is the outermost and sees the
+// request first and the response last. Calling WithMiddleware more than once
+// appends to the chain. Each call passes through the chain once; rate limit
+// waits, token refreshes and retries happen inside it.
+func WithMiddleware(mw ...Middleware) *ClientOption {
+	inner := make([]client.Middleware, 0, len(mw))
+	for _, m := range mw {
+		if m != nil {
+			inner = append(inner, m.inner())
+		}
+	}
+	return &ClientOption{inner: client.WithMiddleware(inner...)}
+}
+
+// inner adapts m to the internal client, converting errors returned by the
+// rest of the chain to their public types.
+func (m Middleware) inner() client.Middleware {
+	return func(next client.Handler) client.Handler {
+		return client.Handler(m(func(op string, req *http.Request) (*http.Response, error) {
+			resp, err := next(op, req)
+			return resp, convertError(err)
+		}))
+	}
+}
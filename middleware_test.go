@@ -0,0 +1,126 @@
+package backlog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		call     func(c *Client) error
+		response *http.Response
+		wantOp   string
+		wantPath string
+		wantErr  bool
+	}{
+		"Issue.List": {
+			call: func(c *Client) error {
+				_, err := c.Issue.List(context.Background())
+				return err
+			},
+			response: mock.NewResponse(`[]`),
+			wantOp:   "Issue.List",
+			wantPath: "/api/v2/issues",
+		},
+		"Project.Status.List": {
+			call: func(c *Client) error {
+				_, err := c.Project.Status.List(context.Background(), "PRJ")
+				return err
+			},
+			response: mock.NewResponse(`[]`),
+			wantOp:   "Project.Status.List",
+			wantPath: "/api/v2/projects/PRJ/statuses",
+		},
+		"Issue.Star.Add-keeps-outermost-name": {
+			call: func(c *Client) error {
+				return c.Issue.Star.Add(context.Background(), 1)
+			},
+			response: mock.NewResponse(``),
+			wantOp:   "Issue.Star.Add",
+			wantPath: "/api/v2/stars",
+		},
+		"error-Space.Info": {
+			call: func(c *Client) error {
+				_, err := c.Space.Info(context.Background())
+				return err
+			},
+			response: mock.NewNotFoundResponse(),
+			wantOp:   "Space.Info",
+			wantPath: "/api/v2/space",
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotOp, gotPath string
+			var gotErr error
+			mw := func(next Handler) Handler {
+				return func(op string, req *http.Request) (*http.Response, error) {
+					gotOp, gotPath = op, req.URL.Path
+					resp, err := next(op, req)
+					gotErr = err
+					return resp, err
+				}
+			}
+
+			c, err := NewClient("https://example.com", "token",
+				WithDoer(&mock.Doer{T: t, DoFunc: func(_ *http.Request) (*http.Response, error) {
+					return tc.response, nil
+				}}),
+				WithMiddleware(mw),
+			)
+			require.NoError(t, err)
+
+			err = tc.call(c)
+
+			assert.Equal(t, tc.wantOp, gotOp)
+			assert.Equal(t, tc.wantPath, gotPath)
+			if tc.wantErr {
+				require.Error(t, err)
+				// Middleware sees the same public error type as the caller.
+				assert.IsType(t, &APIResponseError{}, gotErr)
+				assert.IsType(t, &APIResponseError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestWithMiddleware_order(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(op string, req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+":before")
+				resp, err := next(op, req)
+				calls = append(calls, name+":after")
+				return resp, err
+			}
+		}
+	}
+
+	c, err := NewClient("https://example.com", "token",
+		WithDoer(&mock.Doer{T: t, DoFunc: func(_ *http.Request) (*http.Response, error) {
+			calls = append(calls, "request")
+			return mock.NewResponse(`{}`), nil
+		}}),
+		WithMiddleware(record("first"), record("second")),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Space.Info(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"first:before", "second:before", "request", "second:after", "first:after"}, calls)
+}
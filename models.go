@@ -66,11 +66,22 @@ type Comment struct {
 }
 
 // FileData represents a downloaded binary file with its metadata.
-// Body must be closed by the caller after use.
+// Body must be closed by the caller after use, unless it is consumed with
+// [FileData.WriteTo] or [FileData.SaveTo], which close it.
 type FileData struct {
-	Body        io.ReadCloser
+	Body io.ReadCloser
+	// Filename is the name sent by the server in Content-Disposition. It is
+	// not sanitized and may contain path separators; use [FileData.SaveTo]
+	// to store the file under a safe name.
 	Filename    string
 	ContentType string
+	// Size is the expected length of Body in bytes, or 0 if it is unknown.
+	// It is taken from Content-Length and can be set to a size known in
+	// advance, such as Attachment.Size, before calling WriteTo or SaveTo.
+	Size int64
+	// Progress, if set, is called by WriteTo and SaveTo after each chunk is
+	// written, with the number of bytes written so far and Size.
+	Progress func(written, total int64)
 }
 
 // Licence represents the licence information for a Backlog space.
@@ -111,6 +122,10 @@ type Licence struct {
 }
 
 // Notification represents a notification delivered to a user.
+//
+// Reason holds one of the NotificationReason values as an int. Compare it with
+// int(NotificationReasonIssueAssigned) and so on, or convert it with
+// NotificationReason(n.Reason) to print its name.
 type Notification struct {
 	ID                  int
 	AlreadyRead         bool
@@ -171,14 +186,18 @@ type Version struct {
 
 // Watching represents an item in a user's watching list.
 type Watching struct {
-	ID                 int
-	AlreadyRead        bool
-	Note               string
-	Type               string
-	Issue              *Issue
-	LastContentUpdated Timestamp
-	Created            Timestamp
-	Updated            Timestamp
+	ID int
+	// AlreadyRead reports whether the watching itself has been read.
+	AlreadyRead bool
+	// ResourceAlreadyRead reports whether the watched issue has been read
+	// since it was last updated.
+	ResourceAlreadyRead bool
+	Note                string
+	Type                string
+	Issue               *Issue
+	LastContentUpdated  Timestamp
+	Created             Timestamp
+	Updated             Timestamp
 }
 
 // ──────────────────────────────────────────────────────────────
@@ -376,5 +395,6 @@ func fileDataFromModel(m *model.FileData) *FileData {
 		Body:        m.Body,
 		Filename:    m.Filename,
 		ContentType: m.ContentType,
+		Size:        m.Size,
 	}
 }
@@ -1,6 +1,7 @@
 package backlog
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -301,10 +302,8 @@ func Test_issueFromModel(t *testing.T) {
 				Created:        created,
 				UpdatedUser:    user,
 				Updated:        updated,
-				CustomFields: []*model.CustomField{
-					{ID: 1, TypeID: 6, Name: "OS", Items: []*model.CustomFieldItem{
-						{ID: 1, Name: "Windows", DisplayOrder: 0},
-					}},
+				CustomFields: []*model.IssueCustomField{
+					{ID: 1, FieldTypeID: 6, Name: "OS", Value: json.RawMessage(`[{"id":1,"name":"Windows","displayOrder":0}]`)},
 				},
 				Attachments: []*model.Attachment{
 					{ID: 10, Name: "file.txt", Size: 100, CreatedUser: user, Created: created},
@@ -349,8 +348,8 @@ func Test_issueFromModel(t *testing.T) {
 				Created:        Timestamp{created},
 				UpdatedUser:    wantUser,
 				Updated:        Timestamp{updated},
-				CustomFields: []*CustomField{
-					{ID: 1, TypeID: 6, Name: "OS", Items: []*CustomFieldItem{
+				CustomFields: []*IssueCustomField{
+					{ID: 1, Type: CustomFieldTypeMultipleList, Name: "OS", set: true, items: []*CustomFieldItem{
 						{ID: 1, Name: "Windows", DisplayOrder: 0},
 					}},
 				},
@@ -371,7 +370,7 @@ func Test_issueFromModel(t *testing.T) {
 				Category:     []*model.Category{nil},
 				Versions:     []*model.Version{nil},
 				Milestone:    []*model.Version{nil},
-				CustomFields: []*model.CustomField{nil},
+				CustomFields: []*model.IssueCustomField{nil},
 				Attachments:  []*model.Attachment{nil},
 				SharedFiles:  []*model.SharedFile{nil},
 				Stars:        []*model.Star{nil},
@@ -381,7 +380,7 @@ func Test_issueFromModel(t *testing.T) {
 				Category:     []*Category{nil},
 				Versions:     []*Version{nil},
 				Milestone:    []*Version{nil},
-				CustomFields: []*CustomField{nil},
+				CustomFields: []*IssueCustomField{nil},
 				Attachments:  []*Attachment{nil},
 				SharedFiles:  []*SharedFile{nil},
 				Stars:        []*Star{nil},
@@ -398,6 +397,80 @@ func Test_issueFromModel(t *testing.T) {
 	}
 }
 
+func Test_issueCustomFieldFromModel(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input *model.IssueCustomField
+		want  *IssueCustomField
+	}{
+		"nil": {
+			input: nil,
+			want:  nil,
+		},
+		"text": {
+			input: &model.IssueCustomField{ID: 1, FieldTypeID: 1, Name: "Browser", Value: json.RawMessage(`"Firefox"`)},
+			want:  &IssueCustomField{ID: 1, Type: CustomFieldTypeText, Name: "Browser", set: true, text: "Firefox"},
+		},
+		"number": {
+			input: &model.IssueCustomField{ID: 3, FieldTypeID: 3, Value: json.RawMessage(`2.5`)},
+			want:  &IssueCustomField{ID: 3, Type: CustomFieldTypeNumber, set: true, number: 2.5},
+		},
+		"number-as-string": {
+			input: &model.IssueCustomField{ID: 3, FieldTypeID: 3, Value: json.RawMessage(`"10"`)},
+			want:  &IssueCustomField{ID: 3, Type: CustomFieldTypeNumber, set: true, number: 10},
+		},
+		"date": {
+			input: &model.IssueCustomField{ID: 4, FieldTypeID: 4, Value: json.RawMessage(`"2024-03-01"`)},
+			want:  &IssueCustomField{ID: 4, Type: CustomFieldTypeDate, set: true, date: Date{value: "2024-03-01"}},
+		},
+		"date-with-time": {
+			input: &model.IssueCustomField{ID: 4, FieldTypeID: 4, Value: json.RawMessage(`"2024-03-01T00:00:00Z"`)},
+			want:  &IssueCustomField{ID: 4, Type: CustomFieldTypeDate, set: true, date: Date{value: "2024-03-01"}},
+		},
+		"single-item": {
+			input: &model.IssueCustomField{ID: 5, FieldTypeID: 5, Value: json.RawMessage(`{"id":1,"name":"Windows","displayOrder":0}`)},
+			want: &IssueCustomField{ID: 5, Type: CustomFieldTypeSingleList, set: true, items: []*CustomFieldItem{
+				{ID: 1, Name: "Windows"},
+			}},
+		},
+		"checkbox-with-other-value": {
+			input: &model.IssueCustomField{ID: 7, FieldTypeID: 7, Value: json.RawMessage(`[{"id":3,"name":"Reviewed"}]`), OtherValue: "other"},
+			want: &IssueCustomField{ID: 7, Type: CustomFieldTypeCheckbox, OtherValue: "other", set: true, items: []*CustomFieldItem{
+				{ID: 3, Name: "Reviewed"},
+			}},
+		},
+		"null-value": {
+			input: &model.IssueCustomField{ID: 9, FieldTypeID: 3, Value: json.RawMessage(`null`)},
+			want:  &IssueCustomField{ID: 9, Type: CustomFieldTypeNumber},
+		},
+		"empty-items": {
+			input: &model.IssueCustomField{ID: 6, FieldTypeID: 6, Value: json.RawMessage(`[]`)},
+			want:  &IssueCustomField{ID: 6, Type: CustomFieldTypeMultipleList},
+		},
+		"mismatched-shape": {
+			input: &model.IssueCustomField{ID: 3, FieldTypeID: 3, Value: json.RawMessage(`{"id":1}`)},
+			want:  &IssueCustomField{ID: 3, Type: CustomFieldTypeNumber},
+		},
+		"invalid-date": {
+			input: &model.IssueCustomField{ID: 4, FieldTypeID: 4, Value: json.RawMessage(`"2024-13-01"`)},
+			want:  &IssueCustomField{ID: 4, Type: CustomFieldTypeDate},
+		},
+		"unknown-type": {
+			input: &model.IssueCustomField{ID: 10, FieldTypeID: 99, Value: json.RawMessage(`"x"`)},
+			want:  &IssueCustomField{ID: 10, Type: 99},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, issueCustomFieldFromModel(tc.input))
+		})
+	}
+}
+
 func Test_notificationFromModel(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,157 @@
+package backlog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/domain/notification"
+	"github.com/nattokin/go-backlog/internal/option"
+)
+
+// ──────────────────────────────────────────────────────────────
+//  NotificationService
+// ──────────────────────────────────────────────────────────────
+
+// NotificationService handles communication with the notification-related methods of the Backlog API.
+type NotificationService struct {
+	base *notification.Service
+
+	Option *NotificationOptionService
+}
+
+// List returns notifications of the authenticated user.
+//
+// This method supports options returned by methods in "*Client.Notification.Option",
+// such as:
+//   - WithCount
+//   - WithMaxID
+//   - WithMinID
+//   - WithOrder
+//   - WithSenderID
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-notification
+func (s *NotificationService) List(ctx context.Context, opts ...RequestOption) ([]*Notification, error) {
+	ctx = client.WithOperation(ctx, "Notification.List")
+	v, err := s.base.List(ctx, toInnerOptions(opts)...)
+	return notificationsFromModel(v), convertError(err)
+}
+
+// All returns an iterator that lazily fetches all notifications of the
+// authenticated user with automatic cursor pagination, along with any
+// validation error encountered at call time.
+//
+// perPage controls how many notifications are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.Notification.Option",
+// such as:
+//   - WithOrder
+//   - WithSenderID
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-notification
+func (s *NotificationService) All(ctx context.Context, perPage int, opts ...RequestOption) (iter.Seq2[*Notification, error], error) {
+	ctx = client.WithOperation(ctx, "Notification.All")
+	seq, err := s.base.All(ctx, perPage, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Notification, error) bool) {
+		for v, err := range seq {
+			if !yield(notificationFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
+// Count returns the number of notifications of the authenticated user.
+//
+// This method supports options returned by methods in "*Client.Notification.Option",
+// such as:
+//   - WithAlreadyRead
+//   - WithResourceAlreadyRead
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-notification
+func (s *NotificationService) Count(ctx context.Context, opts ...RequestOption) (int, error) {
+	ctx = client.WithOperation(ctx, "Notification.Count")
+	v, err := s.base.Count(ctx, toInnerOptions(opts)...)
+	return v, convertError(err)
+}
+
+// ResetUnreadCount resets the unread notification count of the authenticated
+// user and returns the count after the reset.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/reset-unread-notification-count
+func (s *NotificationService) ResetUnreadCount(ctx context.Context) (int, error) {
+	ctx = client.WithOperation(ctx, "Notification.ResetUnreadCount")
+	v, err := s.base.ResetUnreadCount(ctx)
+	return v, convertError(err)
+}
+
+// MarkAsRead marks a notification as read.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/read-notification
+func (s *NotificationService) MarkAsRead(ctx context.Context, notificationID int) error {
+	ctx = client.WithOperation(ctx, "Notification.MarkAsRead")
+	return convertError(s.base.MarkAsRead(ctx, notificationID))
+}
+
+// ──────────────────────────────────────────────────────────────
+//  NotificationOptionService
+// ──────────────────────────────────────────────────────────────
+
+// NotificationOptionService provides a domain-specific set of option builders
+// for operations within the NotificationService.
+type NotificationOptionService struct {
+	base *option.OptionService
+}
+
+// WithAlreadyRead filters notifications by whether they have been read.
+func (s *NotificationOptionService) WithAlreadyRead(enabled bool) RequestOption {
+	return &requestOption{opt: s.base.WithAlreadyRead(enabled)}
+}
+
+// WithCount sets the number of notifications to retrieve (1-100).
+func (s *NotificationOptionService) WithCount(count int) RequestOption {
+	return &requestOption{opt: s.base.WithCount(count)}
+}
+
+// WithMaxID filters notifications whose ID is less than or equal to id.
+func (s *NotificationOptionService) WithMaxID(id int) RequestOption {
+	return &requestOption{opt: s.base.WithMaxID(id)}
+}
+
+// WithMinID filters notifications whose ID is greater than or equal to id.
+func (s *NotificationOptionService) WithMinID(id int) RequestOption {
+	return &requestOption{opt: s.base.WithMinID(id)}
+}
+
+// WithOrder sets the sort order of results.
+func (s *NotificationOptionService) WithOrder(order Order) RequestOption {
+	return &requestOption{opt: s.base.WithOrder(string(order))}
+}
+
+// WithResourceAlreadyRead filters notifications by whether the notified
+// resource has been read.
+func (s *NotificationOptionService) WithResourceAlreadyRead(enabled bool) RequestOption {
+	return &requestOption{opt: s.base.WithResourceAlreadyRead(enabled)}
+}
+
+// WithSenderID filters notifications by the ID of the user who triggered them.
+func (s *NotificationOptionService) WithSenderID(id int) RequestOption {
+	return &requestOption{opt: s.base.WithSenderID(id)}
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Constructor
+// ──────────────────────────────────────────────────────────────
+
+func newNotificationService(method *client.Method, option *option.OptionService) *NotificationService {
+	return &NotificationService{
+		base:   notification.NewService(method),
+		Option: &NotificationOptionService{base: option},
+	}
+}
@@ -0,0 +1,165 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestNotificationService(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"List": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/notifications", req.URL.Path)
+				assert.Equal(t, "21", req.URL.Query().Get("minId"))
+				assert.Equal(t, "22", req.URL.Query().Get("maxId"))
+				assert.Equal(t, "2", req.URL.Query().Get("senderId"))
+				assert.Equal(t, "desc", req.URL.Query().Get("order"))
+				return mock.NewResponse(fixture.Notification.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Notification.List(ctx,
+					c.Notification.Option.WithMinID(21),
+					c.Notification.Option.WithMaxID(22),
+					c.Notification.Option.WithSenderID(2),
+					c.Notification.Option.WithOrder(backlog.OrderDesc),
+				)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				assert.Equal(t, int(backlog.NotificationReasonIssueCommented), got[0].Reason)
+				assert.Equal(t, "looks good", got[0].Comment.Content)
+				assert.Equal(t, int(backlog.NotificationReasonPullRequestAdded), got[1].Reason)
+				assert.Equal(t, 1, got[1].PullRequest.Number)
+				assert.True(t, got[1].AlreadyRead)
+			},
+		},
+		"List/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Notification.List(ctx)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"List/validation-error": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Notification.List(ctx, c.Notification.Option.WithSenderID(0))
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"All": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/notifications", req.URL.Path)
+				assert.Equal(t, "100", req.URL.Query().Get("count"))
+				assert.Equal(t, "2", req.URL.Query().Get("senderId"))
+				return mock.NewResponse(fixture.Notification.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Notification.All(ctx, 100, c.Notification.Option.WithSenderID(2))
+				require.NoError(t, err)
+				var got []int
+				for v, err := range seq {
+					require.NoError(t, err)
+					got = append(got, v.ID)
+				}
+				assert.Equal(t, []int{22, 21}, got)
+			},
+		},
+		"All/error-invalid-count": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Notification.All(ctx, 0)
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"All/error-cursor-option": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Notification.All(ctx, 100, c.Notification.Option.WithMaxID(1))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"Count": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/api/v2/notifications/count", req.URL.Path)
+				assert.Equal(t, "false", req.URL.Query().Get("alreadyRead"))
+				assert.Equal(t, "false", req.URL.Query().Get("resourceAlreadyRead"))
+				return mock.NewResponse(fixture.Notification.CountJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Notification.Count(ctx,
+					c.Notification.Option.WithAlreadyRead(false),
+					c.Notification.Option.WithResourceAlreadyRead(false),
+				)
+				require.NoError(t, err)
+				assert.Equal(t, 10, got)
+			},
+		},
+		"Count/invalid-option": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Notification.Count(ctx, c.Notification.Option.WithCount(5))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"ResetUnreadCount": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/notifications/markAsRead", req.URL.Path)
+				return mock.NewResponse(fixture.Notification.MarkReadJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Notification.ResetUnreadCount(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, 0, got)
+			},
+		},
+		"MarkAsRead": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/notifications/22/markAsRead", req.URL.Path)
+				return mock.NewNoContentResponse(), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				require.NoError(t, c.Notification.MarkAsRead(ctx, 22))
+			},
+		},
+		"MarkAsRead/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				err := c.Notification.MarkAsRead(ctx, 22)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			tc.call(t, c)
+		})
+	}
+}
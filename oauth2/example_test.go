@@ -0,0 +1,47 @@
+package oauth2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/oauth2"
+)
+
+// Example demonstrates the authorization code flow and using the resulting
+// token with a Backlog client.
+func Example() {
+	conf := &oauth2.Config{
+		BaseURL:      "https://example.backlog.com",
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://app.example.com/callback",
+	}
+
+	// Redirect the user to the consent page.
+	fmt.Println(conf.AuthCodeURL("state"))
+
+	// In the redirect handler, exchange the code for a token.
+	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		tok, err := conf.Exchange(r.Context(), r.URL.Query().Get("code"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ts := conf.TokenSource(tok, func(t *oauth2.Token) error {
+			// Persist t so the session survives restarts.
+			return nil
+		})
+
+		c, err := backlog.NewClient(conf.BaseURL, "", backlog.WithTokenSource(ts))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = c.Space.Info(context.Background())
+	})
+	// Output:
+	// https://example.backlog.com/OAuth2AccessRequest.action?client_id=client-id&redirect_uri=https%3A%2F%2Fapp.example.com%2Fcallback&response_type=code&state=state
+}
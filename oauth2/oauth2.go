@@ -0,0 +1,263 @@
+// Package oauth2 implements the Backlog OAuth 2.0 authorization code flow.
+//
+// It builds the authorization URL, exchanges authorization codes for tokens,
+// and provides a [TokenSource] that refreshes expired access tokens. A
+// TokenSource can be passed to backlog.WithTokenSource so that the client
+// obtains a valid access token for every request.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/auth
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how long before the actual expiry a token is considered expired,
+// to avoid sending a token that expires in flight.
+const expiryDelta = 10 * time.Second
+
+// Doer defines the minimal interface required to perform HTTP requests.
+// It is compatible with *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Config describes a Backlog OAuth 2.0 application.
+type Config struct {
+	// BaseURL is the URL of the Backlog space (e.g. "https://example.backlog.com").
+	BaseURL string
+	// ClientID is the client ID of the registered application.
+	ClientID string
+	// ClientSecret is the client secret of the registered application.
+	ClientSecret string
+	// RedirectURL is the redirect URI registered for the application.
+	// It may be empty if only one redirect URI is registered.
+	RedirectURL string
+	// Doer is used to call the token endpoint. If nil, http.DefaultClient is used.
+	Doer Doer
+}
+
+// Token represents an OAuth 2.0 token issued by Backlog.
+type Token struct {
+	AccessToken  string
+	TokenType    string
+	RefreshToken string
+	// Expiry is the time at which the access token expires.
+	// A zero value means the token does not expire.
+	Expiry time.Time
+}
+
+// Valid reports whether t holds an access token that has not expired.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(t.Expiry)
+}
+
+// RetrieveError is returned when the token endpoint responds with a non-2xx status.
+type RetrieveError struct {
+	StatusCode int
+	Body       []byte
+}
+
+// Error implements the error interface.
+func (e *RetrieveError) Error() string {
+	return fmt.Sprintf("oauth2: cannot fetch token: status code %d: %s", e.StatusCode, e.Body)
+}
+
+// AuthCodeURL returns the URL of the Backlog consent page that asks the user
+// to authorize the application. state is echoed back to the redirect URI and
+// should be an unguessable value used to protect against CSRF.
+func (c *Config) AuthCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", c.ClientID)
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	return c.endpoint("OAuth2AccessRequest.action") + "?" + v.Encode()
+}
+
+// Exchange converts an authorization code received on the redirect URI into a token.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/auth
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	if c.RedirectURL != "" {
+		form.Set("redirect_uri", c.RedirectURL)
+	}
+	return c.retrieveToken(ctx, form)
+}
+
+// Refresh obtains a new token using refreshToken.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/auth
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	return c.retrieveToken(ctx, form)
+}
+
+// TokenSource returns a TokenSource that starts from t and refreshes it when
+// it expires or is rejected by the API.
+//
+// onRefresh, if not nil, is called with every newly issued token so that it
+// can be persisted. If onRefresh returns an error, the token is still used but
+// the error is returned to the caller that triggered the refresh.
+func (c *Config) TokenSource(t *Token, onRefresh func(*Token) error) *TokenSource {
+	return &TokenSource{
+		config:    c,
+		token:     t,
+		onRefresh: onRefresh,
+	}
+}
+
+func (c *Config) endpoint(elem string) string {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return strings.TrimRight(c.BaseURL, "/") + "/" + elem
+	}
+	u.Path = path.Join(u.Path, elem)
+	return u.String()
+}
+
+type tokenJSON struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+func (c *Config) retrieveToken(ctx context.Context, form url.Values) (*Token, error) {
+	form.Set("client_id", c.ClientID)
+	form.Set("client_secret", c.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint("api/v2/oauth2/token"), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	doer := c.Doer
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &RetrieveError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	var v tokenJSON
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, err
+	}
+	if v.AccessToken == "" {
+		return nil, errors.New("oauth2: server response missing access_token")
+	}
+
+	t := &Token{
+		AccessToken:  v.AccessToken,
+		TokenType:    v.TokenType,
+		RefreshToken: v.RefreshToken,
+	}
+	if v.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(v.ExpiresIn) * time.Second)
+	}
+	return t, nil
+}
+
+// TokenSource supplies access tokens, refreshing them as needed.
+// It is safe for concurrent use and satisfies backlog.TokenSource.
+type TokenSource struct {
+	config    *Config
+	onRefresh func(*Token) error
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// Token returns a valid access token, refreshing the current token first if it has expired.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token.AccessToken, nil
+	}
+	return s.refreshLocked(ctx)
+}
+
+// Refresh obtains a new access token after the API rejected the access token
+// given as rejected. If the current token differs from rejected, it has
+// already been refreshed by another caller and is returned as is.
+func (s *TokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken != rejected && s.token.Valid() {
+		return s.token.AccessToken, nil
+	}
+	return s.refreshLocked(ctx)
+}
+
+// Current returns a copy of the current token, or nil if there is none.
+func (s *TokenSource) Current() *Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil
+	}
+	t := *s.token
+	return &t
+}
+
+func (s *TokenSource) refreshLocked(ctx context.Context) (string, error) {
+	if s.token == nil || s.token.RefreshToken == "" {
+		return "", errors.New("oauth2: token expired and refresh token is not set")
+	}
+
+	t, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+	if t.RefreshToken == "" {
+		t.RefreshToken = s.token.RefreshToken
+	}
+	s.token = t
+
+	if s.onRefresh != nil {
+		if err := s.onRefresh(t); err != nil {
+			return "", fmt.Errorf("oauth2: persisting refreshed token: %w", err)
+		}
+	}
+	return t.AccessToken, nil
+}
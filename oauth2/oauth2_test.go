@@ -0,0 +1,296 @@
+package oauth2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/oauth2"
+)
+
+// newTokenServer starts an httptest server emulating the Backlog token endpoint.
+// handler receives the parsed form of every token request.
+func newTokenServer(t *testing.T, handler func(w http.ResponseWriter, form url.Values)) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/oauth2/token", r.URL.Path)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "client-secret", r.PostForm.Get("client_secret"))
+
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r.PostForm)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newConfig(baseURL string) *oauth2.Config {
+	return &oauth2.Config{
+		BaseURL:      baseURL,
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://app.example.com/callback",
+	}
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	c := newConfig("https://example.backlog.com")
+
+	got, err := url.Parse(c.AuthCodeURL("xyz"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.backlog.com", got.Host)
+	assert.Equal(t, "/OAuth2AccessRequest.action", got.Path)
+	assert.Equal(t, url.Values{
+		"response_type": {"code"},
+		"client_id":     {"client-id"},
+		"redirect_uri":  {"https://app.example.com/callback"},
+		"state":         {"xyz"},
+	}, got.Query())
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		body   string
+
+		wantErr          bool
+		wantRetrieveErr  bool
+		wantAccessToken  string
+		wantRefreshToken string
+	}{
+		"success": {
+			status:           http.StatusOK,
+			body:             `{"access_token":"access","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh"}`,
+			wantAccessToken:  "access",
+			wantRefreshToken: "refresh",
+		},
+		"error-status": {
+			status:          http.StatusBadRequest,
+			body:            `{"error":"invalid_grant"}`,
+			wantErr:         true,
+			wantRetrieveErr: true,
+		},
+		"error-invalid-json": {
+			status:  http.StatusOK,
+			body:    `{`,
+			wantErr: true,
+		},
+		"error-missing-access-token": {
+			status:  http.StatusOK,
+			body:    `{"token_type":"Bearer"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+				assert.Equal(t, "authorization_code", form.Get("grant_type"))
+				assert.Equal(t, "the-code", form.Get("code"))
+				assert.Equal(t, "https://app.example.com/callback", form.Get("redirect_uri"))
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			tok, err := newConfig(srv.URL).Exchange(context.Background(), "the-code")
+
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, tok)
+				var re *oauth2.RetrieveError
+				assert.Equal(t, tc.wantRetrieveErr, errors.As(err, &re))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAccessToken, tok.AccessToken)
+			assert.Equal(t, tc.wantRefreshToken, tok.RefreshToken)
+			assert.Equal(t, "Bearer", tok.TokenType)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), tok.Expiry, time.Minute)
+			assert.True(t, tok.Valid())
+		})
+	}
+}
+
+func TestToken_Valid(t *testing.T) {
+	cases := map[string]struct {
+		token *oauth2.Token
+		want  bool
+	}{
+		"nil":           {token: nil, want: false},
+		"empty":         {token: &oauth2.Token{}, want: false},
+		"no-expiry":     {token: &oauth2.Token{AccessToken: "a"}, want: true},
+		"not-expired":   {token: &oauth2.Token{AccessToken: "a", Expiry: time.Now().Add(time.Hour)}, want: true},
+		"expired":       {token: &oauth2.Token{AccessToken: "a", Expiry: time.Now().Add(-time.Hour)}, want: false},
+		"expiring-soon": {token: &oauth2.Token{AccessToken: "a", Expiry: time.Now().Add(time.Second)}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.token.Valid())
+		})
+	}
+}
+
+func TestTokenSource_Token(t *testing.T) {
+	t.Run("valid-token-is-reused", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newTokenServer(t, func(w http.ResponseWriter, _ url.Values) {
+			t.Error("token endpoint must not be called")
+		})
+		ts := newConfig(srv.URL).TokenSource(&oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}, nil)
+
+		got, err := ts.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "access", got)
+	})
+
+	t.Run("expired-token-is-refreshed-and-persisted", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+			assert.Equal(t, "refresh_token", form.Get("grant_type"))
+			assert.Equal(t, "old-refresh", form.Get("refresh_token"))
+			_, _ = w.Write([]byte(`{"access_token":"new-access","token_type":"Bearer","expires_in":3600,"refresh_token":"new-refresh"}`))
+		})
+
+		var persisted *oauth2.Token
+		ts := newConfig(srv.URL).TokenSource(
+			&oauth2.Token{AccessToken: "old-access", RefreshToken: "old-refresh", Expiry: time.Now().Add(-time.Minute)},
+			func(tok *oauth2.Token) error {
+				persisted = tok
+				return nil
+			},
+		)
+
+		got, err := ts.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "new-access", got)
+		require.NotNil(t, persisted)
+		assert.Equal(t, "new-refresh", persisted.RefreshToken)
+		assert.Equal(t, "new-access", ts.Current().AccessToken)
+	})
+
+	t.Run("refresh-token-kept-when-not-rotated", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newTokenServer(t, func(w http.ResponseWriter, _ url.Values) {
+			_, _ = w.Write([]byte(`{"access_token":"new-access","expires_in":3600}`))
+		})
+		ts := newConfig(srv.URL).TokenSource(&oauth2.Token{RefreshToken: "refresh"}, nil)
+
+		_, err := ts.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "refresh", ts.Current().RefreshToken)
+	})
+
+	t.Run("error-no-refresh-token", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newConfig("https://example.backlog.com").TokenSource(&oauth2.Token{AccessToken: "a", Expiry: time.Now().Add(-time.Hour)}, nil)
+
+		_, err := ts.Token(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("error-persist", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newTokenServer(t, func(w http.ResponseWriter, _ url.Values) {
+			_, _ = w.Write([]byte(`{"access_token":"new-access","expires_in":3600}`))
+		})
+		persistErr := errors.New("disk full")
+		ts := newConfig(srv.URL).TokenSource(&oauth2.Token{RefreshToken: "refresh"}, func(*oauth2.Token) error {
+			return persistErr
+		})
+
+		_, err := ts.Token(context.Background())
+		assert.ErrorIs(t, err, persistErr)
+		// The refreshed token is kept even though persisting it failed.
+		assert.Equal(t, "new-access", ts.Current().AccessToken)
+	})
+}
+
+func TestTokenSource_Refresh(t *testing.T) {
+	var calls int32
+	srv := newTokenServer(t, func(w http.ResponseWriter, _ url.Values) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"access_token":"new-access","expires_in":3600,"refresh_token":"new-refresh"}`))
+	})
+	ts := newConfig(srv.URL).TokenSource(&oauth2.Token{
+		AccessToken:  "old-access",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(time.Hour),
+	}, nil)
+
+	// Concurrent callers rejected with the same token trigger a single refresh.
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := ts.Refresh(context.Background(), "old-access")
+			assert.NoError(t, err)
+			assert.Equal(t, "new-access", got)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestTokenSource_withClient(t *testing.T) {
+	var tokenCalls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/oauth2/token":
+			atomic.AddInt32(&tokenCalls, 1)
+			_, _ = w.Write([]byte(`{"access_token":"new-access","expires_in":3600,"refresh_token":"new-refresh"}`))
+		case "/api/v2/space":
+			if r.Header.Get("Authorization") != "Bearer new-access" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errors":[{"message":"Authentication failure.","code":11,"moreInfo":""}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"spaceKey":"nulab"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	// The stored token has not expired yet but is rejected by the API.
+	ts := newConfig(srv.URL).TokenSource(&oauth2.Token{
+		AccessToken:  "revoked-access",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(time.Hour),
+	}, nil)
+
+	c, err := backlog.NewClient(srv.URL, "", backlog.WithTokenSource(ts))
+	require.NoError(t, err)
+
+	space, err := c.Space.Info(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "nulab", space.SpaceKey)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenCalls))
+}
@@ -0,0 +1,24 @@
+package otelbacklog_test
+
+import (
+	"fmt"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/otelbacklog"
+)
+
+// ExampleNewTracer demonstrates tracing API calls with the global OpenTelemetry TracerProvider.
+func ExampleNewTracer() {
+	c, err := backlog.NewClient(
+		"https://example.backlog.com",
+		"token",
+		backlog.WithTracer(otelbacklog.NewTracer()),
+	)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(c != nil)
+	// Output:
+	// true
+}
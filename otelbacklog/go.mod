@@ -0,0 +1,29 @@
+module github.com/nattokin/go-backlog/otelbacklog
+
+go 1.23
+
+// Until the core release that adds WithTracer is tagged, build against the
+// core module in the parent directory. Replace this with a requirement on
+// that tag and its go.sum entry once it is released.
+replace github.com/nattokin/go-backlog => ../
+
+require (
+	github.com/nattokin/go-backlog v0.0.0
+	github.com/stretchr/testify v1.11.1
+	go.opentelemetry.io/otel v1.35.0
+	go.opentelemetry.io/otel/sdk v1.35.0
+	go.opentelemetry.io/otel/trace v1.35.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.35.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
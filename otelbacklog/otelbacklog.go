@@ -0,0 +1,103 @@
+// Package otelbacklog traces Backlog API calls with OpenTelemetry.
+//
+// It provides an implementation of backlog.Tracer that records every API
+// operation performed by a backlog.Client as a client span:
+//
+//	c, err := backlog.NewClient(baseURL, token,
+//		backlog.WithTracer(otelbacklog.NewTracer()),
+//	)
+//
+// It lives in its own module so that the core go-backlog module does not
+// depend on OpenTelemetry.
+package otelbacklog
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	backlog "github.com/nattokin/go-backlog"
+)
+
+// ScopeName is the instrumentation scope name used to obtain the OpenTelemetry tracer.
+const ScopeName = "github.com/nattokin/go-backlog/otelbacklog"
+
+// Option configures the Tracer returned by NewTracer.
+type Option func(*config)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans.
+// By default, the global TracerProvider is used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = tp
+	}
+}
+
+// NewTracer returns a backlog.Tracer backed by OpenTelemetry.
+func NewTracer(opts ...Option) backlog.Tracer {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.provider == nil {
+		c.provider = otel.GetTracerProvider()
+	}
+	return &tracer{tracer: c.provider.Tracer(ScopeName)}
+}
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t *tracer) Start(ctx context.Context, name string) (context.Context, backlog.Span) {
+	ctx, s := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{span: s}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) SetAttributes(attrs ...backlog.Attribute) {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		if kv, ok := keyValue(a); ok {
+			kvs = append(kvs, kv)
+		}
+	}
+	s.span.SetAttributes(kvs...)
+}
+
+func (s *span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *span) End() {
+	s.span.End()
+}
+
+// keyValue converts a to an OpenTelemetry attribute. It reports false for
+// values of unsupported types.
+func keyValue(a backlog.Attribute) (attribute.KeyValue, bool) {
+	key := attribute.Key(a.Key)
+	switch v := a.Value.(type) {
+	case string:
+		return key.String(v), true
+	case int:
+		return key.Int(v), true
+	case []int:
+		return key.IntSlice(v), true
+	case bool:
+		return key.Bool(v), true
+	default:
+		return attribute.KeyValue{}, false
+	}
+}
@@ -0,0 +1,88 @@
+package otelbacklog_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/otelbacklog"
+)
+
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+func newResponse(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func newClient(t *testing.T, resp func() *http.Response) (*backlog.Client, *tracetest.SpanRecorder) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	c, err := backlog.NewClient("https://example.backlog.com", "token",
+		backlog.WithTracer(otelbacklog.NewTracer(otelbacklog.WithTracerProvider(tp))),
+		backlog.WithDoer(doerFunc(func(req *http.Request) (*http.Response, error) {
+			// The request carries the span started for it.
+			assert.True(t, trace.SpanContextFromContext(req.Context()).IsValid())
+			return resp(), nil
+		})),
+	)
+	require.NoError(t, err)
+	return c, recorder
+}
+
+func TestTracer(t *testing.T) {
+	c, recorder := newClient(t, func() *http.Response {
+		return newResponse(http.StatusOK, `[]`)
+	})
+
+	_, err := c.Project.Category.List(context.Background(), "PRJ")
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	s := spans[0]
+	assert.Equal(t, "Project.Category.List", s.Name())
+	assert.Equal(t, trace.SpanKindClient, s.SpanKind())
+	assert.Equal(t, otelbacklog.ScopeName, s.InstrumentationScope().Name)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String(backlog.AttributeHTTPMethod, http.MethodGet),
+		attribute.String(backlog.AttributeURLPath, "/api/v2/projects/PRJ/categories"),
+		attribute.String(backlog.AttributeOperation, "Project.Category.List"),
+		attribute.String(backlog.AttributeProjectKey, "PRJ"),
+		attribute.Int(backlog.AttributeHTTPStatus, http.StatusOK),
+	}, s.Attributes())
+	assert.Equal(t, codes.Unset, s.Status().Code)
+}
+
+func TestTracer_error(t *testing.T) {
+	c, recorder := newClient(t, func() *http.Response {
+		return newResponse(http.StatusNotFound, `{"errors":[{"message":"No such project.","code":6,"moreInfo":""}]}`)
+	})
+
+	_, err := c.Project.One(context.Background(), "PRJ")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	s := spans[0]
+	assert.Equal(t, codes.Error, s.Status().Code)
+	assert.Contains(t, s.Attributes(), attribute.Int(backlog.AttributeHTTPStatus, http.StatusNotFound))
+	assert.Contains(t, s.Attributes(), attribute.IntSlice(backlog.AttributeErrorCodes, []int{6}))
+	require.Len(t, s.Events(), 1)
+	assert.Equal(t, "exception", s.Events()[0].Name)
+}
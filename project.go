@@ -47,6 +47,7 @@ type ProjectService struct {
 	CustomField *ProjectCustomFieldService
 	IssueType   *ProjectIssueTypeService
 	Status      *ProjectStatusService
+	Team        *ProjectTeamService
 	User        *ProjectUserService
 	SharedFile  *ProjectSharedFileService
 	Webhook     *ProjectWebhookService
@@ -64,6 +65,7 @@ type ProjectService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) List(ctx context.Context, opts ...RequestOption) ([]*Project, error) {
+	ctx = client.WithOperation(ctx, "Project.List")
 	v, err := s.base.List(ctx, toInnerOptions(opts)...)
 	return projectsFromModel(v), convertError(err)
 }
@@ -72,6 +74,7 @@ func (s *ProjectService) List(ctx context.Context, opts ...RequestOption) ([]*Pr
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project
 func (s *ProjectService) One(ctx context.Context, projectIDOrKey string) (*Project, error) {
+	ctx = client.WithOperation(ctx, "Project.One")
 	v, err := s.base.One(ctx, projectIDOrKey)
 	return projectFromModel(v), convertError(err)
 }
@@ -87,6 +90,7 @@ func (s *ProjectService) One(ctx context.Context, projectIDOrKey string) (*Proje
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project
 func (s *ProjectService) Create(ctx context.Context, key, name string, opts ...RequestOption) (*Project, error) {
+	ctx = client.WithOperation(ctx, "Project.Create")
 	v, err := s.base.Create(ctx, key, name, toInnerOptions(opts)...)
 	return projectFromModel(v), convertError(err)
 }
@@ -105,6 +109,7 @@ func (s *ProjectService) Create(ctx context.Context, key, name string, opts ...R
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-project
 func (s *ProjectService) Update(ctx context.Context, projectIDOrKey string, option RequestOption, opts ...RequestOption) (*Project, error) {
+	ctx = client.WithOperation(ctx, "Project.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, toInnerOption(option), toInnerOptions(opts)...)
 	return projectFromModel(v), convertError(err)
 }
@@ -113,6 +118,7 @@ func (s *ProjectService) Update(ctx context.Context, projectIDOrKey string, opti
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project
 func (s *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*Project, error) {
+	ctx = client.WithOperation(ctx, "Project.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey)
 	return projectFromModel(v), convertError(err)
 }
@@ -121,6 +127,7 @@ func (s *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*Pr
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-disk-usage
 func (s *ProjectService) DiskUsage(ctx context.Context, projectIDOrKey string) (*DiskUsageProject, error) {
+	ctx = client.WithOperation(ctx, "Project.DiskUsage")
 	v, err := s.base.DiskUsage(ctx, projectIDOrKey)
 	return diskUsageProjectFromModel(v), convertError(err)
 }
@@ -130,6 +137,7 @@ func (s *ProjectService) DiskUsage(ctx context.Context, projectIDOrKey string) (
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-icon
 func (s *ProjectService) Icon(ctx context.Context, projectIDOrKey string) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Project.Icon")
 	v, err := s.base.Icon(ctx, projectIDOrKey)
 	return fileDataFromModel(v), convertError(err)
 }
@@ -196,6 +204,7 @@ func newProjectService(method *client.Method, option *option.OptionService) *Pro
 		CustomField: newProjectCustomFieldService(method, option),
 		IssueType:   newProjectIssueTypeService(method, option),
 		Status:      newProjectStatusService(method, option),
+		Team:        newProjectTeamService(method),
 		User:        newProjectUserService(method, option),
 		SharedFile:  newProjectSharedFileService(method),
 		Webhook:     newProjectWebhookService(method, option),
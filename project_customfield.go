@@ -47,6 +47,7 @@ type ProjectCustomFieldService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-custom-field-list
 func (s *ProjectCustomFieldService) List(ctx context.Context, projectIDOrKey string) ([]*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return customFieldsFromModel(v), convertError(err)
 }
@@ -74,6 +75,7 @@ func (s *ProjectCustomFieldService) List(ctx context.Context, projectIDOrKey str
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-custom-field
 func (s *ProjectCustomFieldService) Create(ctx context.Context, projectIDOrKey string, fieldType CustomFieldType, name string, opts ...RequestOption) (*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.Create")
 	v, err := s.base.Create(ctx, projectIDOrKey, int(fieldType), name, toInnerOptions(opts)...)
 	return customFieldFromModel(v), convertError(err)
 }
@@ -89,6 +91,7 @@ func (s *ProjectCustomFieldService) Create(ctx context.Context, projectIDOrKey s
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-custom-field
 func (s *ProjectCustomFieldService) Update(ctx context.Context, projectIDOrKey string, customFieldID int, option RequestOption, opts ...RequestOption) (*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, customFieldID, toInnerOption(option), toInnerOptions(opts)...)
 	return customFieldFromModel(v), convertError(err)
 }
@@ -97,6 +100,7 @@ func (s *ProjectCustomFieldService) Update(ctx context.Context, projectIDOrKey s
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-custom-field
 func (s *ProjectCustomFieldService) Delete(ctx context.Context, projectIDOrKey string, customFieldID int) (*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, customFieldID)
 	return customFieldFromModel(v), convertError(err)
 }
@@ -105,6 +109,7 @@ func (s *ProjectCustomFieldService) Delete(ctx context.Context, projectIDOrKey s
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-list-item-for-list-type-custom-field
 func (s *ProjectCustomFieldService) AddListItem(ctx context.Context, projectIDOrKey string, customFieldID int, name string) (*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.AddListItem")
 	v, err := s.base.AddListItem(ctx, projectIDOrKey, customFieldID, name)
 	return customFieldFromModel(v), convertError(err)
 }
@@ -113,6 +118,7 @@ func (s *ProjectCustomFieldService) AddListItem(ctx context.Context, projectIDOr
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-list-item-for-list-type-custom-field
 func (s *ProjectCustomFieldService) UpdateListItem(ctx context.Context, projectIDOrKey string, customFieldID, itemID int, name string) (*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.UpdateListItem")
 	v, err := s.base.UpdateListItem(ctx, projectIDOrKey, customFieldID, itemID, name)
 	return customFieldFromModel(v), convertError(err)
 }
@@ -121,6 +127,7 @@ func (s *ProjectCustomFieldService) UpdateListItem(ctx context.Context, projectI
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-list-item-for-list-type-custom-field
 func (s *ProjectCustomFieldService) DeleteListItem(ctx context.Context, projectIDOrKey string, customFieldID, itemID int) (*CustomField, error) {
+	ctx = client.WithOperation(ctx, "Project.CustomField.DeleteListItem")
 	v, err := s.base.DeleteListItem(ctx, projectIDOrKey, customFieldID, itemID)
 	return customFieldFromModel(v), convertError(err)
 }
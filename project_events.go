@@ -2,6 +2,7 @@ package backlog
 
 import (
 	"context"
+	"iter"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/domain/project"
@@ -46,10 +47,41 @@ type ProjectActivityService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-recent-updates
 func (s *ProjectActivityService) List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*Activity, error) {
+	ctx = client.WithOperation(ctx, "Project.Activity.List")
 	v, err := s.base.List(ctx, projectIDOrKey, toInnerOptions(opts)...)
 	return activitiesFromModel(v), convertError(err)
 }
 
+// All returns an iterator that lazily fetches all activities in the project with
+// automatic cursor pagination, along with any validation error encountered
+// at call time.
+//
+// perPage controls how many activities are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.Project.Activity.Option",
+// such as:
+//   - WithActivityTypeIDs
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-recent-updates
+func (s *ProjectActivityService) All(ctx context.Context, perPage int, projectIDOrKey string, opts ...RequestOption) (iter.Seq2[*Activity, error], error) {
+	ctx = client.WithOperation(ctx, "Project.Activity.All")
+	seq, err := s.base.All(ctx, perPage, projectIDOrKey, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Activity, error) bool) {
+		for v, err := range seq {
+			if !yield(activityFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // ──────────────────────────────────────────────────────────────
 //  ProjectWebhookService
 // ──────────────────────────────────────────────────────────────
@@ -64,6 +96,7 @@ type ProjectWebhookService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-webhooks
 func (s *ProjectWebhookService) List(ctx context.Context, projectIDOrKey string) ([]*Webhook, error) {
+	ctx = client.WithOperation(ctx, "Project.Webhook.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return webhooksFromModel(v), convertError(err)
 }
@@ -78,6 +111,7 @@ func (s *ProjectWebhookService) List(ctx context.Context, projectIDOrKey string)
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-webhook
 func (s *ProjectWebhookService) Create(ctx context.Context, projectIDOrKey, name, hookURL string, opts ...RequestOption) (*Webhook, error) {
+	ctx = client.WithOperation(ctx, "Project.Webhook.Create")
 	v, err := s.base.Add(ctx, projectIDOrKey, name, hookURL, toInnerOptions(opts)...)
 	return webhookFromModel(v), convertError(err)
 }
@@ -86,6 +120,7 @@ func (s *ProjectWebhookService) Create(ctx context.Context, projectIDOrKey, name
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-webhook
 func (s *ProjectWebhookService) One(ctx context.Context, projectIDOrKey string, webhookID int) (*Webhook, error) {
+	ctx = client.WithOperation(ctx, "Project.Webhook.One")
 	v, err := s.base.One(ctx, projectIDOrKey, webhookID)
 	return webhookFromModel(v), convertError(err)
 }
@@ -102,6 +137,7 @@ func (s *ProjectWebhookService) One(ctx context.Context, projectIDOrKey string,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-webhook
 func (s *ProjectWebhookService) Update(ctx context.Context, projectIDOrKey string, webhookID int, option RequestOption, opts ...RequestOption) (*Webhook, error) {
+	ctx = client.WithOperation(ctx, "Project.Webhook.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, webhookID, toInnerOption(option), toInnerOptions(opts)...)
 	return webhookFromModel(v), convertError(err)
 }
@@ -110,6 +146,7 @@ func (s *ProjectWebhookService) Update(ctx context.Context, projectIDOrKey strin
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-webhook
 func (s *ProjectWebhookService) Delete(ctx context.Context, projectIDOrKey string, webhookID int) (*Webhook, error) {
+	ctx = client.WithOperation(ctx, "Project.Webhook.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, webhookID)
 	return webhookFromModel(v), convertError(err)
 }
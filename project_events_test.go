@@ -44,6 +44,38 @@ func TestProjectActivityService(t *testing.T) {
 				assert.True(t, errors.As(err, &target))
 			},
 		},
+		"All": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/projects/TEST/activities", req.URL.Path)
+				assert.Equal(t, "100", req.URL.Query().Get("count"))
+				return mock.NewResponse(fixture.Activity.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Project.Activity.All(ctx, 100, "TEST")
+				require.NoError(t, err)
+				var got []int
+				for v, err := range seq {
+					require.NoError(t, err)
+					got = append(got, v.ID)
+				}
+				assert.Equal(t, []int{3153}, got)
+			},
+		},
+		"All/error-invalid-count": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Project.Activity.All(ctx, 0, "TEST")
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"All/error-cursor-option": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Project.Activity.All(ctx, 100, "TEST", c.Project.Activity.Option.WithMinID(1))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
 	}
 
 	for name, tc := range cases {
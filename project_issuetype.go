@@ -23,6 +23,7 @@ type ProjectIssueTypeService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-type-list
 func (s *ProjectIssueTypeService) List(ctx context.Context, projectIDOrKey string) ([]*IssueType, error) {
+	ctx = client.WithOperation(ctx, "Project.IssueType.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return issueTypesFromModel(v), convertError(err)
 }
@@ -36,6 +37,7 @@ func (s *ProjectIssueTypeService) List(ctx context.Context, projectIDOrKey strin
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-issue-type
 func (s *ProjectIssueTypeService) Create(ctx context.Context, projectIDOrKey, name, color string, opts ...RequestOption) (*IssueType, error) {
+	ctx = client.WithOperation(ctx, "Project.IssueType.Create")
 	v, err := s.base.Create(ctx, projectIDOrKey, name, color, toInnerOptions(opts)...)
 	return issueTypeFromModel(v), convertError(err)
 }
@@ -51,6 +53,7 @@ func (s *ProjectIssueTypeService) Create(ctx context.Context, projectIDOrKey, na
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-issue-type
 func (s *ProjectIssueTypeService) Update(ctx context.Context, projectIDOrKey string, issueTypeID int, option RequestOption, opts ...RequestOption) (*IssueType, error) {
+	ctx = client.WithOperation(ctx, "Project.IssueType.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, issueTypeID, toInnerOption(option), toInnerOptions(opts)...)
 	return issueTypeFromModel(v), convertError(err)
 }
@@ -59,6 +62,7 @@ func (s *ProjectIssueTypeService) Update(ctx context.Context, projectIDOrKey str
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-issue-type
 func (s *ProjectIssueTypeService) Delete(ctx context.Context, projectIDOrKey string, issueTypeID, substituteIssueTypeID int) (*IssueType, error) {
+	ctx = client.WithOperation(ctx, "Project.IssueType.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, issueTypeID, substituteIssueTypeID)
 	return issueTypeFromModel(v), convertError(err)
 }
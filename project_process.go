@@ -36,6 +36,7 @@ type ProjectStatusService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-status-list-of-project
 func (s *ProjectStatusService) List(ctx context.Context, projectIDOrKey string) ([]*Status, error) {
+	ctx = client.WithOperation(ctx, "Project.Status.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return statusesFromModel(v), convertError(err)
 }
@@ -44,6 +45,7 @@ func (s *ProjectStatusService) List(ctx context.Context, projectIDOrKey string)
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-status
 func (s *ProjectStatusService) Create(ctx context.Context, projectIDOrKey, name, color string) (*Status, error) {
+	ctx = client.WithOperation(ctx, "Project.Status.Create")
 	v, err := s.base.Create(ctx, projectIDOrKey, name, color)
 	return statusFromModel(v), convertError(err)
 }
@@ -57,6 +59,7 @@ func (s *ProjectStatusService) Create(ctx context.Context, projectIDOrKey, name,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-status
 func (s *ProjectStatusService) Update(ctx context.Context, projectIDOrKey string, statusID int, option RequestOption, opts ...RequestOption) (*Status, error) {
+	ctx = client.WithOperation(ctx, "Project.Status.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, statusID, toInnerOption(option), toInnerOptions(opts)...)
 	return statusFromModel(v), convertError(err)
 }
@@ -65,6 +68,7 @@ func (s *ProjectStatusService) Update(ctx context.Context, projectIDOrKey string
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-status
 func (s *ProjectStatusService) Delete(ctx context.Context, projectIDOrKey string, statusID, substituteStatusID int) (*Status, error) {
+	ctx = client.WithOperation(ctx, "Project.Status.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, statusID, substituteStatusID)
 	return statusFromModel(v), convertError(err)
 }
@@ -73,6 +77,7 @@ func (s *ProjectStatusService) Delete(ctx context.Context, projectIDOrKey string
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-order-of-status
 func (s *ProjectStatusService) UpdateOrder(ctx context.Context, projectIDOrKey string, statusIDs []int) ([]*Status, error) {
+	ctx = client.WithOperation(ctx, "Project.Status.UpdateOrder")
 	v, err := s.base.UpdateOrder(ctx, projectIDOrKey, statusIDs)
 	return statusesFromModel(v), convertError(err)
 }
@@ -95,6 +100,7 @@ type ProjectVersionService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-version-milestone-list/
 func (s *ProjectVersionService) List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*Version, error) {
+	ctx = client.WithOperation(ctx, "Project.Version.List")
 	v, err := s.base.List(ctx, projectIDOrKey, toInnerOptions(opts)...)
 	return versionsFromModel(v), convertError(err)
 }
@@ -109,6 +115,7 @@ func (s *ProjectVersionService) List(ctx context.Context, projectIDOrKey string,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-version-milestone/
 func (s *ProjectVersionService) Create(ctx context.Context, projectIDOrKey, name string, opts ...RequestOption) (*Version, error) {
+	ctx = client.WithOperation(ctx, "Project.Version.Create")
 	v, err := s.base.Add(ctx, projectIDOrKey, name, toInnerOptions(opts)...)
 	return versionFromModel(v), convertError(err)
 }
@@ -125,6 +132,7 @@ func (s *ProjectVersionService) Create(ctx context.Context, projectIDOrKey, name
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-version-milestone/
 func (s *ProjectVersionService) Update(ctx context.Context, projectIDOrKey string, versionID int, option RequestOption, opts ...RequestOption) (*Version, error) {
+	ctx = client.WithOperation(ctx, "Project.Version.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, versionID, toInnerOption(option), toInnerOptions(opts)...)
 	return versionFromModel(v), convertError(err)
 }
@@ -133,6 +141,7 @@ func (s *ProjectVersionService) Update(ctx context.Context, projectIDOrKey strin
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-version/
 func (s *ProjectVersionService) Delete(ctx context.Context, projectIDOrKey string, versionID int) (*Version, error) {
+	ctx = client.WithOperation(ctx, "Project.Version.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, versionID)
 	return versionFromModel(v), convertError(err)
 }
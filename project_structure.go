@@ -33,6 +33,7 @@ type ProjectCategoryService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-category-list
 func (s *ProjectCategoryService) List(ctx context.Context, projectIDOrKey string) ([]*Category, error) {
+	ctx = client.WithOperation(ctx, "Project.Category.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return categoriesFromModel(v), convertError(err)
 }
@@ -41,6 +42,7 @@ func (s *ProjectCategoryService) List(ctx context.Context, projectIDOrKey string
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-category
 func (s *ProjectCategoryService) Create(ctx context.Context, projectIDOrKey string, name string) (*Category, error) {
+	ctx = client.WithOperation(ctx, "Project.Category.Create")
 	v, err := s.base.Create(ctx, projectIDOrKey, name)
 	return categoryFromModel(v), convertError(err)
 }
@@ -49,6 +51,7 @@ func (s *ProjectCategoryService) Create(ctx context.Context, projectIDOrKey stri
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-category
 func (s *ProjectCategoryService) Update(ctx context.Context, projectIDOrKey string, categoryID int, name string) (*Category, error) {
+	ctx = client.WithOperation(ctx, "Project.Category.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, categoryID, name)
 	return categoryFromModel(v), convertError(err)
 }
@@ -57,6 +60,7 @@ func (s *ProjectCategoryService) Update(ctx context.Context, projectIDOrKey stri
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-category
 func (s *ProjectCategoryService) Delete(ctx context.Context, projectIDOrKey string, categoryID int) (*Category, error) {
+	ctx = client.WithOperation(ctx, "Project.Category.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, categoryID)
 	return categoryFromModel(v), convertError(err)
 }
@@ -74,6 +78,7 @@ type ProjectSharedFileService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-shared-files
 func (s *ProjectSharedFileService) List(ctx context.Context, projectIDOrKey string) ([]*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Project.SharedFile.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return sharedFilesFromModel(v), convertError(err)
 }
@@ -83,10 +88,47 @@ func (s *ProjectSharedFileService) List(ctx context.Context, projectIDOrKey stri
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-file
 func (s *ProjectSharedFileService) Download(ctx context.Context, projectIDOrKey string, sharedFileID int) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Project.SharedFile.Download")
 	v, err := s.base.Download(ctx, projectIDOrKey, sharedFileID)
 	return fileDataFromModel(v), convertError(err)
 }
 
+// ──────────────────────────────────────────────────────────────
+//  ProjectTeamService
+// ──────────────────────────────────────────────────────────────
+
+// ProjectTeamService handles communication with the project team-related methods of the Backlog API.
+type ProjectTeamService struct {
+	base *project.TeamService
+}
+
+// List returns all teams in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-team-list
+func (s *ProjectTeamService) List(ctx context.Context, projectIDOrKey string) ([]*Team, error) {
+	ctx = client.WithOperation(ctx, "Project.Team.List")
+	v, err := s.base.List(ctx, projectIDOrKey)
+	return teamsFromModel(v), convertError(err)
+}
+
+// Add adds a team to the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project-team
+func (s *ProjectTeamService) Add(ctx context.Context, projectIDOrKey string, teamID int) (*Team, error) {
+	ctx = client.WithOperation(ctx, "Project.Team.Add")
+	v, err := s.base.Add(ctx, projectIDOrKey, teamID)
+	return teamFromModel(v), convertError(err)
+}
+
+// Delete removes a team from the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project-team
+func (s *ProjectTeamService) Delete(ctx context.Context, projectIDOrKey string, teamID int) (*Team, error) {
+	ctx = client.WithOperation(ctx, "Project.Team.Delete")
+	v, err := s.base.Delete(ctx, projectIDOrKey, teamID)
+	return teamFromModel(v), convertError(err)
+}
+
 // ──────────────────────────────────────────────────────────────
 //  ProjectUserService
 // ──────────────────────────────────────────────────────────────
@@ -106,6 +148,7 @@ type ProjectUserService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-user-list
 func (s *ProjectUserService) List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*User, error) {
+	ctx = client.WithOperation(ctx, "Project.User.List")
 	v, err := s.base.List(ctx, projectIDOrKey, toInnerOptions(opts)...)
 	return usersFromModel(v), convertError(err)
 }
@@ -114,6 +157,7 @@ func (s *ProjectUserService) List(ctx context.Context, projectIDOrKey string, op
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project-user
 func (s *ProjectUserService) Add(ctx context.Context, projectIDOrKey string, userID int) (*User, error) {
+	ctx = client.WithOperation(ctx, "Project.User.Add")
 	v, err := s.base.Add(ctx, projectIDOrKey, userID)
 	return userFromModel(v), convertError(err)
 }
@@ -122,6 +166,7 @@ func (s *ProjectUserService) Add(ctx context.Context, projectIDOrKey string, use
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project-user
 func (s *ProjectUserService) Delete(ctx context.Context, projectIDOrKey string, userID int) (*User, error) {
+	ctx = client.WithOperation(ctx, "Project.User.Delete")
 	v, err := s.base.Delete(ctx, projectIDOrKey, userID)
 	return userFromModel(v), convertError(err)
 }
@@ -130,6 +175,7 @@ func (s *ProjectUserService) Delete(ctx context.Context, projectIDOrKey string,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project-administrator
 func (s *ProjectUserService) AddAdmin(ctx context.Context, projectIDOrKey string, userID int) (*User, error) {
+	ctx = client.WithOperation(ctx, "Project.User.AddAdmin")
 	v, err := s.base.AddAdmin(ctx, projectIDOrKey, userID)
 	return userFromModel(v), convertError(err)
 }
@@ -138,6 +184,7 @@ func (s *ProjectUserService) AddAdmin(ctx context.Context, projectIDOrKey string
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-project-administrators
 func (s *ProjectUserService) AdminList(ctx context.Context, projectIDOrKey string) ([]*User, error) {
+	ctx = client.WithOperation(ctx, "Project.User.AdminList")
 	v, err := s.base.AdminList(ctx, projectIDOrKey)
 	return usersFromModel(v), convertError(err)
 }
@@ -146,6 +193,7 @@ func (s *ProjectUserService) AdminList(ctx context.Context, projectIDOrKey strin
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project-administrator
 func (s *ProjectUserService) DeleteAdmin(ctx context.Context, projectIDOrKey string, userID int) (*User, error) {
+	ctx = client.WithOperation(ctx, "Project.User.DeleteAdmin")
 	v, err := s.base.DeleteAdmin(ctx, projectIDOrKey, userID)
 	return userFromModel(v), convertError(err)
 }
@@ -181,6 +229,12 @@ func newProjectSharedFileService(method *client.Method) *ProjectSharedFileServic
 	}
 }
 
+func newProjectTeamService(method *client.Method) *ProjectTeamService {
+	return &ProjectTeamService{
+		base: project.NewTeamService(method),
+	}
+}
+
 func newProjectUserService(method *client.Method, option *option.OptionService) *ProjectUserService {
 	return &ProjectUserService{
 		base:   project.NewUserService(method),
@@ -200,6 +200,98 @@ func TestProjectSharedFileService(t *testing.T) {
 	}
 }
 
+func TestProjectTeamService(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"List": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/projects/TEST/teams", req.URL.Path)
+				return mock.NewResponse(fixture.Team.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Project.Team.List(ctx, "TEST")
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				assert.Equal(t, "developers", got[0].Name)
+				require.Len(t, got[0].Members, 1)
+				assert.Equal(t, "admin", got[0].Members[0].UserID)
+			},
+		},
+		"List/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Project.Team.List(ctx, "TEST")
+				require.Error(t, err)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"Add": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/projects/TEST/teams", req.URL.Path)
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "1", req.PostForm.Get("teamId"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Project.Team.Add(ctx, "TEST", 1)
+				require.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+			},
+		},
+		"Add/validation-error": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Project.Team.Add(ctx, "TEST", 0)
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"Delete": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodDelete, req.Method)
+				assert.Equal(t, "/api/v2/projects/TEST/teams", req.URL.Path)
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				form, err := url.ParseQuery(string(body))
+				require.NoError(t, err)
+				assert.Equal(t, "1", form.Get("teamId"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Project.Team.Delete(ctx, "TEST", 1)
+				require.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+			},
+		},
+		"Delete/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Project.Team.Delete(ctx, "TEST", 1)
+				require.Error(t, err)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+			tc.call(t, c)
+		})
+	}
+}
+
 func TestProjectUserService(t *testing.T) {
 	ctx := context.Background()
 
@@ -68,6 +68,7 @@ type PullRequestService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-list
 func (s *PullRequestService) List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...RequestOption) ([]*PullRequest, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.List")
 	v, err := s.base.List(ctx, projectIDOrKey, repositoryIDOrName, toInnerOptions(opts)...)
 	return pullRequestsFromModel(v), convertError(err)
 }
@@ -89,6 +90,7 @@ func (s *PullRequestService) List(ctx context.Context, projectIDOrKey string, re
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-list
 func (s *PullRequestService) All(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, opts ...RequestOption) (iter.Seq2[*PullRequest, error], error) {
+	ctx = client.WithOperation(ctx, "PullRequest.All")
 	seq, err := s.base.All(ctx, perPage, projectIDOrKey, repositoryIDOrName, toInnerOptions(opts)...)
 	if err != nil {
 		return nil, convertError(err)
@@ -113,6 +115,7 @@ func (s *PullRequestService) All(ctx context.Context, perPage int, projectIDOrKe
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-number-of-pull-requests
 func (s *PullRequestService) Count(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, opts ...RequestOption) (int, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Count")
 	count, err := s.base.Count(ctx, projectIDOrKey, repositoryIDOrName, toInnerOptions(opts)...)
 	return count, convertError(err)
 }
@@ -121,6 +124,7 @@ func (s *PullRequestService) Count(ctx context.Context, projectIDOrKey string, r
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request
 func (s *PullRequestService) One(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (*PullRequest, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.One")
 	v, err := s.base.One(ctx, projectIDOrKey, repositoryIDOrName, prNumber)
 	return pullRequestFromModel(v), convertError(err)
 }
@@ -136,6 +140,7 @@ func (s *PullRequestService) One(ctx context.Context, projectIDOrKey string, rep
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-pull-request
 func (s *PullRequestService) Create(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, summary string, description string, base string, branch string, opts ...RequestOption) (*PullRequest, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Create")
 	v, err := s.base.Create(ctx, projectIDOrKey, repositoryIDOrName, summary, description, base, branch, toInnerOptions(opts)...)
 	return pullRequestFromModel(v), convertError(err)
 }
@@ -153,6 +158,7 @@ func (s *PullRequestService) Create(ctx context.Context, projectIDOrKey string,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-pull-request
 func (s *PullRequestService) Update(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, option RequestOption, opts ...RequestOption) (*PullRequest, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, repositoryIDOrName, prNumber, toInnerOption(option), toInnerOptions(opts)...)
 	return pullRequestFromModel(v), convertError(err)
 }
@@ -170,6 +176,7 @@ type PullRequestAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-pull-request-attachment
 func (s *PullRequestAttachmentService) List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) ([]*Attachment, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Attachment.List")
 	v, err := s.base.List(ctx, projectIDOrKey, repositoryIDOrName, prNumber)
 	return attachmentsFromModel(v), convertError(err)
 }
@@ -178,6 +185,7 @@ func (s *PullRequestAttachmentService) List(ctx context.Context, projectIDOrKey
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-pull-request-attachments
 func (s *PullRequestAttachmentService) Remove(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*Attachment, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Attachment.Remove")
 	v, err := s.base.Remove(ctx, projectIDOrKey, repositoryIDOrName, prNumber, attachmentID)
 	return attachmentFromModel(v), convertError(err)
 }
@@ -187,6 +195,7 @@ func (s *PullRequestAttachmentService) Remove(ctx context.Context, projectIDOrKe
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/download-pull-request-attachment
 func (s *PullRequestAttachmentService) Download(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, attachmentID int) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Attachment.Download")
 	v, err := s.base.Download(ctx, projectIDOrKey, repositoryIDOrName, prNumber, attachmentID)
 	return fileDataFromModel(v), convertError(err)
 }
@@ -204,6 +213,7 @@ type PullRequestStarService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-star
 func (s *PullRequestStarService) Add(ctx context.Context, pullRequestID int) error {
+	ctx = client.WithOperation(ctx, "PullRequest.Star.Add")
 	return s.star.Add(ctx, s.star.Option.WithPullRequestID(pullRequestID))
 }
 
@@ -211,6 +221,7 @@ func (s *PullRequestStarService) Add(ctx context.Context, pullRequestID int) err
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-star
 func (s *PullRequestStarService) Remove(ctx context.Context, starID int) error {
+	ctx = client.WithOperation(ctx, "PullRequest.Star.Remove")
 	return s.star.Remove(ctx, starID)
 }
 
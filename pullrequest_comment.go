@@ -2,6 +2,7 @@ package backlog
 
 import (
 	"context"
+	"iter"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/domain/pullrequest"
@@ -25,10 +26,40 @@ type PullRequestCommentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-comment
 func (s *PullRequestCommentService) List(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...RequestOption) ([]*Comment, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Comment.List")
 	v, err := s.base.List(ctx, projectIDOrKey, repositoryIDOrName, prNumber, toInnerOptions(opts)...)
 	return commentsFromModel(v), convertError(err)
 }
 
+// All returns an iterator that lazily fetches all comments on a pull request with
+// automatic cursor pagination, along with any validation error encountered
+// at call time.
+//
+// perPage controls how many comments are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Comment.Option",
+// such as:
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-comment
+func (s *PullRequestCommentService) All(ctx context.Context, perPage int, projectIDOrKey string, repositoryIDOrName string, prNumber int, opts ...RequestOption) (iter.Seq2[*Comment, error], error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Comment.All")
+	seq, err := s.base.All(ctx, perPage, projectIDOrKey, repositoryIDOrName, prNumber, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Comment, error) bool) {
+		for v, err := range seq {
+			if !yield(commentFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // Add adds a comment to a pull request.
 //
 // This method supports options returned by methods in "*Client.PullRequest.Comment.Option",
@@ -38,6 +69,7 @@ func (s *PullRequestCommentService) List(ctx context.Context, projectIDOrKey str
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-pull-request-comment
 func (s *PullRequestCommentService) Add(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, content string, opts ...RequestOption) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Comment.Add")
 	v, err := s.base.Add(ctx, projectIDOrKey, repositoryIDOrName, prNumber, content, toInnerOptions(opts)...)
 	return commentFromModel(v), convertError(err)
 }
@@ -46,6 +78,7 @@ func (s *PullRequestCommentService) Add(ctx context.Context, projectIDOrKey stri
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-number-of-pull-request-comments
 func (s *PullRequestCommentService) Count(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int) (int, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Comment.Count")
 	count, err := s.base.Count(ctx, projectIDOrKey, repositoryIDOrName, prNumber)
 	return count, convertError(err)
 }
@@ -54,6 +87,7 @@ func (s *PullRequestCommentService) Count(ctx context.Context, projectIDOrKey st
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-pull-request-comment-information
 func (s *PullRequestCommentService) Update(ctx context.Context, projectIDOrKey string, repositoryIDOrName string, prNumber int, commentID int, content string) (*Comment, error) {
+	ctx = client.WithOperation(ctx, "PullRequest.Comment.Update")
 	v, err := s.base.Update(ctx, projectIDOrKey, repositoryIDOrName, prNumber, commentID, content)
 	return commentFromModel(v), convertError(err)
 }
@@ -0,0 +1,118 @@
+package backlog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/validate"
+	"github.com/nattokin/go-backlog/internal/validation"
+)
+
+// RawService sends requests to Backlog API endpoints that the library does not
+// wrap yet.
+//
+// Requests go through the same pipeline as those of the typed services:
+// authentication, middleware, rate limiting, retries and logging all apply,
+// and error responses are returned as *[APIResponseError].
+//
+// spath is the endpoint path relative to /api/v2, such as "watchings/1" or
+// "projects/PRJ/teams". Parameters are sent as the query string for GET and
+// as an url-encoded form body otherwise. Repeated parameters such as
+// "projectId[]" are expressed as multiple values of the same key.
+//
+// If v is non-nil, the JSON response body is decoded into it. Responses
+// without content (204 No Content) leave v untouched.
+type RawService struct {
+	method *client.Method
+}
+
+// Get sends a GET request with query and decodes the JSON response into v.
+func (s *RawService) Get(ctx context.Context, spath string, query url.Values, v any) error {
+	ctx = client.WithOperation(ctx, "Raw.Get")
+	return s.do(ctx, s.method.Get, spath, query, v)
+}
+
+// Post sends a POST request with form and decodes the JSON response into v.
+func (s *RawService) Post(ctx context.Context, spath string, form url.Values, v any) error {
+	ctx = client.WithOperation(ctx, "Raw.Post")
+	return s.do(ctx, s.method.Post, spath, form, v)
+}
+
+// Patch sends a PATCH request with form and decodes the JSON response into v.
+func (s *RawService) Patch(ctx context.Context, spath string, form url.Values, v any) error {
+	ctx = client.WithOperation(ctx, "Raw.Patch")
+	return s.do(ctx, s.method.Patch, spath, form, v)
+}
+
+// Put sends a PUT request with form and decodes the JSON response into v.
+func (s *RawService) Put(ctx context.Context, spath string, form url.Values, v any) error {
+	ctx = client.WithOperation(ctx, "Raw.Put")
+	return s.do(ctx, s.method.Put, spath, form, v)
+}
+
+// Delete sends a DELETE request with form and decodes the JSON response into v.
+func (s *RawService) Delete(ctx context.Context, spath string, form url.Values, v any) error {
+	ctx = client.WithOperation(ctx, "Raw.Delete")
+	return s.do(ctx, s.method.Delete, spath, form, v)
+}
+
+// Download sends a GET request with query to an endpoint returning a file.
+// The caller is responsible for closing FileData.Body after use.
+func (s *RawService) Download(ctx context.Context, spath string, query url.Values) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Raw.Download")
+	if err := validateRawPath(spath); err != nil {
+		return nil, convertError(err)
+	}
+
+	resp, err := s.method.Download(ctx, spath, query)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if resp == nil {
+		return nil, &InternalClientError{inner: client.NewInternalClientError("response has no content")}
+	}
+	v, err := client.DownloadResponse(resp)
+	return fileDataFromModel(v), convertError(err)
+}
+
+type rawMethod func(ctx context.Context, spath string, params url.Values) (*http.Response, error)
+
+func (s *RawService) do(ctx context.Context, send rawMethod, spath string, params url.Values, v any) error {
+	if err := validateRawPath(spath); err != nil {
+		return convertError(err)
+	}
+
+	resp, err := send(ctx, spath, params)
+	if err != nil {
+		return convertError(err)
+	}
+	if resp == nil {
+		return nil
+	}
+	if v == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.Body.Close()
+	}
+	return client.DecodeResponse(resp, v)
+}
+
+// validateRawPath rejects paths that are empty or would leave /api/v2.
+func validateRawPath(spath string) error {
+	if err := validate.ValidateNonEmptyString("spath", spath); err != nil {
+		return err
+	}
+	if path.Clean("/"+spath) == "/" || slices.Contains(strings.Split(spath, "/"), "..") {
+		return validation.NewError("spath", "invalid spath: must be a path below /api/v2")
+	}
+	return nil
+}
+
+func newRawService(method *client.Method) *RawService {
+	return &RawService{method: method}
+}
@@ -0,0 +1,193 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestRawService(t *testing.T) {
+	ctx := context.Background()
+
+	type watching struct {
+		ID   int    `json:"id"`
+		Note string `json:"note"`
+	}
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"Get": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/users/1/watchings", req.URL.Path)
+				assert.Equal(t, []string{"1", "2"}, req.URL.Query()["resourceAlreadyRead"])
+				return mock.NewResponse(`[{"id":1,"note":"a"},{"id":2,"note":"b"}]`), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				var got []watching
+				err := c.Raw.Get(ctx, "/users/1/watchings", url.Values{"resourceAlreadyRead": {"1", "2"}}, &got)
+				require.NoError(t, err)
+				assert.Equal(t, []watching{{ID: 1, Note: "a"}, {ID: 2, Note: "b"}}, got)
+			},
+		},
+		"Post": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/watchings", req.URL.Path)
+				assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "TEST-1", req.PostForm.Get("issueIdOrKey"))
+				return mock.NewCreatedResponse(`{"id":3,"note":"c"}`), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				var got watching
+				err := c.Raw.Post(ctx, "watchings", url.Values{"issueIdOrKey": {"TEST-1"}}, &got)
+				require.NoError(t, err)
+				assert.Equal(t, watching{ID: 3, Note: "c"}, got)
+			},
+		},
+		"Patch": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPatch, req.Method)
+				assert.Equal(t, "/api/v2/watchings/3", req.URL.Path)
+				return mock.NewResponse(`{"id":3,"note":"d"}`), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				var got watching
+				err := c.Raw.Patch(ctx, "watchings/3", url.Values{"note": {"d"}}, &got)
+				require.NoError(t, err)
+				assert.Equal(t, "d", got.Note)
+			},
+		},
+		"Put": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPut, req.Method)
+				assert.Equal(t, "/api/v2/projects/PRJ/teams", req.URL.Path)
+				return mock.NewResponse(`{}`), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				err := c.Raw.Put(ctx, "projects/PRJ/teams", nil, nil)
+				require.NoError(t, err)
+			},
+		},
+		"Delete/no-content": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodDelete, req.Method)
+				assert.Equal(t, "/api/v2/watchings/3", req.URL.Path)
+				return mock.NewNoContentResponse(), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got := watching{ID: 99}
+				err := c.Raw.Delete(ctx, "watchings/3", nil, &got)
+				require.NoError(t, err)
+				assert.Equal(t, 99, got.ID)
+			},
+		},
+		"Download": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/documents/1/attachments/2", req.URL.Path)
+				return mock.NewBinaryResponse("doc.pdf", "application/pdf", []byte("PDF")), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Raw.Download(ctx, "documents/1/attachments/2", nil)
+				require.NoError(t, err)
+				defer got.Body.Close()
+				assert.Equal(t, "doc.pdf", got.Filename)
+				data, err := io.ReadAll(got.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "PDF", string(data))
+			},
+		},
+		"Get/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				var got watching
+				err := c.Raw.Get(ctx, "watchings/404", nil, &got)
+				var target *backlog.APIResponseError
+				require.True(t, errors.As(err, &target))
+				assert.Equal(t, http.StatusNotFound, target.StatusCode())
+			},
+		},
+		"Download/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Raw.Download(ctx, "documents/1/attachments/2", nil)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			tc.call(t, c)
+		})
+	}
+}
+
+func TestRawService_operation(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	mw := func(next backlog.Handler) backlog.Handler {
+		return func(op string, req *http.Request) (*http.Response, error) {
+			got = op
+			return next(op, req)
+		}
+	}
+	c, err := backlog.NewClient("https://example.backlog.com", "token",
+		backlog.WithDoer(&mock.Doer{T: t, DoFunc: mock.NewDoFunc(`{}`)}),
+		backlog.WithMiddleware(mw),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Raw.Patch(context.Background(), "watchings/1", nil, nil))
+	assert.Equal(t, "Raw.Patch", got)
+}
+
+func TestRawService_invalidPath(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"empty":          "",
+		"blank":          "  ",
+		"root":           "/",
+		"parent":         "../oauth2/token",
+		"parent-in-path": "projects/../../x",
+	}
+
+	for name, spath := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token",
+				backlog.WithDoer(&mock.Doer{T: t, DoFunc: mock.NewUnexpectedDoFunc(t)}))
+			require.NoError(t, err)
+
+			err = c.Raw.Get(context.Background(), spath, nil, nil)
+			var target *backlog.ValidationError
+			require.True(t, errors.As(err, &target))
+			assert.Equal(t, "spath", target.Target())
+
+			_, err = c.Raw.Download(context.Background(), spath, nil)
+			assert.True(t, errors.As(err, &target))
+		})
+	}
+}
@@ -30,6 +30,7 @@ type RecentlyViewedService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-recently-viewed-issues
 func (s *RecentlyViewedService) ListIssues(ctx context.Context, opts ...RequestOption) ([]*Issue, error) {
+	ctx = client.WithOperation(ctx, "RecentlyViewed.ListIssues")
 	v, err := s.base.ListIssues(ctx, toInnerOptions(opts)...)
 	return issuesFromModel(v), convertError(err)
 }
@@ -38,6 +39,7 @@ func (s *RecentlyViewedService) ListIssues(ctx context.Context, opts ...RequestO
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-recently-viewed-issue
 func (s *RecentlyViewedService) AddIssue(ctx context.Context, issueID int) (*Issue, error) {
+	ctx = client.WithOperation(ctx, "RecentlyViewed.AddIssue")
 	v, err := s.base.AddIssue(ctx, issueID)
 	return issueFromModel(v), convertError(err)
 }
@@ -52,6 +54,7 @@ func (s *RecentlyViewedService) AddIssue(ctx context.Context, issueID int) (*Iss
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-recently-viewed-projects
 func (s *RecentlyViewedService) ListProjects(ctx context.Context, opts ...RequestOption) ([]*Project, error) {
+	ctx = client.WithOperation(ctx, "RecentlyViewed.ListProjects")
 	v, err := s.base.ListProjects(ctx, toInnerOptions(opts)...)
 	return projectsFromModel(v), convertError(err)
 }
@@ -66,6 +69,7 @@ func (s *RecentlyViewedService) ListProjects(ctx context.Context, opts ...Reques
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-recently-viewed-wikis
 func (s *RecentlyViewedService) ListWikis(ctx context.Context, opts ...RequestOption) ([]*Wiki, error) {
+	ctx = client.WithOperation(ctx, "RecentlyViewed.ListWikis")
 	v, err := s.base.ListWikis(ctx, toInnerOptions(opts)...)
 	return wikisFromModel(v), convertError(err)
 }
@@ -74,6 +78,7 @@ func (s *RecentlyViewedService) ListWikis(ctx context.Context, opts ...RequestOp
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-recently-viewed-wiki
 func (s *RecentlyViewedService) AddWiki(ctx context.Context, wikiID int) (*Wiki, error) {
+	ctx = client.WithOperation(ctx, "RecentlyViewed.AddWiki")
 	v, err := s.base.AddWiki(ctx, wikiID)
 	return wikiFromModel(v), convertError(err)
 }
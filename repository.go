@@ -38,6 +38,7 @@ type RepositoryService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-git-repositories
 func (s *RepositoryService) List(ctx context.Context, projectIDOrKey string) ([]*Repository, error) {
+	ctx = client.WithOperation(ctx, "Repository.List")
 	v, err := s.base.List(ctx, projectIDOrKey)
 	return repositoriesFromModel(v), convertError(err)
 }
@@ -46,6 +47,7 @@ func (s *RepositoryService) List(ctx context.Context, projectIDOrKey string) ([]
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-git-repository
 func (s *RepositoryService) One(ctx context.Context, projectIDOrKey string, repoIDOrName string) (*Repository, error) {
+	ctx = client.WithOperation(ctx, "Repository.One")
 	v, err := s.base.One(ctx, projectIDOrKey, repoIDOrName)
 	return repositoryFromModel(v), convertError(err)
 }
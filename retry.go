@@ -0,0 +1,51 @@
+package backlog
+
+import (
+	"time"
+
+	"github.com/nattokin/go-backlog/internal/client"
+)
+
+// RetryPolicy configures automatic retries of transient failures.
+// It is passed to [WithRetry].
+//
+// A request is retried when the HTTP client returns an error, or when the
+// Backlog API responds with 429 Too Many Requests, 502 Bad Gateway,
+// 503 Service Unavailable or 504 Gateway Timeout. Only GET requests are
+// retried unless RetryAllMethods is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// BaseDelay is the backoff delay before the first retry. It doubles after
+	// each attempt and is randomized with full jitter. Zero means 500ms.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the computed backoff delay. Zero means 30s.
+	// It does not limit waits requested by a Retry-After header.
+	MaxDelay time.Duration
+
+	// RetryAllMethods enables retries of POST, PATCH, PUT and DELETE requests.
+	// Enable it only when repeating a write is acceptable for your use case.
+	RetryAllMethods bool
+}
+
+// WithRetry returns a ClientOption that retries transient failures according
+// to policy.
+//
+// The delay before each retry honors the Retry-After response header when
+// present. A 429 response without Retry-After waits until the reset time
+// reported in X-RateLimit-Reset. Otherwise exponential backoff with jitter is
+// used. Waiting is aborted when the request context is done, in which case
+// the context error is returned.
+//
+// When all attempts fail, the error of the last attempt is returned.
+func WithRetry(policy RetryPolicy) *ClientOption {
+	return &ClientOption{inner: client.WithRetry(&client.RetryPolicy{
+		MaxAttempts:     policy.MaxAttempts,
+		BaseDelay:       policy.BaseDelay,
+		MaxDelay:        policy.MaxDelay,
+		RetryAllMethods: policy.RetryAllMethods,
+	})}
+}
@@ -3,6 +3,8 @@ package backlog
 import (
 	"context"
 	"io"
+	"iter"
+	"time"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/domain/space"
@@ -37,6 +39,17 @@ type DiskUsageSpace struct {
 	Details    []*DiskUsageProject
 }
 
+// RateLimitStatus represents the rate limit state of each API category
+// reported by the rate limit endpoint.
+//
+// A category is nil if Backlog did not report it.
+type RateLimitStatus struct {
+	Read   *RateLimit
+	Update *RateLimit
+	Search *RateLimit
+	Icon   *RateLimit
+}
+
 // Space represents space of Backlog.
 type Space struct {
 	SpaceKey           string
@@ -66,12 +79,15 @@ type SpaceService struct {
 
 	Activity   *SpaceActivityService
 	Attachment *SpaceAttachmentService
+	Priority   *SpacePriorityService
+	Resolution *SpaceResolutionService
 }
 
 // Info returns information about your space.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space
 func (s *SpaceService) Info(ctx context.Context) (*Space, error) {
+	ctx = client.WithOperation(ctx, "Space.Info")
 	v, err := s.base.Info(ctx)
 	return spaceFromModel(v), convertError(err)
 }
@@ -80,6 +96,7 @@ func (s *SpaceService) Info(ctx context.Context) (*Space, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-disk-usage
 func (s *SpaceService) DiskUsage(ctx context.Context) (*DiskUsageSpace, error) {
+	ctx = client.WithOperation(ctx, "Space.DiskUsage")
 	v, err := s.base.DiskUsage(ctx)
 	return diskUsageSpaceFromModel(v), convertError(err)
 }
@@ -88,6 +105,7 @@ func (s *SpaceService) DiskUsage(ctx context.Context) (*DiskUsageSpace, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-notification
 func (s *SpaceService) Notification(ctx context.Context) (*SpaceNotification, error) {
+	ctx = client.WithOperation(ctx, "Space.Notification")
 	v, err := s.base.Notification(ctx)
 	return spaceNotificationFromModel(v), convertError(err)
 }
@@ -98,10 +116,43 @@ func (s *SpaceService) Notification(ctx context.Context) (*SpaceNotification, er
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-space-notification
 func (s *SpaceService) UpdateNotification(ctx context.Context, content string) (*SpaceNotification, error) {
+	ctx = client.WithOperation(ctx, "Space.UpdateNotification")
 	v, err := s.base.UpdateNotification(ctx, content)
 	return spaceNotificationFromModel(v), convertError(err)
 }
 
+// Licence returns the licence information of your space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-licence
+func (s *SpaceService) Licence(ctx context.Context) (*Licence, error) {
+	ctx = client.WithOperation(ctx, "Space.Licence")
+	v, err := s.base.Licence(ctx)
+	return licenceFromModel(v), convertError(err)
+}
+
+// Logo returns the logo image of your space.
+// The caller is responsible for closing FileData.Body after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-logo
+func (s *SpaceService) Logo(ctx context.Context) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Space.Logo")
+	v, err := s.base.Logo(ctx)
+	return fileDataFromModel(v), convertError(err)
+}
+
+// RateLimit returns the rate limit status of the authenticated user for each
+// API category.
+//
+// Unlike [Client.RateLimit], which reports the headers of the most recent
+// response, this method queries Backlog for all categories at once.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-rate-limit
+func (s *SpaceService) RateLimit(ctx context.Context) (*RateLimitStatus, error) {
+	ctx = client.WithOperation(ctx, "Space.RateLimit")
+	v, err := s.base.RateLimit(ctx)
+	return rateLimitStatusFromModel(v), convertError(err)
+}
+
 // ──────────────────────────────────────────────────────────────
 //  SpaceActivityService
 // ──────────────────────────────────────────────────────────────
@@ -125,14 +176,46 @@ type SpaceActivityService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates
 func (s *SpaceActivityService) List(ctx context.Context, opts ...RequestOption) ([]*Activity, error) {
+	ctx = client.WithOperation(ctx, "Space.Activity.List")
 	v, err := s.base.List(ctx, toInnerOptions(opts)...)
 	return activitiesFromModel(v), convertError(err)
 }
 
+// All returns an iterator that lazily fetches all activities in your space with
+// automatic cursor pagination, along with any validation error encountered
+// at call time.
+//
+// perPage controls how many activities are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.Space.Activity.Option",
+// such as:
+//   - WithActivityTypeIDs
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates
+func (s *SpaceActivityService) All(ctx context.Context, perPage int, opts ...RequestOption) (iter.Seq2[*Activity, error], error) {
+	ctx = client.WithOperation(ctx, "Space.Activity.All")
+	seq, err := s.base.All(ctx, perPage, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Activity, error) bool) {
+		for v, err := range seq {
+			if !yield(activityFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // One returns a single activity by its ID.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-activity
 func (s *SpaceActivityService) One(ctx context.Context, activityID int) (*Activity, error) {
+	ctx = client.WithOperation(ctx, "Space.Activity.One")
 	v, err := s.base.One(ctx, activityID)
 	return activityFromModel(v), convertError(err)
 }
@@ -146,12 +229,59 @@ type SpaceAttachmentService struct {
 //
 // The file name must not be empty.
 //
+// The file is streamed rather than loaded into memory. If r implements
+// [io.Seeker] (e.g. *os.File), the request is sent with a known Content-Length
+// and can be retried by [WithRetry]; otherwise it cannot be retried.
+// Use the [WithUploadProgress] client option to observe the number of bytes
+// sent.
+//
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/post-attachment-file
 func (s *SpaceAttachmentService) Upload(ctx context.Context, fileName string, r io.Reader) (*Attachment, error) {
+	ctx = client.WithOperation(ctx, "Space.Attachment.Upload")
 	v, err := s.base.Upload(ctx, fileName, r)
 	return attachmentFromModel(v), convertError(err)
 }
 
+// ──────────────────────────────────────────────────────────────
+//  SpacePriorityService
+// ──────────────────────────────────────────────────────────────
+
+// SpacePriorityService handles communication with the priority-related methods of the Backlog API.
+type SpacePriorityService struct {
+	base *space.PriorityService
+}
+
+// List returns the list of issue priorities.
+//
+// The built-in priorities are available as constants such as [PriorityHigh].
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-priority-list
+func (s *SpacePriorityService) List(ctx context.Context) ([]*Priority, error) {
+	ctx = client.WithOperation(ctx, "Space.Priority.List")
+	v, err := s.base.List(ctx)
+	return prioritiesFromModel(v), convertError(err)
+}
+
+// ──────────────────────────────────────────────────────────────
+//  SpaceResolutionService
+// ──────────────────────────────────────────────────────────────
+
+// SpaceResolutionService handles communication with the resolution-related methods of the Backlog API.
+type SpaceResolutionService struct {
+	base *space.ResolutionService
+}
+
+// List returns the list of issue resolutions.
+//
+// The built-in resolutions are available as constants such as [ResolutionFixed].
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-resolution-list
+func (s *SpaceResolutionService) List(ctx context.Context) ([]*Resolution, error) {
+	ctx = client.WithOperation(ctx, "Space.Resolution.List")
+	v, err := s.base.List(ctx)
+	return resolutionsFromModel(v), convertError(err)
+}
+
 // ──────────────────────────────────────────────────────────────
 //  Constructors
 // ──────────────────────────────────────────────────────────────
@@ -161,6 +291,8 @@ func newSpaceService(method *client.Method, option *option.OptionService) *Space
 		base:       space.NewService(method),
 		Activity:   newSpaceActivityService(method, option),
 		Attachment: newSpaceAttachmentService(method),
+		Priority:   newSpacePriorityService(method),
+		Resolution: newSpaceResolutionService(method),
 	}
 }
 
@@ -177,6 +309,18 @@ func newSpaceAttachmentService(method *client.Method) *SpaceAttachmentService {
 	}
 }
 
+func newSpacePriorityService(method *client.Method) *SpacePriorityService {
+	return &SpacePriorityService{
+		base: space.NewPriorityService(method),
+	}
+}
+
+func newSpaceResolutionService(method *client.Method) *SpaceResolutionService {
+	return &SpaceResolutionService{
+		base: space.NewResolutionService(method),
+	}
+}
+
 // ──────────────────────────────────────────────────────────────
 //  Model converters
 // ──────────────────────────────────────────────────────────────
@@ -242,3 +386,67 @@ func spaceNotificationFromModel(m *model.SpaceNotification) *SpaceNotification {
 		Updated: Timestamp{m.Updated},
 	}
 }
+
+func licenceFromModel(m *model.Licence) *Licence {
+	if m == nil {
+		return nil
+	}
+	return &Licence{
+		Active:                            m.Active,
+		AttachmentLimit:                   m.AttachmentLimit,
+		AttachmentLimitPerFile:            m.AttachmentLimitPerFile,
+		AttachmentNumLimit:                m.AttachmentNumLimit,
+		Attribute:                         m.Attribute,
+		AttributeLimit:                    m.AttributeLimit,
+		Burndown:                          m.Burndown,
+		CommentLimit:                      m.CommentLimit,
+		ComponentLimit:                    m.ComponentLimit,
+		FileSharing:                       m.FileSharing,
+		Gantt:                             m.Gantt,
+		Git:                               m.Git,
+		IssueLimit:                        m.IssueLimit,
+		LicenceTypeID:                     m.LicenceTypeID,
+		LimitDate:                         Timestamp{m.LimitDate},
+		NulabAccount:                      m.NulabAccount,
+		ParentChildIssue:                  m.ParentChildIssue,
+		PostIssueByMail:                   m.PostIssueByMail,
+		ProjectGroup:                      m.ProjectGroup,
+		ProjectLimit:                      m.ProjectLimit,
+		PullRequestAttachmentLimitPerFile: m.PullRequestAttachmentLimitPerFile,
+		PullRequestAttachmentNumLimit:     m.PullRequestAttachmentNumLimit,
+		RemoteAddress:                     m.RemoteAddress,
+		RemoteAddressLimit:                m.RemoteAddressLimit,
+		StartedOn:                         Timestamp{m.StartedOn},
+		StorageLimit:                      m.StorageLimit,
+		Subversion:                        m.Subversion,
+		SubversionExternal:                m.SubversionExternal,
+		UserLimit:                         m.UserLimit,
+		VersionLimit:                      m.VersionLimit,
+		WikiAttachment:                    m.WikiAttachment,
+		WikiAttachmentLimitPerFile:        m.WikiAttachmentLimitPerFile,
+		WikiAttachmentNumLimit:            m.WikiAttachmentNumLimit,
+	}
+}
+
+func rateLimitFromModel(m *model.RateLimitCategory) *RateLimit {
+	if m == nil {
+		return nil
+	}
+	return &RateLimit{
+		Limit:     m.Limit,
+		Remaining: m.Remaining,
+		Reset:     Timestamp{time.Unix(m.Reset, 0)},
+	}
+}
+
+func rateLimitStatusFromModel(m *model.RateLimitStatus) *RateLimitStatus {
+	if m == nil {
+		return nil
+	}
+	return &RateLimitStatus{
+		Read:   rateLimitFromModel(m.RateLimit.Read),
+		Update: rateLimitFromModel(m.RateLimit.Update),
+		Search: rateLimitFromModel(m.RateLimit.Search),
+		Icon:   rateLimitFromModel(m.RateLimit.Icon),
+	}
+}
@@ -3,6 +3,7 @@ package backlog_test
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -205,6 +206,152 @@ func TestSpaceService_UpdateNotification(t *testing.T) {
 	}
 }
 
+func TestSpaceService_Licence(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc  func(req *http.Request) (*http.Response, error)
+		wantErr bool
+	}{
+		"success": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/space/licence", req.URL.Path)
+				return mock.NewResponse(fixture.Space.LicenceJSON), nil
+			},
+		},
+		"error": {
+			doFunc:  mock.NewUnauthorizedDoFunc(),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			got, err := c.Space.Licence(ctx)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.True(t, got.Active)
+			assert.Equal(t, 46, got.LicenceTypeID)
+			assert.Equal(t, int64(1073741824), got.StorageLimit)
+			assert.Equal(t, 2020, got.StartedOn.Year())
+		})
+	}
+}
+
+func TestSpaceService_Logo(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc  func(req *http.Request) (*http.Response, error)
+		wantErr bool
+	}{
+		"success": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/space/image", req.URL.Path)
+				return mock.NewBinaryResponse("logo.png", "image/png", []byte("PNG")), nil
+			},
+		},
+		"error": {
+			doFunc:  mock.NewNotFoundDoFunc(),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			got, err := c.Space.Logo(ctx)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			defer got.Body.Close()
+			assert.Equal(t, "logo.png", got.Filename)
+			assert.Equal(t, "image/png", got.ContentType)
+			body, err := io.ReadAll(got.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "PNG", string(body))
+		})
+	}
+}
+
+func TestSpaceService_RateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc  func(req *http.Request) (*http.Response, error)
+		wantErr bool
+	}{
+		"success": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/rateLimit", req.URL.Path)
+				return mock.NewResponse(fixture.Space.RateLimitJSON), nil
+			},
+		},
+		"error": {
+			doFunc:  mock.NewUnauthorizedDoFunc(),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			got, err := c.Space.RateLimit(ctx)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.NotNil(t, got.Read)
+			assert.Equal(t, 600, got.Read.Limit)
+			assert.Equal(t, 598, got.Read.Remaining)
+			assert.Equal(t, int64(1700000060), got.Read.Reset.Unix())
+			require.NotNil(t, got.Update)
+			assert.Equal(t, 150, got.Update.Remaining)
+			require.NotNil(t, got.Search)
+			assert.Equal(t, 149, got.Search.Remaining)
+			require.NotNil(t, got.Icon)
+			assert.Equal(t, 60, got.Icon.Limit)
+		})
+	}
+}
+
 func TestSpaceActivityService(t *testing.T) {
 	ctx := context.Background()
 
@@ -263,6 +410,38 @@ func TestSpaceActivityService(t *testing.T) {
 				assert.True(t, errors.As(err, &target))
 			},
 		},
+		"All": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/space/activities", req.URL.Path)
+				assert.Equal(t, "100", req.URL.Query().Get("count"))
+				return mock.NewResponse(fixture.Activity.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Space.Activity.All(ctx, 100)
+				require.NoError(t, err)
+				var got []int
+				for v, err := range seq {
+					require.NoError(t, err)
+					got = append(got, v.ID)
+				}
+				assert.Equal(t, []int{3153}, got)
+			},
+		},
+		"All/error-invalid-count": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Space.Activity.All(ctx, 0)
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"All/error-cursor-option": {
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Space.Activity.All(ctx, 100, c.Space.Activity.Option.WithMinID(1))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -306,6 +485,36 @@ func TestSpaceAttachmentService(t *testing.T) {
 		assert.Equal(t, 8857, got.Size)
 	})
 
+	t.Run("Upload/progress", func(t *testing.T) {
+		doFunc := func(req *http.Request) (*http.Response, error) {
+			_, err := io.Copy(io.Discard, req.Body)
+			require.NoError(t, err)
+			assert.Positive(t, req.ContentLength)
+			return mock.NewResponse(fixture.Attachment.UploadJSON), nil
+		}
+
+		var name string
+		var sent, total int64
+		progress := backlog.WithUploadProgress(func(fileName string, s, t int64) {
+			name, sent, total = fileName, s, t
+		})
+
+		c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{DoFunc: doFunc}), progress)
+		require.NoError(t, err)
+
+		f, err := os.Open("testdata/testfile")
+		require.NoError(t, err)
+		defer f.Close()
+		info, err := f.Stat()
+		require.NoError(t, err)
+
+		_, err = c.Space.Attachment.Upload(ctx, "testfile", f)
+		require.NoError(t, err)
+		assert.Equal(t, "testfile", name)
+		assert.Equal(t, info.Size(), sent)
+		assert.Equal(t, info.Size(), total)
+	})
+
 	t.Run("Upload/error", func(t *testing.T) {
 		doFunc := mock.NewUnauthorizedDoFunc()
 
@@ -318,3 +527,89 @@ func TestSpaceAttachmentService(t *testing.T) {
 		assert.True(t, errors.As(err, &target))
 	})
 }
+
+func TestSpacePriorityService(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"List": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/priorities", req.URL.Path)
+				return mock.NewResponse(fixture.Priority.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Space.Priority.List(ctx)
+				require.NoError(t, err)
+				require.Len(t, got, 3)
+				assert.Equal(t, backlog.PriorityHigh, got[0].ID)
+				assert.Equal(t, backlog.PriorityNormal, got[1].ID)
+				assert.Equal(t, backlog.PriorityLow, got[2].ID)
+			},
+		},
+		"List/error": {
+			doFunc: mock.NewUnauthorizedDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Space.Priority.List(ctx)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+			tc.call(t, c)
+		})
+	}
+}
+
+func TestSpaceResolutionService(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"List": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/resolutions", req.URL.Path)
+				return mock.NewResponse(fixture.Resolution.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Space.Resolution.List(ctx)
+				require.NoError(t, err)
+				require.Len(t, got, 5)
+				assert.Equal(t, backlog.ResolutionFixed, got[0].ID)
+				assert.Equal(t, "Fixed", got[0].Name)
+				assert.Equal(t, backlog.ResolutionCannotReproduce, got[4].ID)
+			},
+		},
+		"List/error": {
+			doFunc: mock.NewUnauthorizedDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Space.Resolution.List(ctx)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+			tc.call(t, c)
+		})
+	}
+}
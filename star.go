@@ -46,6 +46,7 @@ type StarService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-star
 func (s *StarService) Add(ctx context.Context, option RequestOption) error {
+	ctx = client.WithOperation(ctx, "Star.Add")
 	return convertError(s.base.Add(ctx, toInnerOption(option)))
 }
 
@@ -53,6 +54,7 @@ func (s *StarService) Add(ctx context.Context, option RequestOption) error {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-star
 func (s *StarService) Remove(ctx context.Context, id int) error {
+	ctx = client.WithOperation(ctx, "Star.Remove")
 	return convertError(s.base.Remove(ctx, id))
 }
 
@@ -0,0 +1,170 @@
+package backlog
+
+import (
+	"context"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/domain/team"
+	"github.com/nattokin/go-backlog/internal/model"
+	"github.com/nattokin/go-backlog/internal/option"
+)
+
+// ──────────────────────────────────────────────────────────────
+//  TeamService
+// ──────────────────────────────────────────────────────────────
+
+// TeamService handles communication with the team-related methods of the Backlog API.
+type TeamService struct {
+	base *team.Service
+
+	Option *TeamOptionService
+}
+
+// List returns a list of teams in the space.
+//
+// This method supports options returned by methods in "*Client.Team.Option",
+// such as:
+//   - WithCount
+//   - WithOffset
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-teams
+func (s *TeamService) List(ctx context.Context, opts ...RequestOption) ([]*Team, error) {
+	ctx = client.WithOperation(ctx, "Team.List")
+	v, err := s.base.List(ctx, toInnerOptions(opts)...)
+	return teamsFromModel(v), convertError(err)
+}
+
+// One returns a single team by its ID.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-team
+func (s *TeamService) One(ctx context.Context, teamID int) (*Team, error) {
+	ctx = client.WithOperation(ctx, "Team.One")
+	v, err := s.base.One(ctx, teamID)
+	return teamFromModel(v), convertError(err)
+}
+
+// Create creates a new team.
+//
+// This method supports options returned by methods in "*Client.Team.Option",
+// such as:
+//   - WithMembers
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-team
+func (s *TeamService) Create(ctx context.Context, name string, opts ...RequestOption) (*Team, error) {
+	ctx = client.WithOperation(ctx, "Team.Create")
+	v, err := s.base.Create(ctx, name, toInnerOptions(opts)...)
+	return teamFromModel(v), convertError(err)
+}
+
+// Update updates a team.
+//
+// This method requires at least one option returned by methods in
+// "*Client.Team.Option", such as:
+//   - WithMembers
+//   - WithName
+//
+// WithMembers replaces the whole member list of the team.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-team
+func (s *TeamService) Update(ctx context.Context, teamID int, option RequestOption, opts ...RequestOption) (*Team, error) {
+	ctx = client.WithOperation(ctx, "Team.Update")
+	v, err := s.base.Update(ctx, teamID, toInnerOption(option), toInnerOptions(opts)...)
+	return teamFromModel(v), convertError(err)
+}
+
+// Delete deletes a team and returns the deleted team.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-team
+func (s *TeamService) Delete(ctx context.Context, teamID int) (*Team, error) {
+	ctx = client.WithOperation(ctx, "Team.Delete")
+	v, err := s.base.Delete(ctx, teamID)
+	return teamFromModel(v), convertError(err)
+}
+
+// Icon returns the icon image of a team.
+// The caller is responsible for closing FileData.Body after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-team-icon
+func (s *TeamService) Icon(ctx context.Context, teamID int) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Team.Icon")
+	v, err := s.base.Icon(ctx, teamID)
+	return fileDataFromModel(v), convertError(err)
+}
+
+// ──────────────────────────────────────────────────────────────
+//  TeamOptionService
+// ──────────────────────────────────────────────────────────────
+
+// TeamOptionService provides a domain-specific set of option builders
+// for operations within the TeamService.
+type TeamOptionService struct {
+	base *option.OptionService
+}
+
+// WithCount sets the number of results to return (1-100).
+func (s *TeamOptionService) WithCount(count int) RequestOption {
+	return &requestOption{opt: s.base.WithCount(count)}
+}
+
+// WithMembers sets the user IDs of the team members.
+func (s *TeamOptionService) WithMembers(userIDs []int) RequestOption {
+	return &requestOption{opt: s.base.WithMembers(userIDs)}
+}
+
+// WithName sets the team name.
+func (s *TeamOptionService) WithName(name string) RequestOption {
+	return &requestOption{opt: s.base.WithName(name)}
+}
+
+// WithOffset sets the number of teams to skip.
+func (s *TeamOptionService) WithOffset(offset int) RequestOption {
+	return &requestOption{opt: s.base.WithOffset(offset)}
+}
+
+// WithOrder sets the sort order of results.
+func (s *TeamOptionService) WithOrder(order Order) RequestOption {
+	return &requestOption{opt: s.base.WithOrder(string(order))}
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Constructor
+// ──────────────────────────────────────────────────────────────
+
+func newTeamService(method *client.Method, option *option.OptionService) *TeamService {
+	return &TeamService{
+		base:   team.NewService(method),
+		Option: &TeamOptionService{base: option},
+	}
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Helpers
+// ──────────────────────────────────────────────────────────────
+
+func teamFromModel(m *model.Team) *Team {
+	if m == nil {
+		return nil
+	}
+	return &Team{
+		ID:           m.ID,
+		Name:         m.Name,
+		Members:      usersFromModel(m.Members),
+		DisplayOrder: m.DisplayOrder,
+		CreatedUser:  userFromModel(m.CreatedUser),
+		Created:      Timestamp{m.Created},
+		UpdatedUser:  userFromModel(m.UpdatedUser),
+		Updated:      Timestamp{m.Updated},
+	}
+}
+
+func teamsFromModel(ms []*model.Team) []*Team {
+	if ms == nil {
+		return nil
+	}
+	result := make([]*Team, len(ms))
+	for i, v := range ms {
+		result[i] = teamFromModel(v)
+	}
+	return result
+}
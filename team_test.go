@@ -0,0 +1,170 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestTeamService(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"List": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/teams", req.URL.Path)
+				assert.Equal(t, "asc", req.URL.Query().Get("order"))
+				assert.Equal(t, "10", req.URL.Query().Get("count"))
+				return mock.NewResponse(fixture.Team.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Team.List(ctx,
+					c.Team.Option.WithOrder(backlog.OrderAsc),
+					c.Team.Option.WithCount(10),
+				)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				assert.Equal(t, "developers", got[0].Name)
+				assert.Equal(t, "admin", got[0].CreatedUser.UserID)
+				assert.Empty(t, got[1].Members)
+				assert.Equal(t, 1, got[1].DisplayOrder)
+			},
+		},
+		"List/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Team.List(ctx)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"List/invalid-option": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Team.List(ctx, c.Team.Option.WithName("developers"))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"One": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/teams/1", req.URL.Path)
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Team.One(ctx, 1)
+				require.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+				require.Len(t, got.Members, 2)
+				assert.Equal(t, "dev", got.Members[1].UserID)
+			},
+		},
+		"One/validation-error": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Team.One(ctx, 0)
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"Create": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/teams", req.URL.Path)
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "developers", req.PostForm.Get("name"))
+				assert.Equal(t, []string{"1", "2"}, req.PostForm["members[]"])
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Team.Create(ctx, "developers", c.Team.Option.WithMembers([]int{1, 2}))
+				require.NoError(t, err)
+				assert.Equal(t, "developers", got.Name)
+			},
+		},
+		"Update": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPatch, req.Method)
+				assert.Equal(t, "/api/v2/teams/1", req.URL.Path)
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "developers", req.PostForm.Get("name"))
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Team.Update(ctx, 1, c.Team.Option.WithName("developers"))
+				require.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+			},
+		},
+		"Update/validation-error": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Team.Update(ctx, 1, c.Team.Option.WithMembers([]int{0}))
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"Delete": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodDelete, req.Method)
+				assert.Equal(t, "/api/v2/teams/1", req.URL.Path)
+				return mock.NewResponse(fixture.Team.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Team.Delete(ctx, 1)
+				require.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+			},
+		},
+		"Icon": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/teams/1/icon", req.URL.Path)
+				return mock.NewBinaryResponse("team.png", "image/png", []byte("PNG")), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Team.Icon(ctx, 1)
+				require.NoError(t, err)
+				defer got.Body.Close()
+				assert.Equal(t, "team.png", got.Filename)
+				assert.Equal(t, "image/png", got.ContentType)
+				body, err := io.ReadAll(got.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "PNG", string(body))
+			},
+		},
+		"Icon/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Team.Icon(ctx, 1)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			tc.call(t, c)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package backlog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Attribute keys set on spans started by the Client.
+const (
+	// AttributeOperation is the service operation, such as "Issue.List" (string).
+	AttributeOperation = "backlog.operation"
+	// AttributeProjectKey is the project ID or key targeted by the request,
+	// when it can be determined from the request (string).
+	AttributeProjectKey = "backlog.project.key"
+	// AttributeHTTPMethod is the HTTP request method (string).
+	AttributeHTTPMethod = "http.request.method"
+	// AttributeURLPath is the path of the request URL (string).
+	AttributeURLPath = "url.path"
+	// AttributeHTTPStatus is the HTTP response status code (int).
+	AttributeHTTPStatus = "http.response.status_code"
+	// AttributeErrorCodes are the Backlog error codes of an error response ([]int).
+	AttributeErrorCodes = "backlog.error.codes"
+)
+
+// Attribute is a key-value pair describing a span.
+// Value is a string, an int or a []int.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer starts spans for API operations performed by the Client.
+//
+// It is a minimal abstraction over tracing libraries. The otelbacklog module
+// provides an implementation backed by OpenTelemetry.
+type Tracer interface {
+	// Start starts a span named name as a child of any span in ctx, and
+	// returns a context carrying the new span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced API operation started by a [Tracer].
+type Span interface {
+	// SetAttributes sets attributes on the span.
+	SetAttributes(attrs ...Attribute)
+	// RecordError records err on the span and marks the span as failed.
+	RecordError(err error)
+	// End completes the span.
+	End()
+}
+
+// WithTracer returns a ClientOption that traces every API request made by the
+// Client with tracer.
+//
+// A span named after the service operation (e.g. "Issue.List") is started for
+// each request and carries the Attribute* attributes defined in this package. The span covers rate
+// limit waits, token refreshes and retries, and its context is propagated to
+// the request, so spans started by the Doer become its children.
+//
+// The tracer is applied as a [Middleware] at the position of this option;
+// pass it before [WithMiddleware] for its spans to cover other middleware.
+func WithTracer(tracer Tracer) *ClientOption {
+	return WithMiddleware(tracingMiddleware(tracer))
+}
+
+func tracingMiddleware(tracer Tracer) Middleware {
+	return func(next Handler) Handler {
+		return func(op string, req *http.Request) (*http.Response, error) {
+			name := op
+			if name == "" {
+				name = "Backlog " + req.Method
+			}
+			ctx, span := tracer.Start(req.Context(), name)
+			defer span.End()
+
+			attrs := []Attribute{
+				{Key: AttributeHTTPMethod, Value: req.Method},
+				{Key: AttributeURLPath, Value: req.URL.Path},
+			}
+			if op != "" {
+				attrs = append(attrs, Attribute{Key: AttributeOperation, Value: op})
+			}
+			if key := projectKeyOf(req); key != "" {
+				attrs = append(attrs, Attribute{Key: AttributeProjectKey, Value: key})
+			}
+			span.SetAttributes(attrs...)
+
+			resp, err := next(op, req.WithContext(ctx))
+
+			switch {
+			case err != nil:
+				var apiErr *APIResponseError
+				if errors.As(err, &apiErr) {
+					span.SetAttributes(
+						Attribute{Key: AttributeHTTPStatus, Value: apiErr.StatusCode()},
+						Attribute{Key: AttributeErrorCodes, Value: errorCodes(apiErr)},
+					)
+				}
+				span.RecordError(err)
+			case resp != nil:
+				span.SetAttributes(Attribute{Key: AttributeHTTPStatus, Value: resp.StatusCode})
+			default:
+				// Responses without content are returned as (nil, nil).
+				span.SetAttributes(Attribute{Key: AttributeHTTPStatus, Value: http.StatusNoContent})
+			}
+
+			return resp, err
+		}
+	}
+}
+
+func errorCodes(e *APIResponseError) []int {
+	codes := make([]int, 0, len(e.inner.Errors))
+	for _, ce := range e.inner.Errors {
+		codes = append(codes, ce.Code)
+	}
+	return codes
+}
+
+// issueKeyPattern matches issue keys such as "PRJ-123".
+var issueKeyPattern = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)-[0-9]+$`)
+
+// projectKeyOf returns the project ID or key targeted by req, taken from the
+// /projects/:projectIdOrKey path, an issue key in the /issues/:issueIdOrKey
+// path, or the projectIdOrKey query parameter. It returns "" if none is found.
+func projectKeyOf(req *http.Request) string {
+	prefix := "/api/v2/"
+	if i := strings.Index(req.URL.Path, prefix); i >= 0 {
+		segs := strings.Split(req.URL.Path[i+len(prefix):], "/")
+		if len(segs) > 1 && segs[1] != "" {
+			switch segs[0] {
+			case "projects":
+				return segs[1]
+			case "issues":
+				if m := issueKeyPattern.FindStringSubmatch(segs[1]); m != nil {
+					return m[1]
+				}
+			}
+		}
+	}
+	return req.URL.Query().Get("projectIdOrKey")
+}
@@ -0,0 +1,162 @@
+package backlog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]any
+	err   error
+	ended bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+func (s *recordedSpan) RecordError(err error) { s.err = err }
+func (s *recordedSpan) End()                  { s.ended = true }
+
+type spanKey struct{}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &recordedSpan{name: name, attrs: map[string]any{}}
+	t.spans = append(t.spans, s)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func TestWithTracer(t *testing.T) {
+	cases := map[string]struct {
+		call      func(c *Client) error
+		response  *http.Response
+		wantName  string
+		wantAttrs map[string]any
+		wantErr   bool
+	}{
+		"Project.Status.List": {
+			call: func(c *Client) error {
+				_, err := c.Project.Status.List(context.Background(), "PRJ")
+				return err
+			},
+			response: mock.NewResponse(`[]`),
+			wantName: "Project.Status.List",
+			wantAttrs: map[string]any{
+				AttributeOperation:  "Project.Status.List",
+				AttributeProjectKey: "PRJ",
+				AttributeHTTPMethod: http.MethodGet,
+				AttributeURLPath:    "/api/v2/projects/PRJ/statuses",
+				AttributeHTTPStatus: http.StatusOK,
+			},
+		},
+		"Issue.One-issue-key": {
+			call: func(c *Client) error {
+				_, err := c.Issue.One(context.Background(), "PRJ-12")
+				return err
+			},
+			response: mock.NewResponse(`{}`),
+			wantName: "Issue.One",
+			wantAttrs: map[string]any{
+				AttributeOperation:  "Issue.One",
+				AttributeProjectKey: "PRJ",
+				AttributeHTTPMethod: http.MethodGet,
+				AttributeURLPath:    "/api/v2/issues/PRJ-12",
+				AttributeHTTPStatus: http.StatusOK,
+			},
+		},
+		"Wiki.List-query": {
+			call: func(c *Client) error {
+				_, err := c.Wiki.List(context.Background(), "PRJ")
+				return err
+			},
+			response: mock.NewResponse(`[]`),
+			wantName: "Wiki.List",
+			wantAttrs: map[string]any{
+				AttributeOperation:  "Wiki.List",
+				AttributeProjectKey: "PRJ",
+				AttributeHTTPMethod: http.MethodGet,
+				AttributeURLPath:    "/api/v2/wikis",
+				AttributeHTTPStatus: http.StatusOK,
+			},
+		},
+		"error-Space.Info": {
+			call: func(c *Client) error {
+				_, err := c.Space.Info(context.Background())
+				return err
+			},
+			response: mock.NewNotFoundResponse(),
+			wantName: "Space.Info",
+			wantAttrs: map[string]any{
+				AttributeOperation:  "Space.Info",
+				AttributeHTTPMethod: http.MethodGet,
+				AttributeURLPath:    "/api/v2/space",
+				AttributeHTTPStatus: http.StatusNotFound,
+				AttributeErrorCodes: []int{6},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer := &recordingTracer{}
+			var gotSpan any
+			c, err := NewClient("https://example.com", "token",
+				WithDoer(&mock.Doer{T: t, DoFunc: func(req *http.Request) (*http.Response, error) {
+					gotSpan = req.Context().Value(spanKey{})
+					return tc.response, nil
+				}}),
+				WithTracer(tracer),
+			)
+			require.NoError(t, err)
+
+			err = tc.call(c)
+
+			require.Len(t, tracer.spans, 1)
+			span := tracer.spans[0]
+			assert.Equal(t, tc.wantName, span.name)
+			assert.Equal(t, tc.wantAttrs, span.attrs)
+			assert.True(t, span.ended)
+			// The span context is propagated to the request.
+			assert.Same(t, span, gotSpan)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.IsType(t, &APIResponseError{}, span.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, span.err)
+		})
+	}
+}
+
+func TestWithTracer_noContent(t *testing.T) {
+	tracer := &recordingTracer{}
+	c, err := NewClient("https://example.com", "token",
+		WithDoer(&mock.Doer{T: t, DoFunc: func(_ *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+		}}),
+		WithTracer(tracer),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Star.Remove(context.Background(), 1))
+
+	require.Len(t, tracer.spans, 1)
+	assert.Equal(t, http.StatusNoContent, tracer.spans[0].attrs[AttributeHTTPStatus])
+}
@@ -2,6 +2,7 @@ package backlog
 
 import (
 	"context"
+	"iter"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/domain/user"
@@ -37,6 +38,7 @@ type UserService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-list
 func (s *UserService) List(ctx context.Context) ([]*User, error) {
+	ctx = client.WithOperation(ctx, "User.List")
 	v, err := s.base.List(ctx)
 	return usersFromModel(v), convertError(err)
 }
@@ -45,6 +47,7 @@ func (s *UserService) List(ctx context.Context) ([]*User, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user
 func (s *UserService) One(ctx context.Context, id int) (*User, error) {
+	ctx = client.WithOperation(ctx, "User.One")
 	v, err := s.base.One(ctx, id)
 	return userFromModel(v), convertError(err)
 }
@@ -53,6 +56,7 @@ func (s *UserService) One(ctx context.Context, id int) (*User, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-own-user
 func (s *UserService) Me(ctx context.Context) (*User, error) {
+	ctx = client.WithOperation(ctx, "User.Me")
 	v, err := s.base.Me(ctx)
 	return userFromModel(v), convertError(err)
 }
@@ -61,6 +65,7 @@ func (s *UserService) Me(ctx context.Context) (*User, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-user
 func (s *UserService) Add(ctx context.Context, userID, password, name, mailAddress string, roleType Role) (*User, error) {
+	ctx = client.WithOperation(ctx, "User.Add")
 	v, err := s.base.Add(ctx, userID, password, name, mailAddress, int(roleType))
 	return userFromModel(v), convertError(err)
 }
@@ -76,6 +81,7 @@ func (s *UserService) Add(ctx context.Context, userID, password, name, mailAddre
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-user
 func (s *UserService) Update(ctx context.Context, id int, option RequestOption, opts ...RequestOption) (*User, error) {
+	ctx = client.WithOperation(ctx, "User.Update")
 	v, err := s.base.Update(ctx, id, toInnerOption(option), toInnerOptions(opts)...)
 	return userFromModel(v), convertError(err)
 }
@@ -84,6 +90,7 @@ func (s *UserService) Update(ctx context.Context, id int, option RequestOption,
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-user
 func (s *UserService) Delete(ctx context.Context, id int) (*User, error) {
+	ctx = client.WithOperation(ctx, "User.Delete")
 	v, err := s.base.Delete(ctx, id)
 	return userFromModel(v), convertError(err)
 }
@@ -93,6 +100,7 @@ func (s *UserService) Delete(ctx context.Context, id int) (*User, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-icon
 func (s *UserService) Icon(ctx context.Context, id int) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "User.Icon")
 	v, err := s.base.Icon(ctx, id)
 	return fileDataFromModel(v), convertError(err)
 }
@@ -120,10 +128,41 @@ type UserActivityService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-recent-updates
 func (s *UserActivityService) List(ctx context.Context, userID int, opts ...RequestOption) ([]*Activity, error) {
+	ctx = client.WithOperation(ctx, "User.Activity.List")
 	v, err := s.base.List(ctx, userID, toInnerOptions(opts)...)
 	return activitiesFromModel(v), convertError(err)
 }
 
+// All returns an iterator that lazily fetches all activities of the user with
+// automatic cursor pagination, along with any validation error encountered
+// at call time.
+//
+// perPage controls how many activities are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.User.Activity.Option",
+// such as:
+//   - WithActivityTypeIDs
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-recent-updates
+func (s *UserActivityService) All(ctx context.Context, perPage int, userID int, opts ...RequestOption) (iter.Seq2[*Activity, error], error) {
+	ctx = client.WithOperation(ctx, "User.Activity.All")
+	seq, err := s.base.All(ctx, perPage, userID, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Activity, error) bool) {
+		for v, err := range seq {
+			if !yield(activityFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // ──────────────────────────────────────────────────────────────
 //  UserOptionService
 // ──────────────────────────────────────────────────────────────
@@ -2,6 +2,7 @@ package backlog
 
 import (
 	"context"
+	"iter"
 
 	"github.com/nattokin/go-backlog/internal/client"
 	"github.com/nattokin/go-backlog/internal/domain/user"
@@ -26,14 +27,45 @@ type UserStarService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-received-star-list
 func (s *UserStarService) List(ctx context.Context, userID int, opts ...RequestOption) ([]*Star, error) {
+	ctx = client.WithOperation(ctx, "User.Star.List")
 	v, err := s.base.List(ctx, userID, toInnerOptions(opts)...)
 	return starsFromModel(v), convertError(err)
 }
 
+// All returns an iterator that lazily fetches all stars received by the user with
+// automatic cursor pagination, along with any validation error encountered
+// at call time.
+//
+// perPage controls how many stars are fetched per API call (1-100).
+// Items are yielded newest first unless WithOrder(OrderAsc) is passed.
+// The caller must not pass WithCount, WithMinID or WithMaxID in opts; those
+// are managed internally. If they are passed, an error is returned immediately.
+//
+// This method supports options returned by methods in "*Client.User.Star.Option",
+// such as:
+//   - WithOrder
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-received-star-list
+func (s *UserStarService) All(ctx context.Context, perPage int, userID int, opts ...RequestOption) (iter.Seq2[*Star, error], error) {
+	ctx = client.WithOperation(ctx, "User.Star.All")
+	seq, err := s.base.All(ctx, perPage, userID, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Star, error) bool) {
+		for v, err := range seq {
+			if !yield(starFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
 // Count returns the number of stars received by the user with the given ID.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-user-received-stars
 func (s *UserStarService) Count(ctx context.Context, userID int) (int, error) {
+	ctx = client.WithOperation(ctx, "User.Star.Count")
 	v, err := s.base.Count(ctx, userID)
 	return v, convertError(err)
 }
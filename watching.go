@@ -0,0 +1,228 @@
+package backlog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/nattokin/go-backlog/internal/client"
+	"github.com/nattokin/go-backlog/internal/domain/watching"
+	"github.com/nattokin/go-backlog/internal/model"
+	"github.com/nattokin/go-backlog/internal/option"
+)
+
+// ──────────────────────────────────────────────────────────────
+//  WatchingService
+// ──────────────────────────────────────────────────────────────
+
+// WatchingService handles communication with the watching-related methods of the Backlog API.
+type WatchingService struct {
+	base *watching.Service
+
+	Option *WatchingOptionService
+}
+
+// List returns the watching list of the user with the given ID.
+//
+// This method supports options returned by methods in "*Client.Watching.Option",
+// such as:
+//   - WithCount
+//   - WithIssueIDs
+//   - WithOffset
+//   - WithOrder
+//   - WithResourceAlreadyRead
+//   - WithWatchingSort
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-watching-list
+func (s *WatchingService) List(ctx context.Context, userID int, opts ...RequestOption) ([]*Watching, error) {
+	ctx = client.WithOperation(ctx, "Watching.List")
+	v, err := s.base.List(ctx, userID, toInnerOptions(opts)...)
+	return watchingsFromModel(v), convertError(err)
+}
+
+// All returns an iterator that lazily fetches the whole watching list of the
+// user with the given ID with automatic pagination, along with any validation
+// error encountered at call time.
+//
+// perPage controls how many watchings are fetched per API call (1-100).
+// Iteration stops automatically when all watchings have been returned.
+// The caller must not pass WithCount or WithOffset in opts; those are managed
+// internally. If they are passed, an error is returned immediately.
+//
+// This method supports filter options returned by methods in "*Client.Watching.Option",
+// such as:
+//   - WithIssueIDs
+//   - WithOrder
+//   - WithResourceAlreadyRead
+//   - WithWatchingSort
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-watching-list
+func (s *WatchingService) All(ctx context.Context, perPage int, userID int, opts ...RequestOption) (iter.Seq2[*Watching, error], error) {
+	ctx = client.WithOperation(ctx, "Watching.All")
+	seq, err := s.base.All(ctx, perPage, userID, toInnerOptions(opts)...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return func(yield func(*Watching, error) bool) {
+		for v, err := range seq {
+			if !yield(watchingFromModel(v), convertError(err)) {
+				return
+			}
+		}
+	}, nil
+}
+
+// Count returns the number of watchings of the user with the given ID.
+//
+// This method supports options returned by methods in "*Client.Watching.Option",
+// such as:
+//   - WithAlreadyRead
+//   - WithResourceAlreadyRead
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-watching
+func (s *WatchingService) Count(ctx context.Context, userID int, opts ...RequestOption) (int, error) {
+	ctx = client.WithOperation(ctx, "Watching.Count")
+	v, err := s.base.Count(ctx, userID, toInnerOptions(opts)...)
+	return v, convertError(err)
+}
+
+// One returns a single watching by its ID.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-watching
+func (s *WatchingService) One(ctx context.Context, watchingID int) (*Watching, error) {
+	ctx = client.WithOperation(ctx, "Watching.One")
+	v, err := s.base.One(ctx, watchingID)
+	return watchingFromModel(v), convertError(err)
+}
+
+// Add adds an issue to the watching list of the authenticated user.
+//
+// This method supports options returned by methods in "*Client.Watching.Option",
+// such as:
+//   - WithNote
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-watching
+func (s *WatchingService) Add(ctx context.Context, issueIDOrKey string, opts ...RequestOption) (*Watching, error) {
+	ctx = client.WithOperation(ctx, "Watching.Add")
+	v, err := s.base.Add(ctx, issueIDOrKey, toInnerOptions(opts)...)
+	return watchingFromModel(v), convertError(err)
+}
+
+// Update updates the note of a watching.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-watching
+func (s *WatchingService) Update(ctx context.Context, watchingID int, note string) (*Watching, error) {
+	ctx = client.WithOperation(ctx, "Watching.Update")
+	v, err := s.base.Update(ctx, watchingID, note)
+	return watchingFromModel(v), convertError(err)
+}
+
+// Delete deletes a watching and returns the deleted watching.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-watching
+func (s *WatchingService) Delete(ctx context.Context, watchingID int) (*Watching, error) {
+	ctx = client.WithOperation(ctx, "Watching.Delete")
+	v, err := s.base.Delete(ctx, watchingID)
+	return watchingFromModel(v), convertError(err)
+}
+
+// MarkAsRead marks a watching as read.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/mark-watching-as-read
+func (s *WatchingService) MarkAsRead(ctx context.Context, watchingID int) error {
+	ctx = client.WithOperation(ctx, "Watching.MarkAsRead")
+	return convertError(s.base.MarkAsRead(ctx, watchingID))
+}
+
+// ──────────────────────────────────────────────────────────────
+//  WatchingOptionService
+// ──────────────────────────────────────────────────────────────
+
+// WatchingOptionService provides a domain-specific set of option builders
+// for operations within the WatchingService.
+type WatchingOptionService struct {
+	base *option.OptionService
+}
+
+// WithAlreadyRead filters watchings by whether the watching itself has been read.
+func (s *WatchingOptionService) WithAlreadyRead(enabled bool) RequestOption {
+	return &requestOption{opt: s.base.WithAlreadyRead(enabled)}
+}
+
+// WithCount sets the number of results to return (1-100).
+func (s *WatchingOptionService) WithCount(count int) RequestOption {
+	return &requestOption{opt: s.base.WithCount(count)}
+}
+
+// WithIssueIDs filters watchings by issue IDs.
+func (s *WatchingOptionService) WithIssueIDs(ids []int) RequestOption {
+	return &requestOption{opt: s.base.WithIssueIDs(ids)}
+}
+
+// WithNote sets the note of a watching.
+func (s *WatchingOptionService) WithNote(note string) RequestOption {
+	return &requestOption{opt: s.base.WithNote(note)}
+}
+
+// WithOffset sets the number of watchings to skip.
+func (s *WatchingOptionService) WithOffset(offset int) RequestOption {
+	return &requestOption{opt: s.base.WithOffset(offset)}
+}
+
+// WithOrder sets the sort order of results.
+func (s *WatchingOptionService) WithOrder(order Order) RequestOption {
+	return &requestOption{opt: s.base.WithOrder(string(order))}
+}
+
+// WithResourceAlreadyRead filters watchings by whether the watched issue has
+// been read since its last update.
+func (s *WatchingOptionService) WithResourceAlreadyRead(enabled bool) RequestOption {
+	return &requestOption{opt: s.base.WithResourceAlreadyRead(enabled)}
+}
+
+// WithWatchingSort sets the field to sort watching list results by.
+func (s *WatchingOptionService) WithWatchingSort(sort WatchingSort) RequestOption {
+	return &requestOption{opt: s.base.WithWatchingSort(string(sort))}
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Constructor
+// ──────────────────────────────────────────────────────────────
+
+func newWatchingService(method *client.Method, option *option.OptionService) *WatchingService {
+	return &WatchingService{
+		base:   watching.NewService(method),
+		Option: &WatchingOptionService{base: option},
+	}
+}
+
+// ──────────────────────────────────────────────────────────────
+//  Helpers
+// ──────────────────────────────────────────────────────────────
+
+func watchingFromModel(m *model.Watching) *Watching {
+	if m == nil {
+		return nil
+	}
+	return &Watching{
+		ID:                  m.ID,
+		AlreadyRead:         m.AlreadyRead,
+		ResourceAlreadyRead: m.ResourceAlreadyRead,
+		Note:                m.Note,
+		Type:                m.Type,
+		Issue:               issueFromModel(m.Issue),
+		LastContentUpdated:  Timestamp{m.LastContentUpdated},
+		Created:             Timestamp{m.Created},
+		Updated:             Timestamp{m.Updated},
+	}
+}
+
+func watchingsFromModel(ms []*model.Watching) []*Watching {
+	if ms == nil {
+		return nil
+	}
+	result := make([]*Watching, len(ms))
+	for i, v := range ms {
+		result[i] = watchingFromModel(v)
+	}
+	return result
+}
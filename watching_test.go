@@ -0,0 +1,186 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backlog "github.com/nattokin/go-backlog"
+	"github.com/nattokin/go-backlog/internal/testutil/fixture"
+	"github.com/nattokin/go-backlog/internal/testutil/mock"
+)
+
+func TestWatchingService(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		doFunc func(req *http.Request) (*http.Response, error)
+		call   func(t *testing.T, c *backlog.Client)
+	}{
+		"List": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/users/1/watchings", req.URL.Path)
+				assert.Equal(t, "updated", req.URL.Query().Get("sort"))
+				assert.Equal(t, "false", req.URL.Query().Get("resourceAlreadyRead"))
+				return mock.NewResponse(fixture.Watching.ListJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Watching.List(ctx, 1,
+					c.Watching.Option.WithWatchingSort(backlog.WatchingSortUpdated),
+					c.Watching.Option.WithResourceAlreadyRead(false),
+				)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				assert.Equal(t, 1, got[0].ID)
+				assert.Equal(t, "TEST-1", got[0].Issue.IssueKey)
+				assert.Equal(t, "check later", got[1].Note)
+				assert.True(t, got[1].ResourceAlreadyRead)
+				assert.True(t, got[1].LastContentUpdated.Equal(time.Date(2024, 2, 20, 12, 0, 0, 0, time.UTC)))
+			},
+		},
+		"List/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Watching.List(ctx, 1)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"List/validation-error": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Watching.List(ctx, 0)
+				var target *backlog.ValidationError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"All": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/api/v2/users/1/watchings", req.URL.Path)
+				if req.URL.Query().Get("offset") == "0" {
+					return mock.NewResponse(fixture.Watching.ListJSON), nil
+				}
+				return mock.NewResponse(`[]`), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				seq, err := c.Watching.All(ctx, 2, 1)
+				require.NoError(t, err)
+				var ids []int
+				for w, err := range seq {
+					require.NoError(t, err)
+					ids = append(ids, w.ID)
+				}
+				assert.Equal(t, []int{1, 2}, ids)
+			},
+		},
+		"All/invalid-option": {
+			doFunc: mock.NewUnexpectedDoFunc(t),
+			call: func(t *testing.T, c *backlog.Client) {
+				_, err := c.Watching.All(ctx, 10, 1, c.Watching.Option.WithCount(5))
+				var target *backlog.InvalidOptionKeyError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+		"Count": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/api/v2/users/1/watchings/count", req.URL.Path)
+				assert.Equal(t, "true", req.URL.Query().Get("alreadyRead"))
+				return mock.NewResponse(fixture.Watching.CountJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Watching.Count(ctx, 1, c.Watching.Option.WithAlreadyRead(true))
+				require.NoError(t, err)
+				assert.Equal(t, 138, got)
+			},
+		},
+		"One": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "/api/v2/watchings/2", req.URL.Path)
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Watching.One(ctx, 2)
+				require.NoError(t, err)
+				assert.Equal(t, 2, got.ID)
+				assert.True(t, got.AlreadyRead)
+			},
+		},
+		"Add": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/watchings", req.URL.Path)
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "TEST-2", req.PostForm.Get("issueIdOrKey"))
+				assert.Equal(t, "check later", req.PostForm.Get("note"))
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Watching.Add(ctx, "TEST-2", c.Watching.Option.WithNote("check later"))
+				require.NoError(t, err)
+				assert.Equal(t, 2, got.ID)
+			},
+		},
+		"Update": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPatch, req.Method)
+				assert.Equal(t, "/api/v2/watchings/2", req.URL.Path)
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "check later", req.PostForm.Get("note"))
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Watching.Update(ctx, 2, "check later")
+				require.NoError(t, err)
+				assert.Equal(t, "check later", got.Note)
+			},
+		},
+		"Delete": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodDelete, req.Method)
+				assert.Equal(t, "/api/v2/watchings/2", req.URL.Path)
+				return mock.NewResponse(fixture.Watching.SingleJSON), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				got, err := c.Watching.Delete(ctx, 2)
+				require.NoError(t, err)
+				assert.Equal(t, 2, got.ID)
+			},
+		},
+		"MarkAsRead": {
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/watchings/2/markAsRead", req.URL.Path)
+				return mock.NewNoContentResponse(), nil
+			},
+			call: func(t *testing.T, c *backlog.Client) {
+				require.NoError(t, c.Watching.MarkAsRead(ctx, 2))
+			},
+		},
+		"MarkAsRead/error": {
+			doFunc: mock.NewNotFoundDoFunc(),
+			call: func(t *testing.T, c *backlog.Client) {
+				err := c.Watching.MarkAsRead(ctx, 2)
+				var target *backlog.APIResponseError
+				assert.True(t, errors.As(err, &target))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := backlog.NewClient("https://example.backlog.com", "token", backlog.WithDoer(&mock.Doer{T: t, DoFunc: tc.doFunc}))
+			require.NoError(t, err)
+
+			tc.call(t, c)
+		})
+	}
+}
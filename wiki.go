@@ -63,6 +63,7 @@ type WikiService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-list
 func (s *WikiService) List(ctx context.Context, projectIDOrKey string, opts ...RequestOption) ([]*Wiki, error) {
+	ctx = client.WithOperation(ctx, "Wiki.List")
 	v, err := s.base.List(ctx, projectIDOrKey, toInnerOptions(opts)...)
 	return wikisFromModel(v), convertError(err)
 }
@@ -71,6 +72,7 @@ func (s *WikiService) List(ctx context.Context, projectIDOrKey string, opts ...R
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-wiki-page
 func (s *WikiService) Count(ctx context.Context, projectIDOrKey string) (int, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Count")
 	v, err := s.base.Count(ctx, projectIDOrKey)
 	return v, convertError(err)
 }
@@ -79,6 +81,7 @@ func (s *WikiService) Count(ctx context.Context, projectIDOrKey string) (int, er
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page
 func (s *WikiService) One(ctx context.Context, wikiID int) (*Wiki, error) {
+	ctx = client.WithOperation(ctx, "Wiki.One")
 	v, err := s.base.One(ctx, wikiID)
 	return wikiFromModel(v), convertError(err)
 }
@@ -91,6 +94,7 @@ func (s *WikiService) One(ctx context.Context, wikiID int) (*Wiki, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-wiki-page
 func (s *WikiService) Create(ctx context.Context, projectID int, name, content string, opts ...RequestOption) (*Wiki, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Create")
 	v, err := s.base.Create(ctx, projectID, name, content, toInnerOptions(opts)...)
 	return wikiFromModel(v), convertError(err)
 }
@@ -105,6 +109,7 @@ func (s *WikiService) Create(ctx context.Context, projectID int, name, content s
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-wiki-page
 func (s *WikiService) Update(ctx context.Context, wikiID int, option RequestOption, opts ...RequestOption) (*Wiki, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Update")
 	v, err := s.base.Update(ctx, wikiID, toInnerOption(option), toInnerOptions(opts)...)
 	return wikiFromModel(v), convertError(err)
 }
@@ -113,6 +118,7 @@ func (s *WikiService) Update(ctx context.Context, wikiID int, option RequestOpti
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-wiki-page
 func (s *WikiService) Delete(ctx context.Context, wikiID int, opts ...RequestOption) (*Wiki, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Delete")
 	v, err := s.base.Delete(ctx, wikiID, toInnerOptions(opts)...)
 	return wikiFromModel(v), convertError(err)
 }
@@ -130,6 +136,7 @@ type WikiAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/attach-file-to-wiki
 func (s *WikiAttachmentService) Attach(ctx context.Context, wikiID int, attachmentIDs []int) ([]*Attachment, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Attachment.Attach")
 	v, err := s.base.Attach(ctx, wikiID, attachmentIDs)
 	return attachmentsFromModel(v), convertError(err)
 }
@@ -138,6 +145,7 @@ func (s *WikiAttachmentService) Attach(ctx context.Context, wikiID int, attachme
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-wiki-attachments
 func (s *WikiAttachmentService) List(ctx context.Context, wikiID int) ([]*Attachment, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Attachment.List")
 	v, err := s.base.List(ctx, wikiID)
 	return attachmentsFromModel(v), convertError(err)
 }
@@ -146,6 +154,7 @@ func (s *WikiAttachmentService) List(ctx context.Context, wikiID int) ([]*Attach
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-wiki-attachment
 func (s *WikiAttachmentService) Remove(ctx context.Context, wikiID, attachmentID int) (*Attachment, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Attachment.Remove")
 	v, err := s.base.Remove(ctx, wikiID, attachmentID)
 	return attachmentFromModel(v), convertError(err)
 }
@@ -155,6 +164,7 @@ func (s *WikiAttachmentService) Remove(ctx context.Context, wikiID, attachmentID
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-attachment
 func (s *WikiAttachmentService) Download(ctx context.Context, wikiID, attachmentID int) (*FileData, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Attachment.Download")
 	v, err := s.base.Download(ctx, wikiID, attachmentID)
 	return fileDataFromModel(v), convertError(err)
 }
@@ -172,6 +182,7 @@ type WikiHistoryService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-history/
 func (s *WikiHistoryService) List(ctx context.Context, wikiID int) ([]*WikiHistory, error) {
+	ctx = client.WithOperation(ctx, "Wiki.History.List")
 	v, err := s.base.List(ctx, wikiID)
 	return wikiHistoriesFromModel(v), convertError(err)
 }
@@ -189,6 +200,7 @@ type WikiSharedFileService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-shared-files-on-wiki
 func (s *WikiSharedFileService) List(ctx context.Context, wikiID int) ([]*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Wiki.SharedFile.List")
 	v, err := s.base.List(ctx, wikiID)
 	return sharedFilesFromModel(v), convertError(err)
 }
@@ -197,6 +209,7 @@ func (s *WikiSharedFileService) List(ctx context.Context, wikiID int) ([]*Shared
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/link-shared-files-to-wiki
 func (s *WikiSharedFileService) Link(ctx context.Context, wikiID int, fileIDs []int) ([]*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Wiki.SharedFile.Link")
 	v, err := s.base.Link(ctx, wikiID, fileIDs)
 	return sharedFilesFromModel(v), convertError(err)
 }
@@ -205,6 +218,7 @@ func (s *WikiSharedFileService) Link(ctx context.Context, wikiID int, fileIDs []
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-link-to-shared-file-from-wiki
 func (s *WikiSharedFileService) Unlink(ctx context.Context, wikiID, fileID int) (*SharedFile, error) {
+	ctx = client.WithOperation(ctx, "Wiki.SharedFile.Unlink")
 	v, err := s.base.Unlink(ctx, wikiID, fileID)
 	return sharedFileFromModel(v), convertError(err)
 }
@@ -223,6 +237,7 @@ type WikiStarService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-star
 func (s *WikiStarService) List(ctx context.Context, wikiID int) ([]*Star, error) {
+	ctx = client.WithOperation(ctx, "Wiki.Star.List")
 	v, err := s.base.List(ctx, wikiID)
 	return starsFromModel(v), convertError(err)
 }
@@ -231,6 +246,7 @@ func (s *WikiStarService) List(ctx context.Context, wikiID int) ([]*Star, error)
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-star
 func (s *WikiStarService) Add(ctx context.Context, wikiID int) error {
+	ctx = client.WithOperation(ctx, "Wiki.Star.Add")
 	return s.star.Add(ctx, s.star.Option.WithWikiID(wikiID))
 }
 
@@ -238,6 +254,7 @@ func (s *WikiStarService) Add(ctx context.Context, wikiID int) error {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-star
 func (s *WikiStarService) Remove(ctx context.Context, starID int) error {
+	ctx = client.WithOperation(ctx, "Wiki.Star.Remove")
 	return s.star.Remove(ctx, starID)
 }
 